@@ -4,14 +4,29 @@ import (
 	"api-gateway/config"
 	_ "api-gateway/docs"
 	"api-gateway/internal/auth"
+	"api-gateway/internal/gql"
+	"api-gateway/internal/handlers"
+	"api-gateway/internal/orderstatus"
+	"api-gateway/internal/orderview"
+	"api-gateway/internal/ratelimit"
 	"api-gateway/internal/router"
+	"context"
+	"errors"
+	"io/fs"
 	"os"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation/interceptor"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
+	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
+	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
 
 	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -24,6 +39,7 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
+// @securityDefinitions.basic BasicAuth
 func main() {
 	_ = godotenv.Load()
 	isDev := os.Getenv("ENV") == "development"
@@ -35,11 +51,21 @@ func main() {
 
 	log := logger.L()
 
+	shutdownTracing, err := telemetry.Setup(context.Background(), "api-gateway", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg := config.Load(log)
 
 	authConn, err := grpc.NewClient(
 		cfg.AuthAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// X-Request-ID и traceparent уходят в метаданные каждого вызова auth.Client;
+		// дедлайны, ретраи и breaker — после них, чтобы попытки несли те же метаданные
+		telemetry.DialOption(),
+		grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("auth-service", cfg.AuthRPC, log)),
 	)
 	if err != nil {
 		log.Error("auth service dial failed: ", zap.Error(err))
@@ -49,7 +75,104 @@ func main() {
 	rawAuthClient := authv1.NewAuthServiceClient(authConn)
 	authClient := auth.NewClient(rawAuthClient)
 
-	r := router.Router(authClient, log)
+	var rdb *redis.Client
+	if cfg.Redis.Addr != "" {
+		rdb = redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password, DB: cfg.Redis.DB})
+		defer rdb.Close()
+	}
+
+	// готовность gateway — доступность auth-service и Redis, если он задан
+	ready := readiness.New(log)
+	ready.Add("auth", readiness.GRPC(authConn, ""))
+	if rdb != nil {
+		ready.Add("redis", func(ctx context.Context) error { return rdb.Ping(ctx).Err() })
+	}
+
+	// политики rate limit; без файла ограничения отключены
+	var limiter *ratelimit.Limiter
+	policies, err := ratelimit.LoadConfig(cfg.RateLimitFile)
+	switch {
+	case cfg.RateLimitFile == "" || errors.Is(err, fs.ErrNotExist):
+		log.Warn("rate limit policies not found, rate limiting disabled", zap.String("file", cfg.RateLimitFile))
+		policies = nil
+	case err != nil:
+		log.Fatal("failed to load rate limit policies", zap.Error(err))
+	default:
+		var store ratelimit.Store
+		if rdb != nil {
+			store = ratelimit.NewRedisStore(rdb)
+			log.Info("rate limit counters stored in redis", zap.String("addr", cfg.Redis.Addr))
+		} else {
+			log.Info("rate limit counters stored in memory")
+		}
+		limiter = ratelimit.NewLimiter(store, log)
+	}
+
+	// стриминг статусов заказов: события из Kafka читает одна реплика (общая группа),
+	// через Redis pub/sub они доходят до клиентов всех реплик
+	var (
+		orderStatus *handlers.OrderStatusHandler
+		hub         *orderstatus.Hub
+	)
+	if len(cfg.OrderStatus.KafkaBrokers) > 0 {
+		var broker orderstatus.Broker
+		if rdb != nil {
+			broker = orderstatus.NewRedisBroker(rdb, cfg.OrderStatus.History)
+		} else {
+			log.Warn("order status fan-out kept in memory, only one gateway replica is supported")
+			broker = orderstatus.NewMemoryBroker(cfg.OrderStatus.History)
+		}
+		hub = orderstatus.NewHub(cfg.OrderStatus.Buffer)
+		go hub.Run(context.Background(), broker, log)
+
+		consumer := orderstatus.NewConsumer(cfg.OrderStatus.KafkaBrokers, cfg.OrderStatus.GroupID, cfg.OrderStatus.Topic, broker, log)
+		defer consumer.Close()
+		orderstatus.RegisterConsumerLag(cfg.OrderStatus.Topic, consumer.Lag)
+		go func() {
+			if err := consumer.Run(context.Background()); err != nil {
+				log.Error("order status consumer stopped", zap.Error(err))
+			}
+		}()
+
+		orderStatus = handlers.NewOrderStatusHandler(hub, broker, cfg.CORSOrigins, log)
+	} else {
+		log.Warn("KAFKA_BROKERS not set, order status streaming disabled")
+	}
+
+	// BFF-эндпоинты и GraphQL собирают ответ из order- и inventory-service; без адресов отключены
+	var (
+		orderView *handlers.OrderViewHandler
+		graphQL   *handlers.GraphQLHandler
+	)
+	if cfg.OrderAddr != "" && cfg.InventoryAddr != "" {
+		orderConn, err := grpc.NewClient(cfg.OrderAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), telemetry.DialOption(),
+			grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("order-service", cfg.OrderRPC, log)))
+		if err != nil {
+			log.Fatal("order service dial failed", zap.Error(err))
+		}
+		defer orderConn.Close()
+		inventoryConn, err := grpc.NewClient(cfg.InventoryAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), telemetry.DialOption(),
+			grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("inventory-service", cfg.InventoryRPC, log)))
+		if err != nil {
+			log.Fatal("inventory service dial failed", zap.Error(err))
+		}
+		defer inventoryConn.Close()
+		orderClient := orderv1.NewOrderServiceClient(orderConn)
+		inventoryClient := inventoryv1.NewInventoryServiceClient(inventoryConn)
+		orderView = handlers.NewOrderViewHandler(orderview.NewComposer(orderClient, inventoryClient, log), log)
+
+		// без стриминга статусов (hub == nil) подписки GraphQL возвращают ошибку
+		gqlServer, err := gql.NewServer(authClient, orderClient, inventoryClient, hub,
+			gql.Limits{MaxDepth: cfg.GraphQL.MaxDepth, MaxComplexity: cfg.GraphQL.MaxComplexity}, log)
+		if err != nil {
+			log.Fatal("failed to build graphql schema", zap.Error(err))
+		}
+		graphQL = handlers.NewGraphQLHandler(gqlServer, cfg.CORSOrigins, log)
+	} else {
+		log.Warn("ORDER_SERVICE_ADDR or INVENTORY_SERVICE_ADDR not set, order view and graphql endpoints disabled")
+	}
+
+	r := router.Router(cfg, authClient, limiter, policies, ready, orderStatus, orderView, graphQL, log)
 
 	if err := r.Run(":8080"); err != nil {
 		log.Fatal("failed to run http server", zap.Error(err))
@@ -1,19 +1,157 @@
 package config
 
 import (
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
 	"go.uber.org/zap"
 )
 
 type Config struct {
 	AuthAddr string
+	AuthRPC  resilience.Config // дедлайны, ретраи и breaker вызовов auth-service (AUTH_RPC_*)
+
+	// адреса order- и inventory-service для BFF-эндпоинтов; без них эндпоинты отключены
+	OrderAddr     string
+	OrderRPC      resilience.Config // ORDER_RPC_*
+	InventoryAddr string
+	InventoryRPC  resilience.Config // INVENTORY_RPC_*
+
+	RateLimitFile string // файл политик ограничения частоты запросов
+	Redis         Redis
+
+	// CORSOrigins — разрешённые источники (CORS_ALLOWED_ORIGINS через запятую). "*" разрешает
+	// любой источник, но без credentials: cookie-режим refresh-токена требует явного списка.
+	CORSOrigins []string
+	Cookies     Cookies
+
+	OrderStatus OrderStatus
+	GraphQL     GraphQL
+}
+
+// GraphQL — ограничения запросов /graphql
+type GraphQL struct {
+	MaxDepth      int // GRAPHQL_MAX_DEPTH
+	MaxComplexity int // GRAPHQL_MAX_COMPLEXITY
+}
+
+// OrderStatus — стриминг статусов заказов по SSE и WebSocket
+type OrderStatus struct {
+	KafkaBrokers []string // пусто — стриминг отключён
+	Topic        string   // топик смен статуса, в который пишет order-service
+	GroupID      string   // общая для всех реплик gateway consumer group
+	History      int      // сколько последних событий хранится для Last-Event-ID
+	Buffer       int      // неотправленных событий на соединение до отключения медленного клиента
+}
+
+// Cookies — cookie-режим refresh-токена для браузерных клиентов
+type Cookies struct {
+	RefreshName string        // HttpOnly-cookie с refresh-токеном, путь /api/v1/auth
+	CSRFName    string        // читаемая из JS cookie с CSRF-токеном (double submit)
+	Domain      string        // пусто — только хост gateway
+	Secure      bool          // false — только для локальной разработки по http
+	SameSite    http.SameSite // strict, lax или none (none требует Secure)
+}
+
+// Redis — общее хранилище счётчиков rate limit; без адреса счётчики живут в памяти реплики
+type Redis struct {
+	Addr     string
+	Password string
+	DB       int
 }
 
 func Load(log *zap.Logger) *Config {
+	db, _ := strconv.Atoi(os.Getenv("REDIS_DB"))
+	sameSite, ok := parseSameSite(envDefault("COOKIE_SAMESITE", "strict"))
+	if !ok {
+		log.Fatal("invalid COOKIE_SAMESITE, expected strict, lax or none")
+	}
+	authRPC, err := resilience.FromEnv("AUTH", resilience.AuthDefaults())
+	if err != nil {
+		log.Fatal("invalid auth rpc settings", zap.Error(err))
+	}
+	orderRPC, err := resilience.FromEnv("ORDER", resilience.OrderDefaults())
+	if err != nil {
+		log.Fatal("invalid order rpc settings", zap.Error(err))
+	}
+	inventoryRPC, err := resilience.FromEnv("INVENTORY", resilience.InventoryDefaults())
+	if err != nil {
+		log.Fatal("invalid inventory rpc settings", zap.Error(err))
+	}
 	return &Config{
-		AuthAddr: getEnv("AUTH_SERVICE_ADDR", log),
+		AuthAddr:      getEnv("AUTH_SERVICE_ADDR", log),
+		AuthRPC:       authRPC,
+		OrderAddr:     os.Getenv("ORDER_SERVICE_ADDR"),
+		OrderRPC:      orderRPC,
+		InventoryAddr: os.Getenv("INVENTORY_SERVICE_ADDR"),
+		InventoryRPC:  inventoryRPC,
+		RateLimitFile: envDefault("RATE_LIMIT_FILE", "config/ratelimit.yaml"),
+		Redis: Redis{
+			Addr:     os.Getenv("REDIS_ADDR"),
+			Password: os.Getenv("REDIS_PASSWORD"),
+			DB:       db,
+		},
+		CORSOrigins: splitAndTrim(envDefault("CORS_ALLOWED_ORIGINS", "*")),
+		Cookies: Cookies{
+			RefreshName: envDefault("REFRESH_COOKIE_NAME", "orderhub_refresh"),
+			CSRFName:    envDefault("CSRF_COOKIE_NAME", "orderhub_csrf"),
+			Domain:      os.Getenv("COOKIE_DOMAIN"),
+			Secure:      envDefault("COOKIE_SECURE", "true") != "false",
+			SameSite:    sameSite,
+		},
+		OrderStatus: OrderStatus{
+			KafkaBrokers: splitAndTrim(os.Getenv("KAFKA_BROKERS")),
+			Topic:        envDefault("KAFKA_TOPIC_ORDER_STATUS", "order.status"),
+			GroupID:      envDefault("ORDER_STATUS_GROUP_ID", "api-gateway-order-status"),
+			History:      atoiDefault(os.Getenv("ORDER_STATUS_HISTORY"), 10000),
+			Buffer:       atoiDefault(os.Getenv("ORDER_STATUS_BUFFER"), 64),
+		},
+		GraphQL: GraphQL{
+			MaxDepth:      atoiDefault(os.Getenv("GRAPHQL_MAX_DEPTH"), 8),
+			MaxComplexity: atoiDefault(os.Getenv("GRAPHQL_MAX_COMPLEXITY"), 2000),
+		},
+	}
+}
+
+func atoiDefault(s string, def int) int {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return def
+	}
+	return n
+}
+
+func parseSameSite(s string) (http.SameSite, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "strict":
+		return http.SameSiteStrictMode, true
+	case "lax":
+		return http.SameSiteLaxMode, true
+	case "none":
+		return http.SameSiteNoneMode, true
+	default:
+		return 0, false
+	}
+}
+
+func splitAndTrim(s string) []string {
+	var parts []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return parts
+}
+
+func envDefault(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
 	}
+	return def
 }
 
 func getEnv(key string, log *zap.Logger) string {
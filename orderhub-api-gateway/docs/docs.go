@@ -15,9 +15,55 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/auth/confirm-password-reset": {
+        "/api/v1/admin/oauth-clients": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Зарегистрированные resource server'ы; секреты не возвращаются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "OAuth-клиенты",
+                "responses": {
+                    "200": {
+                        "description": "Клиенты",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListOAuthClientsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права oauth_client:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Подтверждает сброс пароля для пользователя",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Выдаёт client_id и client_secret стороннему resource server'у для /oauth/introspect и /oauth/revoke. Секрет показывается только в этом ответе.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,25 +71,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Подтверждение сброса пароля",
+                "summary": "Зарегистрировать OAuth-клиент",
                 "parameters": [
                     {
-                        "description": "Код подтверждения и новый пароль",
-                        "name": "code",
+                        "description": "Название клиента",
+                        "name": "client",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ConfirmPasswordResetRequest"
+                            "$ref": "#/definitions/dto.CreateOAuthClientRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Успешное подтверждение сброса пароля",
+                    "201": {
+                        "description": "Клиент создан",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.CreateOAuthClientResponse"
                         }
                     },
                     "400": {
@@ -52,10 +98,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ValidationErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "401": {
+                        "description": "Не авторизован",
                         "schema": {
-                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права oauth_client:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -67,45 +119,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/email/verification/confirm": {
-            "post": {
-                "description": "Подтверждает почту по одноразовому коду из письма",
-                "consumes": [
-                    "application/json"
+        "/api/v1/admin/oauth-clients/{client_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Учётные данные клиента сразу перестают приниматься",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Подтверждение email по коду",
+                "summary": "Удалить OAuth-клиент",
                 "parameters": [
                     {
-                        "description": "Код подтверждения",
-                        "name": "confirm",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ConfirmEmailVerificationRequest"
-                        }
+                        "type": "string",
+                        "description": "client_id",
+                        "name": "client_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Email подтверждён",
+                        "description": "Клиент удалён",
                         "schema": {
                             "$ref": "#/definitions/dto.SuccessResponse"
                         }
                     },
-                    "400": {
-                        "description": "Неверные данные или истёкший код",
+                    "401": {
+                        "description": "Не авторизован",
                         "schema": {
-                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права oauth_client:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Пользователь не найден",
+                        "description": "Клиент не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.NotFoundErrorResponse"
                         }
@@ -119,50 +177,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/email/verification/request": {
-            "post": {
+        "/api/v1/admin/rbac/permissions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Отправляет письмо подтверждения для текущего авторизованного пользователя",
+                "description": "Возвращает все известные права доступа",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "rbac"
                 ],
-                "summary": "Повторная отправка письма подтверждения",
+                "summary": "Каталог прав",
                 "responses": {
                     "200": {
-                        "description": "Письмо отправлено",
+                        "description": "Список прав",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.ListPermissionsResponse"
                         }
                     },
                     "401": {
-                        "description": "Неавторизован",
+                        "description": "Не авторизован",
                         "schema": {
                             "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
-                        "schema": {
-                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Email уже подтверждён",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ConflictErrorResponse"
-                        }
-                    },
-                    "429": {
-                        "description": "Слишком много запросов",
+                    "403": {
+                        "description": "Нет права rbac:manage",
                         "schema": {
-                            "$ref": "#/definitions/dto.TooManyRequestsErrorResponse"
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -174,63 +220,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/jwks": {
+        "/api/v1/admin/rbac/roles/{role}/permissions": {
             "get": {
-                "description": "Получает JSON Web Key Set (JWKS) для проверки подписи JWT",
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Получение JWKS",
-                "responses": {}
-            }
-        },
-        "/api/v1/auth/login": {
-            "post": {
-                "description": "Авторизует пользователя и выдаёт пару токенов (access/refresh)",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Возвращает права, выданные роли (customer, vendor, admin)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "rbac"
                 ],
-                "summary": "Авторизация пользователя",
+                "summary": "Права роли",
                 "parameters": [
                     {
-                        "description": "Данные авторизации",
-                        "name": "login",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Права роли",
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginResponse"
+                            "$ref": "#/definitions/dto.RolePermissionsResponse"
                         }
                     },
                     "400": {
-                        "description": "Неверные данные",
+                        "description": "Неизвестная роль",
                         "schema": {
                             "$ref": "#/definitions/dto.ValidationErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Ошибка авторизации",
+                        "description": "Не авторизован",
                         "schema": {
                             "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Пользователь не найден",
+                    "403": {
+                        "description": "Нет права rbac:manage",
                         "schema": {
-                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -240,16 +276,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/auth/logout": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Логаут по refresh_token (single) или массовый логаут по all=true",
+                "description": "Добавляет право роли. Действует на новые access-токены",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,23 +291,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "rbac"
                 ],
-                "summary": "Выход из системы",
+                "summary": "Выдать право роли",
                 "parameters": [
                     {
-                        "description": "Refresh token или all=true",
-                        "name": "logout",
+                        "type": "string",
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Право",
+                        "name": "permission",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.LogoutRequest"
+                            "$ref": "#/definitions/dto.RolePermissionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешный логаут",
+                        "description": "Право выдано",
                         "schema": {
                             "$ref": "#/definitions/dto.SuccessResponse"
                         }
@@ -284,8 +325,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ValidationErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права rbac:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Токен не найден",
+                        "description": "Право не найдено",
                         "schema": {
                             "$ref": "#/definitions/dto.NotFoundErrorResponse"
                         }
@@ -299,35 +352,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/refresh": {
-            "post": {
-                "description": "Обновляет пару токенов по refresh токену",
-                "consumes": [
-                    "application/json"
+        "/api/v1/admin/rbac/roles/{role}/permissions/{permission}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Удаляет право у роли. Действует на новые access-токены",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "rbac"
                 ],
-                "summary": "Обновление токена",
+                "summary": "Отозвать право роли",
                 "parameters": [
                     {
-                        "description": "Данные для обновления токена",
-                        "name": "refresh",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.RefreshRequest"
-                        }
+                        "type": "string",
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Право",
+                        "name": "permission",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Право отозвано",
                         "schema": {
-                            "$ref": "#/definitions/dto.RefreshResponse"
+                            "$ref": "#/definitions/dto.SuccessResponse"
                         }
                     },
                     "400": {
@@ -337,11 +397,23 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Ошибка авторизации",
+                        "description": "Не авторизован",
                         "schema": {
                             "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Нет права rbac:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Право не выдано",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Внутренняя ошибка",
                         "schema": {
@@ -351,47 +423,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/register": {
+        "/api/v1/admin/users/{id}/disable": {
             "post": {
-                "description": "Создаёт нового пользователя с ролью CUSTOMER",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Блокирует учётную запись и отзывает все её токены и сессии",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "users"
                 ],
-                "summary": "Регистрация пользователя",
+                "summary": "Заблокировать пользователя",
                 "parameters": [
                     {
-                        "description": "Данные регистрации",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.RegisterRequest"
-                        }
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешная регистрация",
+                        "description": "Пользователь заблокирован",
                         "schema": {
-                            "$ref": "#/definitions/dto.RegisterResponse"
+                            "$ref": "#/definitions/dto.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Неверные данные",
+                        "description": "Неверный ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ValidationErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Пользователь уже существует",
+                    "401": {
+                        "description": "Не авторизован",
                         "schema": {
-                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права user:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
                         }
                     },
                     "500": {
@@ -403,9 +487,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/request-password-reset": {
+        "/api/v1/admin/users/{id}/impersonate": {
             "post": {
-                "description": "Запрашивает сброс пароля для пользователя по почте",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Выдаёт короткоживущий access-токен пользователя с claim'ом act (администратор). Refresh-токен не выдаётся; вход пишется в журнал аудита. Смена пароля, удаление аккаунта и управление API-ключами с таким токеном запрещены",
                 "consumes": [
                     "application/json"
                 ],
@@ -413,25 +502,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "users"
                 ],
-                "summary": "Запрос на сброс пароля",
+                "summary": "Войти от имени пользователя",
                 "parameters": [
                     {
-                        "description": "Email пользователя",
-                        "name": "email",
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина (номер обращения)",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.RequestPasswordResetRequest"
+                            "$ref": "#/definitions/dto.ImpersonateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешный запрос на сброс пароля",
+                        "description": "Токен выдан",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.ImpersonateResponse"
                         }
                     },
                     "400": {
@@ -440,12 +536,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ValidationErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права user:impersonate или цель — администратор",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Пользователь не найден",
                         "schema": {
                             "$ref": "#/definitions/dto.NotFoundErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Учётная запись заблокирована",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Внутренняя ошибка",
                         "schema": {
@@ -454,37 +568,2336 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
+        },
+        "/api/v1/admin/users/{id}/role": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Назначает роль (customer, vendor, admin); выданные ранее access-токены пользователя отзываются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Сменить роль пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetUserRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роль изменена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права user:manage",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/vendor-applications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заявки в порядке поступления, с фильтром по статусу",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "Список заявок продавцов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PENDING, APPROVED или REJECTED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Размер страницы (1..100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявки",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListVendorApplicationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права vendor:review",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/vendor-applications/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Пользователь получает роль ROLE_VENDOR; выданные ранее access-токены отзываются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "Одобрить заявку продавца",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка одобрена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VendorApplication"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права vendor:review",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заявка уже рассмотрена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/vendor-applications/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заявка отклоняется, пользователю уходит письмо с причиной",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "Отклонить заявку продавца",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID заявки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина",
+                        "name": "reason",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectVendorApplicationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заявка отклонена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VendorApplication"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Нет права vendor:review",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заявка не найдена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заявка уже рассмотрена",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/account": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет учётную запись текущего пользователя (требуется пароль). Все токены и сессии отзываются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Удаление аккаунта",
+                "parameters": [
+                    {
+                        "description": "Пароль для подтверждения",
+                        "name": "account",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeleteAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Аккаунт удалён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Неверный пароль",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ключи текущего пользователя, включая отозванные; открытые значения не возвращаются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Мои API-ключи",
+                "responses": {
+                    "200": {
+                        "description": "Ключи",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListAPIKeysResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Запрос сделан по API-ключу",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Выпускает именованный ключ с правами из числа прав пользователя. Ключ показывается только в этом ответе. Управлять ключами можно только после входа по паролю, не по самому ключу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Создать API-ключ",
+                "parameters": [
+                    {
+                        "description": "Название, права и срок действия",
+                        "name": "key",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Ключ создан",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Права не выданы пользователю или запрос сделан по API-ключу",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ключ перестаёт приниматься; сервисы, закэшировавшие проверку, отпустят его не позже чем через 30 секунд",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-keys"
+                ],
+                "summary": "Отозвать API-ключ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID ключа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ключ отозван",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Запрос сделан по API-ключу",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Ключ не найден или уже отозван",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/confirm-password-reset": {
+            "post": {
+                "description": "Подтверждает сброс пароля для пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Подтверждение сброса пароля",
+                "parameters": [
+                    {
+                        "description": "Код подтверждения и новый пароль",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешное подтверждение сброса пароля",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/devices": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Браузеры и сети, из которых выполнялся вход; вход с незнакомых отправляет письмо о новом входе",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "Мои устройства",
+                "responses": {
+                    "200": {
+                        "description": "Устройства, недавние первыми",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListTrustedDevicesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/devices/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Следующий вход с этого устройства снова вызовет письмо о новом входе",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "Забыть устройство",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID устройства",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Устройство удалено",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Устройство не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/email/verification/confirm": {
+            "post": {
+                "description": "Подтверждает почту по одноразовому коду из письма",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Подтверждение email по коду",
+                "parameters": [
+                    {
+                        "description": "Код подтверждения",
+                        "name": "confirm",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmEmailVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Email подтверждён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные или истёкший код",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/email/verification/request": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отправляет письмо подтверждения для текущего авторизованного пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Повторная отправка письма подтверждения",
+                "responses": {
+                    "200": {
+                        "description": "Письмо отправлено",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Неавторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email уже подтверждён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много запросов",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TooManyRequestsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/guest": {
+            "post": {
+                "description": "Создаёт анонимного покупателя и выдаёт пару токенов, чтобы оформить заказ без регистрации",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Гостевой аккаунт",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateGuestResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много запросов",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TooManyRequestsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/guest/upgrade": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Привязывает email и пароль к текущему гостевому аккаунту. ID пользователя и заказы сохраняются, на почту уходит письмо подтверждения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Регистрация гостя",
+                "parameters": [
+                    {
+                        "description": "Email и пароль",
+                        "name": "guest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpgradeGuestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpgradeGuestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Аккаунт уже зарегистрирован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email уже занят",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/jwks": {
+            "get": {
+                "description": "Получает JSON Web Key Set (JWKS) для проверки подписи JWT",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Получение JWKS",
+                "responses": {}
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Авторизует пользователя и выдаёт пару токенов (access/refresh). С refresh_cookie=true refresh-токен приходит в HttpOnly-cookie (путь /api/v1/auth) вместе с CSRF-cookie, а не в теле ответа.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Авторизация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные авторизации",
+                        "name": "login",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "DPoP-доказательство (RFC 9449): токены привязываются к ключу клиента",
+                        "name": "DPoP",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Ошибка авторизации",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Аккаунт заблокирован или требуется сброс пароля",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Логаут по refresh_token (single) или массовый логаут по all=true. Без refresh_token в теле используется refresh-cookie (с заголовком X-CSRF-Token); cookie удаляются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выход из системы",
+                "parameters": [
+                    {
+                        "description": "Refresh token или all=true",
+                        "name": "logout",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LogoutRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Значение CSRF-cookie; обязательно в cookie-режиме",
+                        "name": "X-CSRF-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный логаут",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Неверный CSRF-токен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Токен не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Профиль текущего пользователя: имя, телефон, язык писем, часовой пояс и согласие на рассылки",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Мой профиль",
+                "responses": {
+                    "200": {
+                        "description": "Профиль",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Меняет только переданные поля. Телефон — в формате E.164, язык — BCP 47 (ru, en-US), часовой пояс — IANA (Europe/Moscow). Пустая строка очищает имя или телефон. Согласие на рассылки нельзя менять под impersonation-токеном.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Обновить профиль",
+                "parameters": [
+                    {
+                        "description": "Изменяемые поля профиля",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Обновлённый профиль",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Согласие на рассылки под impersonation-токеном",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает JSON-архив всех данных, которые auth-service хранит о текущем пользователе",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выгрузка персональных данных",
+                "responses": {
+                    "200": {
+                        "description": "JSON-архив",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/phone/verification/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Подтверждает телефон кодом из SMS. На один код даётся 5 попыток, после этого нужно запросить новый.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Подтвердить телефон",
+                "parameters": [
+                    {
+                        "description": "Код из SMS",
+                        "name": "confirm",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmPhoneVerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Телефон подтверждён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный или истёкший код",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Телефон не указан или уже подтверждён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Попытки исчерпаны",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TooManyRequestsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/phone/verification/request": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отправляет 6-значный код на телефон из профиля. Код действует 10 минут, новый можно запросить не чаще раза в минуту.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Отправить SMS-код",
+                "responses": {
+                    "200": {
+                        "description": "Код отправлен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RequestPhoneVerificationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Телефон не указан или уже подтверждён",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много запросов",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TooManyRequestsErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/refresh": {
+            "post": {
+                "description": "Обновляет пару токенов по refresh токену из тела. Без него токен берётся из refresh-cookie: тогда обязателен заголовок X-CSRF-Token со значением CSRF-cookie, а новый токен снова приходит в cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Обновление токена",
+                "parameters": [
+                    {
+                        "description": "Данные для обновления токена",
+                        "name": "refresh",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "DPoP-доказательство; обязательно для токенов, привязанных к ключу",
+                        "name": "DPoP",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Значение CSRF-cookie; обязательно в cookie-режиме",
+                        "name": "X-CSRF-Token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Ошибка авторизации",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Неверный CSRF-токен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/register": {
+            "post": {
+                "description": "Создаёт нового пользователя с ролью CUSTOMER",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Регистрация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные регистрации",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешная регистрация",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Пользователь уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/request-password-reset": {
+            "post": {
+                "description": "Запрашивает сброс пароля для пользователя по почте",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Запрос на сброс пароля",
+                "parameters": [
+                    {
+                        "description": "Email пользователя",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RequestPasswordResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный запрос на сброс пароля",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/sign-in-alerts/report": {
+            "post": {
+                "description": "Токен из письма о новом входе. Завершает все сеансы, отзывает токены и блокирует вход до сброса пароля; код сброса приходит на почту",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "devices"
+                ],
+                "summary": "Это был не я",
+                "parameters": [
+                    {
+                        "description": "Токен из письма",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportSignInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сеансы завершены",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Ссылка недействительна или истекла",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/status/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поток text/event-stream: событие order_status с id для возобновления, комментарий \": ping\" каждые 15 с.\nПользователь получает события своих заказов, с правом order:read:any — любых. EventSource не умеет\nзаголовки, поэтому токен можно передать в параметре access_token. После обрыва EventSource сам\nпереподключается с Last-Event-ID и получает пропущенное; медленного клиента сервер отключает.\nПоток закрывается, когда токен истекает или отозван: переподключитесь с новым токеном.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Статусы заказов (SSE)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Только этот заказ",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Продолжить после события (вместо заголовка Last-Event-ID)",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access-токен, если нельзя передать заголовок Authorization",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий",
+                        "schema": {
+                            "$ref": "#/definitions/orderstatus.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "История событий недоступна",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceUnavailableErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/status/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Каждое сообщение — JSON события со полем id. Сервер шлёт ping каждые 15 с и закрывает соединение,\nесли pong не пришёл. Медленный клиент отключается с кодом 1013: переподключитесь с last_event_id\nпоследнего полученного события. Токен можно передать в параметре access_token. Когда токен\nистекает или отозван, соединение закрывается с кодом 1008.",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Статусы заказов (WebSocket)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Только этот заказ",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Продолжить после события",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access-токен, если нельзя передать заголовок Authorization",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Переключение на WebSocket",
+                        "schema": {
+                            "$ref": "#/definitions/orderstatus.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные параметры",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "История событий недоступна",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceUnavailableErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/orders/{id}/view": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заказ вместе с названиями и изображениями товаров, текущими остатками и возможностью повторить заказ —\nодин запрос вместо N+1. Товары и остатки запрашиваются параллельно одним пакетным вызовом каждый.\nЕсли inventory-service недоступен, заказ возвращается с partial=true, а недостающие части перечислены в unavailable.\nПользователь видит свои заказы, с правом order:read:any — любые.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Страница заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID заказа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ с данными товаров",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrderViewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Чужой заказ",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "order-service недоступен",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ServiceUnavailableErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/vendor/applications": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Покупатель отправляет реквизиты компании; заявка ждёт решения администратора",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "Заявка на статус продавца",
+                "parameters": [
+                    {
+                        "description": "Реквизиты компании",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubmitVendorApplicationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Заявка создана",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VendorApplication"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверные данные",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Заявка уже на рассмотрении или пользователь уже продавец",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConflictErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка",
+                        "schema": {
+                            "$ref": "#/definitions/dto.InternalErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "WebSocket с подпротоколом graphql-transport-ws: connection_init → connection_ack, затем subscribe/next/complete.\nПодписка orderStatusChanged отдаёт смены статусов своих заказов, с правом order:read:any — любых.\nТокен проверяется при открытии соединения: заголовок Authorization или параметр access_token\n(payload connection_init не читается). Пропущенные события не догоняются — для этого есть SSE-стрим с Last-Event-ID.\nКогда токен истекает или отозван, соединение закрывается с кодом 4401. В соединении одновременно\nидёт не больше 32 операций: следующий subscribe закрывает его с кодом 4400.",
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL-подписки (WebSocket)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Access-токен, если нельзя передать заголовок Authorization",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Переключение на WebSocket",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Не WebSocket-запрос",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Запросы к схеме internal/gql/schema.graphql: пользователь, заказы с товарами и остатками, каталог.\nТовары и остатки позиций загружаются пакетно (BatchGetProducts/BatchGetStock) на весь запрос.\nЗапрос глубже GRAPHQL_MAX_DEPTH или сложнее GRAPHQL_MAX_COMPLEXITY отклоняется с кодом\nQUERY_TOO_DEEP / QUERY_TOO_COMPLEX в extensions.code. Ошибки GraphQL возвращаются со статусом 200.\nПодписки — по WebSocket на GET /graphql (протокол graphql-transport-ws).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL",
+                "parameters": [
+                    {
+                        "description": "Запрос GraphQL",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверное тело запроса",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnauthorizedErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/introspect": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Для сторонних resource server'ов. Клиент аутентифицируется через HTTP Basic или поля client_id/client_secret. Недействительный, просроченный или отозванный токен — ответ 200 с active=false.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Интроспекция токена (RFC 7662)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Access или refresh токен",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "access_token или refresh_token",
+                        "name": "token_type_hint",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_id, если не передан в Authorization",
+                        "name": "client_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_secret, если не передан в Authorization",
+                        "name": "client_secret",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Сведения о токене",
+                        "schema": {
+                            "$ref": "#/definitions/dto.IntrospectTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid_request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid_client",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "temporarily_unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Отзывает refresh-токен (и его сессию, если других токенов в ней нет) или access-токен. На неизвестный или уже недействительный токен тоже отвечает 200.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Отзыв токена (RFC 7009)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Access или refresh токен",
+                        "name": "token",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "access_token или refresh_token",
+                        "name": "token_type_hint",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_id, если не передан в Authorization",
+                        "name": "client_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_secret, если не передан в Authorization",
+                        "name": "client_secret",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Токен отозван или уже недействителен"
+                    },
+                    "400": {
+                        "description": "invalid_request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "invalid_client",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "temporarily_unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OAuthErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
+        "dto.APIKey": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "last_used_ip": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.Actor": {
+            "type": "object",
+            "properties": {
+                "sub": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.ConfirmEmailVerificationRequest": {
             "type": "object",
             "required": [
-                "code"
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConfirmPasswordResetRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "new_password"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "dto.ConfirmPhoneVerificationRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "dto.ConflictErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FieldError"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "не задан — бессрочный ключ",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "scopes": {
+                    "type": "array",
+                    "maxItems": 32,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "api_key": {
+                    "$ref": "#/definitions/dto.APIKey"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateGuestResponse": {
+            "type": "object",
+            "properties": {
+                "tokens": {
+                    "type": "object",
+                    "properties": {
+                        "access_expires_in": {
+                            "type": "integer"
+                        },
+                        "access_token": {
+                            "type": "string"
+                        },
+                        "refresh_expires_in": {
+                            "type": "integer"
+                        },
+                        "refresh_token": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateOAuthClientRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                }
+            }
+        },
+        "dto.CreateOAuthClientResponse": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "$ref": "#/definitions/dto.OAuthClient"
+                },
+                "client_secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DeleteAccountRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 72
+                }
+            }
+        },
+        "dto.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ForbiddenErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FieldError"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GraphQLError": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "dto.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
             ],
             "properties": {
-                "code": {
+                "operationName": {
                     "type": "string"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "{ me { email orders(limit: 5) { nodes { id status } } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
                 }
             }
         },
-        "dto.ConfirmPasswordResetRequest": {
+        "dto.GraphQLResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GraphQLError"
+                    }
+                }
+            }
+        },
+        "dto.ImpersonateRequest": {
             "type": "object",
             "required": [
-                "code",
-                "new_password"
+                "reason"
             ],
             "properties": {
-                "code": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 3
+                }
+            }
+        },
+        "dto.ImpersonateResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
                     "type": "string"
                 },
-                "new_password": {
-                    "type": "string",
-                    "minLength": 6
+                "actor_id": {
+                    "type": "string"
+                },
+                "exp_unix": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ConflictErrorResponse": {
+        "dto.InternalErrorResponse": {
             "type": "object",
             "properties": {
                 "code": {
@@ -504,21 +2917,214 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.FieldError": {
+        "dto.IntrospectTokenResponse": {
             "type": "object",
             "properties": {
-                "field": {
+                "act": {
+                    "description": "администратор, если токен выдан через impersonation",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.Actor"
+                        }
+                    ]
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "client_id": {
                     "type": "string"
                 },
-                "message": {
+                "exp": {
+                    "type": "integer"
+                },
+                "iat": {
+                    "type": "integer"
+                },
+                "jti": {
                     "type": "string"
                 },
-                "tag": {
+                "scope": {
+                    "description": "права через пробел",
+                    "type": "string"
+                },
+                "sub": {
+                    "type": "string"
+                },
+                "token_type": {
+                    "description": "access_token | refresh_token",
                     "type": "string"
                 }
             }
         },
-        "dto.InternalErrorResponse": {
+        "dto.ListAPIKeysResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.APIKey"
+                    }
+                }
+            }
+        },
+        "dto.ListOAuthClientsResponse": {
+            "type": "object",
+            "properties": {
+                "clients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OAuthClient"
+                    }
+                }
+            }
+        },
+        "dto.ListPermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Permission"
+                    }
+                }
+            }
+        },
+        "dto.ListTrustedDevicesResponse": {
+            "type": "object",
+            "properties": {
+                "devices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TrustedDevice"
+                    }
+                }
+            }
+        },
+        "dto.ListVendorApplicationsResponse": {
+            "type": "object",
+            "properties": {
+                "applications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VendorApplication"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                },
+                "refresh_cookie": {
+                    "description": "RefreshCookie — выдать refresh-токен в HttpOnly-cookie вместо тела ответа (для SPA)",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                },
+                "tokens": {
+                    "type": "object",
+                    "properties": {
+                        "access_expires_in": {
+                            "type": "integer"
+                        },
+                        "access_token": {
+                            "type": "string"
+                        },
+                        "refresh_expires_in": {
+                            "type": "integer"
+                        },
+                        "refresh_token": {
+                            "description": "пусто в cookie-режиме",
+                            "type": "string"
+                        },
+                        "token_type": {
+                            "description": "Bearer или DPoP",
+                            "type": "string"
+                        }
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "all": {
+                    "type": "boolean"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.MeResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "is_email_verified": {
+                    "type": "boolean"
+                },
+                "is_guest": {
+                    "type": "boolean"
+                },
+                "is_phone_verified": {
+                    "type": "boolean"
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "ru"
+                },
+                "marketing_consent": {
+                    "type": "boolean"
+                },
+                "marketing_consent_at": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotFoundErrorResponse": {
             "type": "object",
             "properties": {
                 "code": {
@@ -533,91 +3139,200 @@ const docTemplate = `{
                         "$ref": "#/definitions/dto.FieldError"
                     }
                 },
-                "message": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OAuthClient": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OAuthErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid_client"
+                },
+                "error_description": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OrderViewItem": {
+            "type": "object",
+            "properties": {
+                "currency_code": {
+                    "type": "string"
+                },
+                "line_total_cents": {
+                    "type": "integer"
+                },
+                "product": {
+                    "description": "null — товар удалён или каталог недоступен",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.OrderViewProduct"
+                        }
+                    ]
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "reorder": {
+                    "description": "null — неизвестно",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.OrderViewReorder"
+                        }
+                    ]
+                },
+                "stock": {
+                    "description": "null — остатки недоступны",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.OrderViewStock"
+                        }
+                    ]
+                },
+                "unit_price_cents": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.OrderViewProduct": {
+            "type": "object",
+            "properties": {
+                "currency_code": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price_cents": {
+                    "description": "текущая цена, может отличаться от цены в заказе",
+                    "type": "integer"
+                },
+                "sku": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.OrderViewReorder": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "eligible": {
+                    "type": "boolean"
                 },
-                "password": {
+                "reason": {
                     "type": "string",
-                    "minLength": 6
+                    "example": "insufficient_stock"
                 }
             }
         },
-        "dto.LoginResponse": {
+        "dto.OrderViewResponse": {
             "type": "object",
             "properties": {
-                "role": {
+                "can_reorder": {
+                    "description": "CanReorder — все позиции можно заказать снова; null — неизвестно (нет данных о товарах или остатках)",
+                    "type": "boolean"
+                },
+                "cancel_reason": {
                     "type": "string"
                 },
-                "tokens": {
-                    "type": "object",
-                    "properties": {
-                        "access_expires_in": {
-                            "type": "integer"
-                        },
-                        "access_token": {
-                            "type": "string"
-                        },
-                        "refresh_expires_in": {
-                            "type": "integer"
-                        },
-                        "refresh_token": {
-                            "type": "string"
-                        }
+                "created_at": {
+                    "type": "string"
+                },
+                "currency_code": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrderViewItem"
                     }
                 },
+                "partial": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "ORDER_STATUS_PENDING"
+                },
+                "total_price_cents": {
+                    "type": "integer"
+                },
+                "unavailable": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "stock"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.LogoutRequest": {
+        "dto.OrderViewStock": {
             "type": "object",
             "properties": {
-                "all": {
+                "available": {
+                    "type": "integer"
+                },
+                "in_stock": {
                     "type": "boolean"
                 },
-                "refresh_token": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.NotFoundErrorResponse": {
+        "dto.Permission": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "string"
                 },
-                "details": {
-                    "type": "string"
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.FieldError"
-                    }
-                },
-                "message": {
+                "description": {
                     "type": "string"
                 }
             }
         },
         "dto.RefreshRequest": {
             "type": "object",
-            "required": [
-                "refresh_token"
-            ],
             "properties": {
                 "refresh_token": {
                     "type": "string"
@@ -640,6 +3355,11 @@ const docTemplate = `{
                             "type": "integer"
                         },
                         "refresh_token": {
+                            "description": "пусто в cookie-режиме",
+                            "type": "string"
+                        },
+                        "token_type": {
+                            "description": "Bearer или DPoP",
                             "type": "string"
                         }
                     }
@@ -679,6 +3399,28 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.RejectVendorApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "dto.ReportSignInRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 20
+                }
+            }
+        },
         "dto.RequestPasswordResetRequest": {
             "type": "object",
             "required": [
@@ -690,6 +3432,104 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.RequestPhoneVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T12:10:00Z"
+                }
+            }
+        },
+        "dto.RolePermissionRequest": {
+            "type": "object",
+            "required": [
+                "permission"
+            ],
+            "properties": {
+                "permission": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "dto.RolePermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ServiceUnavailableErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FieldError"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SetUserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "maxLength": 32
+                }
+            }
+        },
+        "dto.SubmitVendorApplicationRequest": {
+            "type": "object",
+            "required": [
+                "company_name",
+                "tax_id"
+            ],
+            "properties": {
+                "company_name": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 2
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "tax_id": {
+                    "type": "string",
+                    "maxLength": 12,
+                    "minLength": 10
+                },
+                "website": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "dto.SuccessResponse": {
             "type": "object",
             "properties": {
@@ -718,6 +3558,29 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TrustedDevice": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "first_seen_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_network": {
+                    "type": "string"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.UnauthorizedErrorResponse": {
             "type": "object",
             "properties": {
@@ -738,6 +3601,58 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateMeRequest": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en-US"
+                },
+                "marketing_consent": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+79991234567"
+                },
+                "time_zone": {
+                    "type": "string",
+                    "example": "Europe/Moscow"
+                }
+            }
+        },
+        "dto.UpgradeGuestRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8
+                }
+            }
+        },
+        "dto.UpgradeGuestResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.ValidationErrorResponse": {
             "type": "object",
             "properties": {
@@ -757,9 +3672,77 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dto.VendorApplication": {
+            "type": "object",
+            "properties": {
+                "company_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "reject_reason": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "PENDING | APPROVED | REJECTED",
+                    "type": "string"
+                },
+                "tax_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "orderstatus.Event": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "previous_status": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
+        "BasicAuth": {
+            "type": "basic"
+        },
         "BearerAuth": {
             "type": "apiKey",
             "name": "Authorization",
@@ -4,17 +4,26 @@ import (
 	"api-gateway/internal/dto"
 	"context"
 	"strings"
+	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
+	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Client обёртка над gRPC AuthServiceClient, инкапсулирующая маппинг
 // HTTP DTO <-> gRPC proto. Добавлять сюда методы: Register, Login, Refresh и т.д.
 type Client struct {
-	grpc authv1.AuthServiceClient
+	grpc     authv1.AuthServiceClient
+	keyCache *apikey.Cache
 }
 
-func NewClient(grpcClient authv1.AuthServiceClient) *Client { return &Client{grpc: grpcClient} }
+func NewClient(grpcClient authv1.AuthServiceClient) *Client {
+	return &Client{grpc: grpcClient, keyCache: apikey.NewCache(apikey.DefaultCacheTTL)}
+}
 
 func (c *Client) Register(ctx context.Context, in dto.RegisterRequest) (*dto.RegisterResponse, error) {
 	req := &authv1.RegisterRequest{
@@ -40,9 +49,12 @@ func (c *Client) Login(ctx context.Context, in dto.LoginRequest) (*dto.LoginResp
 	req := &authv1.LoginRequest{
 		Email:    in.Email,
 		Password: in.Password,
+		Dpop:     toProtoDPoP(in.DPoP),
 	}
 
-	resp, err := c.grpc.Login(ctx, req)
+	var trailer metadata.MD
+	resp, err := c.grpc.Login(ctx, req, grpc.Trailer(&trailer))
+	readDPoPNonce(in.DPoP, trailer)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +69,7 @@ func (c *Client) Login(ctx context.Context, in dto.LoginRequest) (*dto.LoginResp
 		out.Tokens.RefreshToken = t.GetRefreshToken()
 		out.Tokens.AccessExpiresIn = t.GetAccessExpiresIn()
 		out.Tokens.RefreshExpiresIn = t.GetRefreshExpiresIn()
+		out.Tokens.TokenType = t.GetTokenType()
 	}
 
 	return out, nil
@@ -65,9 +78,12 @@ func (c *Client) Login(ctx context.Context, in dto.LoginRequest) (*dto.LoginResp
 func (c *Client) Refresh(ctx context.Context, in dto.RefreshRequest) (*dto.RefreshResponse, error) {
 	req := &authv1.RefreshRequest{
 		RefreshToken: in.RefreshToken,
+		Dpop:         toProtoDPoP(in.DPoP),
 	}
 
-	resp, err := c.grpc.Refresh(ctx, req)
+	var trailer metadata.MD
+	resp, err := c.grpc.Refresh(ctx, req, grpc.Trailer(&trailer))
+	readDPoPNonce(in.DPoP, trailer)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +94,7 @@ func (c *Client) Refresh(ctx context.Context, in dto.RefreshRequest) (*dto.Refre
 		out.Tokens.RefreshToken = t.GetRefreshToken()
 		out.Tokens.AccessExpiresIn = t.GetAccessExpiresIn()
 		out.Tokens.RefreshExpiresIn = t.GetRefreshExpiresIn()
+		out.Tokens.TokenType = t.GetTokenType()
 	}
 	return out, nil
 }
@@ -136,9 +153,12 @@ func (c *Client) GetJwks(ctx context.Context) (*dto.GetJwksResponse, error) {
 func (c *Client) Introspect(ctx context.Context, in dto.IntrospectRequest) (*dto.IntrospectResponse, error) {
 	req := &authv1.IntrospectRequest{
 		AccessToken: in.AccessToken,
+		Dpop:        toProtoDPoP(in.DPoP),
 	}
 
-	resp, err := c.grpc.Introspect(ctx, req)
+	var trailer metadata.MD
+	resp, err := c.grpc.Introspect(ctx, req, grpc.Trailer(&trailer))
+	readDPoPNonce(in.DPoP, trailer)
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +172,28 @@ func (c *Client) Introspect(ctx context.Context, in dto.IntrospectRequest) (*dto
 	if scopes := resp.GetScopes(); len(scopes) > 0 {
 		out.Scopes = append(out.Scopes, scopes...)
 	}
+	out.ActorId = resp.GetActorId().GetValue()
+	out.Jkt = resp.GetJkt()
 	return out, nil
 }
 
+func toProtoDPoP(p *dto.DPoPProof) *authv1.DPoPProof {
+	if p == nil {
+		return nil
+	}
+	return &authv1.DPoPProof{Proof: p.Proof, Htm: p.Method, Htu: p.URL}
+}
+
+// readDPoPNonce переносит nonce из трейлера "dpop-nonce" в p; auth-service отдаёт его и с ошибкой
+func readDPoPNonce(p *dto.DPoPProof, trailer metadata.MD) {
+	if p == nil {
+		return
+	}
+	if v := trailer.Get("dpop-nonce"); len(v) > 0 {
+		p.Nonce = v[0]
+	}
+}
+
 func (c *Client) Logout(ctx context.Context, in dto.LogoutRequest) error {
 	var req *authv1.LogoutRequest
 	if rt := strings.TrimSpace(in.RefreshToken); rt != "" {
@@ -189,3 +228,460 @@ func (c *Client) ConfirmEmailVerification(ctx context.Context, in dto.ConfirmEma
 	}
 	return nil
 }
+
+// parseRole принимает роль в виде "vendor" или "ROLE_VENDOR".
+func parseRole(role string) commonv1.Role {
+	name := strings.ToUpper(strings.TrimSpace(role))
+	if !strings.HasPrefix(name, "ROLE_") {
+		name = "ROLE_" + name
+	}
+	return commonv1.Role(commonv1.Role_value[name])
+}
+
+func (c *Client) ListPermissions(ctx context.Context) (*dto.ListPermissionsResponse, error) {
+	resp, err := c.grpc.ListPermissions(ctx, &authv1.ListPermissionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.ListPermissionsResponse{Permissions: make([]dto.Permission, 0, len(resp.GetPermissions()))}
+	for _, p := range resp.GetPermissions() {
+		out.Permissions = append(out.Permissions, dto.Permission{Code: p.GetCode(), Description: p.GetDescription()})
+	}
+	return out, nil
+}
+
+func (c *Client) ListRolePermissions(ctx context.Context, role string) (*dto.RolePermissionsResponse, error) {
+	resp, err := c.grpc.ListRolePermissions(ctx, &authv1.ListRolePermissionsRequest{Role: parseRole(role)})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.RolePermissionsResponse{
+		Role:        resp.GetRole().String(),
+		Permissions: append([]string{}, resp.GetPermissions()...),
+	}
+	return out, nil
+}
+
+func (c *Client) GrantRolePermission(ctx context.Context, role, permission string) error {
+	_, err := c.grpc.GrantRolePermission(ctx, &authv1.GrantRolePermissionRequest{Role: parseRole(role), Permission: permission})
+	return err
+}
+
+func (c *Client) RevokeRolePermission(ctx context.Context, role, permission string) error {
+	_, err := c.grpc.RevokeRolePermission(ctx, &authv1.RevokeRolePermissionRequest{Role: parseRole(role), Permission: permission})
+	return err
+}
+
+func (c *Client) DeleteAccount(ctx context.Context, in dto.DeleteAccountRequest) error {
+	_, err := c.grpc.DeleteAccount(ctx, &authv1.DeleteAccountRequest{Password: in.Password})
+	return err
+}
+
+// ExportMyData возвращает JSON-архив данных пользователя как есть.
+func (c *Client) ExportMyData(ctx context.Context) ([]byte, error) {
+	resp, err := c.grpc.ExportMyData(ctx, &authv1.ExportMyDataRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
+
+func (c *Client) GetMe(ctx context.Context) (*dto.MeResponse, error) {
+	resp, err := c.grpc.GetMe(ctx, &authv1.GetMeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return toMeDTO(resp), nil
+}
+
+func (c *Client) UpdateMe(ctx context.Context, in dto.UpdateMeRequest) (*dto.MeResponse, error) {
+	resp, err := c.grpc.UpdateMe(ctx, &authv1.UpdateMeRequest{
+		DisplayName:      in.DisplayName,
+		Phone:            in.Phone,
+		Locale:           in.Locale,
+		TimeZone:         in.TimeZone,
+		MarketingConsent: in.MarketingConsent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toMeDTO(resp), nil
+}
+
+func toMeDTO(m *authv1.Me) *dto.MeResponse {
+	const layout = "2006-01-02T15:04:05Z07:00"
+	out := &dto.MeResponse{
+		UserId:           m.GetUserId().GetValue(),
+		Email:            m.GetEmail(),
+		Role:             m.GetRole().String(),
+		IsEmailVerified:  m.GetIsEmailVerified(),
+		IsGuest:          m.GetIsGuest(),
+		DisplayName:      m.GetDisplayName(),
+		Phone:            m.GetPhone(),
+		IsPhoneVerified:  m.GetIsPhoneVerified(),
+		Locale:           m.GetLocale(),
+		TimeZone:         m.GetTimeZone(),
+		MarketingConsent: m.GetMarketingConsent(),
+		CreatedAt:        m.GetCreatedAt().AsTime().Format(layout),
+	}
+	if m.GetMarketingConsentAt() != nil {
+		out.MarketingConsentAt = m.GetMarketingConsentAt().AsTime().Format(layout)
+	}
+	return out
+}
+
+func (c *Client) RequestPhoneVerification(ctx context.Context) (*dto.RequestPhoneVerificationResponse, error) {
+	resp, err := c.grpc.RequestPhoneVerification(ctx, &authv1.RequestPhoneVerificationRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RequestPhoneVerificationResponse{
+		ExpiresAt: resp.GetExpiresAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+func (c *Client) ConfirmPhoneVerification(ctx context.Context, in dto.ConfirmPhoneVerificationRequest) error {
+	_, err := c.grpc.ConfirmPhoneVerification(ctx, &authv1.ConfirmPhoneVerificationRequest{Code: in.Code})
+	return err
+}
+
+func (c *Client) CreateGuest(ctx context.Context) (*dto.CreateGuestResponse, error) {
+	resp, err := c.grpc.CreateGuest(ctx, &authv1.CreateGuestRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.CreateGuestResponse{UserId: resp.GetUserId().GetValue()}
+	if t := resp.GetTokens(); t != nil {
+		out.Tokens.AccessToken = t.GetAccessToken()
+		out.Tokens.RefreshToken = t.GetRefreshToken()
+		out.Tokens.AccessExpiresIn = t.GetAccessExpiresIn()
+		out.Tokens.RefreshExpiresIn = t.GetRefreshExpiresIn()
+	}
+	return out, nil
+}
+
+func (c *Client) UpgradeGuest(ctx context.Context, in dto.UpgradeGuestRequest) (*dto.UpgradeGuestResponse, error) {
+	resp, err := c.grpc.UpgradeGuest(ctx, &authv1.UpgradeGuestRequest{
+		Email:    in.Email,
+		Password: in.Password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.UpgradeGuestResponse{
+		UserId: resp.GetUserId().GetValue(),
+		Email:  resp.GetEmail(),
+	}, nil
+}
+
+func (c *Client) SubmitVendorApplication(ctx context.Context, in dto.SubmitVendorApplicationRequest) (*dto.VendorApplication, error) {
+	resp, err := c.grpc.SubmitVendorApplication(ctx, &authv1.SubmitVendorApplicationRequest{
+		CompanyName: in.CompanyName,
+		TaxId:       in.TaxID,
+		Website:     in.Website,
+		Phone:       in.Phone,
+		Description: in.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := toVendorApplicationDTO(resp)
+	return &out, nil
+}
+
+// ListVendorApplications — status: PENDING/APPROVED/REJECTED или пусто (все заявки)
+func (c *Client) ListVendorApplications(ctx context.Context, status string, limit, offset int32) (*dto.ListVendorApplicationsResponse, error) {
+	resp, err := c.grpc.ListVendorApplications(ctx, &authv1.ListVendorApplicationsRequest{
+		Limit:  limit,
+		Offset: offset,
+		Status: parseVendorStatus(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dto.ListVendorApplicationsResponse{
+		Applications: make([]dto.VendorApplication, 0, len(resp.GetApplications())),
+		Total:        resp.GetTotal(),
+	}
+	for _, a := range resp.GetApplications() {
+		out.Applications = append(out.Applications, toVendorApplicationDTO(a))
+	}
+	return out, nil
+}
+
+func (c *Client) ApproveVendorApplication(ctx context.Context, id string) (*dto.VendorApplication, error) {
+	resp, err := c.grpc.ApproveVendorApplication(ctx, &authv1.ApproveVendorApplicationRequest{Id: &commonv1.UUID{Value: id}})
+	if err != nil {
+		return nil, err
+	}
+	out := toVendorApplicationDTO(resp)
+	return &out, nil
+}
+
+func (c *Client) RejectVendorApplication(ctx context.Context, id, reason string) (*dto.VendorApplication, error) {
+	resp, err := c.grpc.RejectVendorApplication(ctx, &authv1.RejectVendorApplicationRequest{Id: &commonv1.UUID{Value: id}, Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+	out := toVendorApplicationDTO(resp)
+	return &out, nil
+}
+
+// parseVendorStatus принимает "pending" и "VENDOR_APPLICATION_STATUS_PENDING";
+// неизвестное значение уходит в auth-service как есть и отклоняется валидацией.
+func parseVendorStatus(s string) authv1.VendorApplicationStatus {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "" {
+		return authv1.VendorApplicationStatus_VENDOR_APPLICATION_STATUS_UNSPECIFIED
+	}
+	if !strings.HasPrefix(s, "VENDOR_APPLICATION_STATUS_") {
+		s = "VENDOR_APPLICATION_STATUS_" + s
+	}
+	if v, ok := authv1.VendorApplicationStatus_value[s]; ok {
+		return authv1.VendorApplicationStatus(v)
+	}
+	return authv1.VendorApplicationStatus(-1)
+}
+
+func toVendorApplicationDTO(a *authv1.VendorApplication) dto.VendorApplication {
+	out := dto.VendorApplication{
+		ID:           a.GetId().GetValue(),
+		UserID:       a.GetUserId().GetValue(),
+		CompanyName:  a.GetCompanyName(),
+		TaxID:        a.GetTaxId(),
+		Website:      a.GetWebsite(),
+		Phone:        a.GetPhone(),
+		Description:  a.GetDescription(),
+		Status:       strings.TrimPrefix(a.GetStatus().String(), "VENDOR_APPLICATION_STATUS_"),
+		RejectReason: a.GetRejectReason(),
+		CreatedAt:    a.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if a.GetReviewedAt() != nil {
+		out.ReviewedAt = a.GetReviewedAt().AsTime().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return out
+}
+
+func (c *Client) SetUserRole(ctx context.Context, userID, role string) error {
+	_, err := c.grpc.SetUserRole(ctx, &authv1.SetUserRoleRequest{UserId: &commonv1.UUID{Value: userID}, Role: parseRole(role)})
+	return err
+}
+
+func (c *Client) DisableUser(ctx context.Context, userID string) error {
+	_, err := c.grpc.DisableUser(ctx, &authv1.DisableUserRequest{UserId: &commonv1.UUID{Value: userID}})
+	return err
+}
+
+func (c *Client) Impersonate(ctx context.Context, userID, reason string) (*dto.ImpersonateResponse, error) {
+	resp, err := c.grpc.Impersonate(ctx, &authv1.ImpersonateRequest{UserId: &commonv1.UUID{Value: userID}, Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ImpersonateResponse{
+		AccessToken: resp.GetAccessToken(),
+		ExpUnix:     resp.GetExpUnix(),
+		UserID:      userID,
+		ActorID:     resp.GetActorId().GetValue(),
+	}, nil
+}
+
+// ResolveAPIKey проверяет API-ключ; успешный результат кэшируется на apikey.DefaultCacheTTL,
+// поэтому last_used в auth-service обновляется не чаще раза за этот интервал.
+func (c *Client) ResolveAPIKey(ctx context.Context, key, ip string) (*dto.IntrospectResponse, error) {
+	if id, ok := c.keyCache.Get(key); ok {
+		return identityToIntrospect(id), nil
+	}
+
+	resp, err := c.grpc.ResolveApiKey(ctx, &authv1.ResolveApiKeyRequest{Key: key, Ip: ip})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetActive() {
+		return &dto.IntrospectResponse{Active: false}, nil
+	}
+
+	id := apikey.Identity{
+		KeyID:  resp.GetKeyId().GetValue(),
+		UserID: resp.GetUserId().GetValue(),
+		Role:   resp.GetRole().String(),
+		Scopes: resp.GetScopes(),
+	}
+	if resp.GetExpUnix() > 0 {
+		id.ExpiresAt = time.Unix(resp.GetExpUnix(), 0)
+	}
+	c.keyCache.Set(key, id)
+	return identityToIntrospect(id), nil
+}
+
+func identityToIntrospect(id apikey.Identity) *dto.IntrospectResponse {
+	out := &dto.IntrospectResponse{
+		Active: true,
+		UserId: id.UserID,
+		Role:   id.Role,
+		Scopes: append([]string(nil), id.Scopes...),
+		KeyId:  id.KeyID,
+	}
+	if !id.ExpiresAt.IsZero() {
+		out.ExpUnix = id.ExpiresAt.Unix()
+	}
+	return out
+}
+
+func (c *Client) CreateAPIKey(ctx context.Context, in dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	req := &authv1.CreateApiKeyRequest{Name: strings.TrimSpace(in.Name), Scopes: in.Scopes}
+	if in.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*in.ExpiresAt)
+	}
+	resp, err := c.grpc.CreateApiKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreateAPIKeyResponse{APIKey: toAPIKeyDTO(resp.GetApiKey()), Key: resp.GetKey()}, nil
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context) (*dto.ListAPIKeysResponse, error) {
+	resp, err := c.grpc.ListApiKeys(ctx, &authv1.ListApiKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+	out := &dto.ListAPIKeysResponse{Keys: make([]dto.APIKey, 0, len(resp.GetKeys()))}
+	for _, k := range resp.GetKeys() {
+		out.Keys = append(out.Keys, toAPIKeyDTO(k))
+	}
+	return out, nil
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, id string) error {
+	_, err := c.grpc.RevokeApiKey(ctx, &authv1.RevokeApiKeyRequest{Id: &commonv1.UUID{Value: id}})
+	return err
+}
+
+func toAPIKeyDTO(k *authv1.ApiKey) dto.APIKey {
+	const layout = "2006-01-02T15:04:05Z07:00"
+	out := dto.APIKey{
+		ID:         k.GetId().GetValue(),
+		Name:       k.GetName(),
+		Prefix:     k.GetPrefix(),
+		Scopes:     k.GetScopes(),
+		CreatedAt:  k.GetCreatedAt().AsTime().Format(layout),
+		LastUsedIP: k.GetLastUsedIp(),
+	}
+	if k.GetExpiresAt() != nil {
+		out.ExpiresAt = k.GetExpiresAt().AsTime().Format(layout)
+	}
+	if k.GetLastUsedAt() != nil {
+		out.LastUsedAt = k.GetLastUsedAt().AsTime().Format(layout)
+	}
+	if k.GetRevokedAt() != nil {
+		out.RevokedAt = k.GetRevokedAt().AsTime().Format(layout)
+	}
+	return out
+}
+
+func (c *Client) ListTrustedDevices(ctx context.Context) (*dto.ListTrustedDevicesResponse, error) {
+	resp, err := c.grpc.ListTrustedDevices(ctx, &authv1.ListTrustedDevicesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	const layout = "2006-01-02T15:04:05Z07:00"
+	out := &dto.ListTrustedDevicesResponse{Devices: make([]dto.TrustedDevice, 0, len(resp.GetDevices()))}
+	for _, d := range resp.GetDevices() {
+		out.Devices = append(out.Devices, dto.TrustedDevice{
+			ID:          d.GetId().GetValue(),
+			ClientID:    d.GetClientId(),
+			IPNetwork:   d.GetIpNetwork(),
+			UserAgent:   d.GetUserAgent(),
+			FirstSeenAt: d.GetFirstSeenAt().AsTime().Format(layout),
+			LastSeenAt:  d.GetLastSeenAt().AsTime().Format(layout),
+		})
+	}
+	return out, nil
+}
+
+func (c *Client) ForgetTrustedDevice(ctx context.Context, id string) error {
+	_, err := c.grpc.ForgetTrustedDevice(ctx, &authv1.ForgetTrustedDeviceRequest{Id: &commonv1.UUID{Value: id}})
+	return err
+}
+
+func (c *Client) ReportSignIn(ctx context.Context, in dto.ReportSignInRequest) error {
+	_, err := c.grpc.ReportSignIn(ctx, &authv1.ReportSignInRequest{Token: strings.TrimSpace(in.Token)})
+	return err
+}
+
+// IntrospectToken — интроспекция токена по RFC 7662 от имени стороннего resource server'а
+func (c *Client) IntrospectToken(ctx context.Context, clientID, clientSecret, token, hint string) (*dto.IntrospectTokenResponse, error) {
+	resp, err := c.grpc.IntrospectToken(ctx, &authv1.IntrospectTokenRequest{
+		Client:        &authv1.OAuthClientCredentials{ClientId: clientID, ClientSecret: clientSecret},
+		Token:         token,
+		TokenTypeHint: hint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &dto.IntrospectTokenResponse{
+		Active:    resp.GetActive(),
+		Scope:     resp.GetScope(),
+		ClientID:  resp.GetClientId(),
+		Sub:       resp.GetSub(),
+		Iat:       resp.GetIat(),
+		Exp:       resp.GetExp(),
+		Jti:       resp.GetJti(),
+		TokenType: resp.GetTokenType(),
+	}
+	if resp.GetActSub() != "" {
+		out.Act = &dto.Actor{Sub: resp.GetActSub()}
+	}
+	return out, nil
+}
+
+// RevokeToken — отзыв токена по RFC 7009 от имени стороннего resource server'а
+func (c *Client) RevokeToken(ctx context.Context, clientID, clientSecret, token, hint string) error {
+	_, err := c.grpc.RevokeToken(ctx, &authv1.RevokeTokenRequest{
+		Client:        &authv1.OAuthClientCredentials{ClientId: clientID, ClientSecret: clientSecret},
+		Token:         token,
+		TokenTypeHint: hint,
+	})
+	return err
+}
+
+func (c *Client) CreateOAuthClient(ctx context.Context, in dto.CreateOAuthClientRequest) (*dto.CreateOAuthClientResponse, error) {
+	resp, err := c.grpc.CreateOAuthClient(ctx, &authv1.CreateOAuthClientRequest{Name: strings.TrimSpace(in.Name)})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreateOAuthClientResponse{Client: toOAuthClientDTO(resp.GetClient()), ClientSecret: resp.GetClientSecret()}, nil
+}
+
+func (c *Client) ListOAuthClients(ctx context.Context) (*dto.ListOAuthClientsResponse, error) {
+	resp, err := c.grpc.ListOAuthClients(ctx, &authv1.ListOAuthClientsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	out := &dto.ListOAuthClientsResponse{Clients: make([]dto.OAuthClient, 0, len(resp.GetClients()))}
+	for _, cl := range resp.GetClients() {
+		out.Clients = append(out.Clients, toOAuthClientDTO(cl))
+	}
+	return out, nil
+}
+
+func (c *Client) DeleteOAuthClient(ctx context.Context, clientID string) error {
+	_, err := c.grpc.DeleteOAuthClient(ctx, &authv1.DeleteOAuthClientRequest{ClientId: clientID})
+	return err
+}
+
+func toOAuthClientDTO(cl *authv1.OAuthClient) dto.OAuthClient {
+	const layout = "2006-01-02T15:04:05Z07:00"
+	out := dto.OAuthClient{
+		ClientID:  cl.GetClientId(),
+		Name:      cl.GetName(),
+		CreatedAt: cl.GetCreatedAt().AsTime().Format(layout),
+	}
+	if cl.GetLastUsedAt() != nil {
+		out.LastUsedAt = cl.GetLastUsedAt().AsTime().Format(layout)
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// CreateAPIKeyRequest — новый персональный API-ключ
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,min=1,max=100"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1,max=32,dive,min=1,max=64"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // не задан — бессрочный ключ
+}
+
+type APIKey struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  string   `json:"created_at"`
+	ExpiresAt  string   `json:"expires_at,omitempty"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+	LastUsedIP string   `json:"last_used_ip,omitempty"`
+	RevokedAt  string   `json:"revoked_at,omitempty"`
+}
+
+// CreateAPIKeyResponse — ключ возвращается открыто только один раз
+type CreateAPIKeyResponse struct {
+	APIKey APIKey `json:"api_key"`
+	Key    string `json:"key"`
+}
+
+type ListAPIKeysResponse struct {
+	Keys []APIKey `json:"keys"`
+}
@@ -15,11 +15,35 @@ type RegisterResponse struct {
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=6"`
+	// RefreshCookie — выдать refresh-токен в HttpOnly-cookie вместо тела ответа (для SPA)
+	RefreshCookie bool       `json:"refresh_cookie"`
+	DPoP          *DPoPProof `json:"-"`
 }
 
 type LoginResponse struct {
 	UserId string `json:"user_id"`
 	Role   string `json:"role"`
+	Tokens struct {
+		AccessToken      string `json:"access_token"`
+		RefreshToken     string `json:"refresh_token,omitempty"` // пусто в cookie-режиме
+		AccessExpiresIn  int64  `json:"access_expires_in"`
+		RefreshExpiresIn int64  `json:"refresh_expires_in"`
+		TokenType        string `json:"token_type"` // Bearer или DPoP
+	} `json:"tokens"`
+}
+
+// DPoPProof — доказательство владения ключом из заголовка DPoP (RFC 9449).
+// Nonce заполняет клиент auth-service: это nonce для следующего доказательства.
+type DPoPProof struct {
+	Proof  string
+	Method string
+	URL    string
+	Nonce  string
+}
+
+// CreateGuestResponse — анонимный покупатель и его токены
+type CreateGuestResponse struct {
+	UserId string `json:"user_id"`
 	Tokens struct {
 		AccessToken      string `json:"access_token"`
 		RefreshToken     string `json:"refresh_token"`
@@ -28,19 +52,35 @@ type LoginResponse struct {
 	} `json:"tokens"`
 }
 
+// UpgradeGuestRequest — email и пароль, которые закрепляются за гостевым аккаунтом
+type UpgradeGuestRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8,max=72"`
+}
+
+type UpgradeGuestResponse struct {
+	UserId string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// RefreshRequest — refresh_token в теле; в cookie-режиме тело можно не передавать, токен
+// берётся из refresh-cookie
 type RefreshRequest struct {
-	RefreshToken string `json:"refresh_token" binding:"required"`
+	RefreshToken string     `json:"refresh_token"`
+	DPoP         *DPoPProof `json:"-"`
 }
 
 type RefreshResponse struct {
 	Tokens struct {
 		AccessToken      string `json:"access_token"`
-		RefreshToken     string `json:"refresh_token"`
+		RefreshToken     string `json:"refresh_token,omitempty"` // пусто в cookie-режиме
 		AccessExpiresIn  int64  `json:"access_expires_in"`
 		RefreshExpiresIn int64  `json:"refresh_expires_in"`
+		TokenType        string `json:"token_type"` // Bearer или DPoP
 	} `json:"tokens"`
 }
 
+// LogoutRequest — без refresh_token и all=true используется refresh-cookie
 type LogoutRequest struct {
 	RefreshToken string `json:"refresh_token"`
 	All          bool   `json:"all"`
@@ -60,7 +100,8 @@ type Jwk struct {
 }
 
 type IntrospectRequest struct {
-	AccessToken string `json:"access_token" binding:"required"`
+	AccessToken string     `json:"access_token" binding:"required"`
+	DPoP        *DPoPProof `json:"-"` // токен предъявлен со схемой DPoP
 }
 
 type IntrospectResponse struct {
@@ -69,6 +110,9 @@ type IntrospectResponse struct {
 	Role    string   `json:"role"`
 	ExpUnix int64    `json:"exp_unix"`
 	Scopes  []string `json:"scopes"`
+	ActorId string   `json:"actor_id,omitempty"` // администратор, если токен выдан через имперсонацию
+	Jkt     string   `json:"jkt,omitempty"`      // отпечаток ключа, к которому привязан токен
+	KeyId   string   `json:"key_id,omitempty"`   // API-ключ, если запрос аутентифицирован им
 }
 
 type RequestPasswordResetRequest struct {
@@ -94,3 +138,7 @@ type ConfirmPasswordResetRequest struct {
 type ConfirmEmailVerificationRequest struct {
 	Code string `json:"code" binding:"required"`
 }
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required,max=72"`
+}
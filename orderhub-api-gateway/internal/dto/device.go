@@ -0,0 +1,20 @@
+package dto
+
+// TrustedDevice — браузер (cid) и сеть, из которых пользователь уже входил
+type TrustedDevice struct {
+	ID          string `json:"id"`
+	ClientID    string `json:"client_id"`
+	IPNetwork   string `json:"ip_network"`
+	UserAgent   string `json:"user_agent,omitempty"`
+	FirstSeenAt string `json:"first_seen_at"`
+	LastSeenAt  string `json:"last_seen_at"`
+}
+
+type ListTrustedDevicesResponse struct {
+	Devices []TrustedDevice `json:"devices"`
+}
+
+// ReportSignInRequest — токен из ссылки «это был не я» в письме о новом входе
+type ReportSignInRequest struct {
+	Token string `json:"token" binding:"required,min=20,max=128"`
+}
@@ -62,6 +62,11 @@ type InternalErrorResponse BaseError
 
 type TooManyRequestsErrorResponse BaseError
 
+// ServiceUnavailableErrorResponse 503
+// Пример: auth-service недоступен или его breaker разомкнут
+// Code: "service_unavailable"
+type ServiceUnavailableErrorResponse BaseError
+
 // Helper-функции для быстрого создания
 func NewValidationError(msg string, fields []FieldError) ValidationErrorResponse {
 	return ValidationErrorResponse(BaseError{Code: "validation_error", Message: msg, Fields: fields})
@@ -84,6 +89,15 @@ func NewRateLimitedError(msg string) RateLimitedErrorResponse {
 func NewTooManyRequestsError(msg string) TooManyRequestsErrorResponse {
 	return TooManyRequestsErrorResponse(BaseError{Code: "too_many_requests", Message: msg})
 }
+func NewCSRFError(msg string) ForbiddenErrorResponse {
+	return ForbiddenErrorResponse(BaseError{Code: "csrf_token_invalid", Message: msg})
+}
+func NewDPoPError(code, msg string) UnauthorizedErrorResponse {
+	return UnauthorizedErrorResponse(BaseError{Code: code, Message: msg})
+}
+func NewServiceUnavailableError(msg string) ServiceUnavailableErrorResponse {
+	return ServiceUnavailableErrorResponse(BaseError{Code: "service_unavailable", Message: msg})
+}
 func NewInternalError(details string) InternalErrorResponse {
 	return InternalErrorResponse(BaseError{Code: "internal_error", Message: "internal server error", Details: details})
 }
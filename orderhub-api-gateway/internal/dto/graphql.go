@@ -0,0 +1,21 @@
+package dto
+
+// GraphQLRequest — тело POST /graphql
+type GraphQLRequest struct {
+	Query         string         `json:"query" binding:"required" example:"{ me { email orders(limit: 5) { nodes { id status } } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse — ответ GraphQL: data и/или errors. Ошибки резолверов несут код
+// в extensions.code (UNAUTHENTICATED, FORBIDDEN, SERVICE_UNAVAILABLE, QUERY_TOO_COMPLEX…).
+type GraphQLResponse struct {
+	Data   map[string]any `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+// IntrospectTokenResponse — ответ RFC 7662. Для недействительного токена — только active=false.
+type IntrospectTokenResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"` // права через пробел
+	ClientID  string `json:"client_id,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+	TokenType string `json:"token_type,omitempty"` // access_token | refresh_token
+	Act       *Actor `json:"act,omitempty"`        // администратор, если токен выдан через impersonation
+}
+
+// Actor — claim act (RFC 8693)
+type Actor struct {
+	Sub string `json:"sub"`
+}
+
+// OAuthErrorResponse — ошибка в формате RFC 6749, раздел 5.2
+type OAuthErrorResponse struct {
+	Error            string `json:"error" example:"invalid_client"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// CreateOAuthClientRequest — регистрация стороннего resource server'а
+type CreateOAuthClientRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100"`
+}
+
+type OAuthClient struct {
+	ClientID   string `json:"client_id"`
+	Name       string `json:"name"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at,omitempty"`
+}
+
+// CreateOAuthClientResponse — секрет возвращается открыто только один раз
+type CreateOAuthClientResponse struct {
+	Client       OAuthClient `json:"client"`
+	ClientSecret string      `json:"client_secret"`
+}
+
+type ListOAuthClientsResponse struct {
+	Clients []OAuthClient `json:"clients"`
+}
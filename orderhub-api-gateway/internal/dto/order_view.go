@@ -0,0 +1,69 @@
+package dto
+
+// Причины, по которым позицию нельзя заказать повторно
+const (
+	ReorderProductNotFound   = "product_not_found"
+	ReorderProductInactive   = "product_inactive"
+	ReorderOutOfStock        = "out_of_stock"
+	ReorderInsufficientStock = "insufficient_stock"
+)
+
+// Части страницы заказа, которые не удалось получить (поле unavailable)
+const (
+	OrderViewPartProducts = "products"
+	OrderViewPartStock    = "stock"
+)
+
+// OrderViewResponse — заказ вместе с данными товаров для страницы заказа. Если inventory-service
+// не ответил, заказ всё равно возвращается: partial=true, в unavailable — чего не хватает,
+// а зависящие от этого поля равны null.
+type OrderViewResponse struct {
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id"`
+	Status          string          `json:"status" example:"ORDER_STATUS_PENDING"`
+	TotalPriceCents int64           `json:"total_price_cents"`
+	CurrencyCode    string          `json:"currency_code" example:"RUB"`
+	CancelReason    string          `json:"cancel_reason,omitempty"`
+	CreatedAt       string          `json:"created_at"`
+	UpdatedAt       string          `json:"updated_at"`
+	Items           []OrderViewItem `json:"items"`
+	// CanReorder — все позиции можно заказать снова; null — неизвестно (нет данных о товарах или остатках)
+	CanReorder  *bool    `json:"can_reorder"`
+	Partial     bool     `json:"partial"`
+	Unavailable []string `json:"unavailable,omitempty" example:"stock"`
+}
+
+// OrderViewItem — позиция заказа по цене на момент покупки и текущее состояние товара
+type OrderViewItem struct {
+	ProductID      string            `json:"product_id"`
+	Quantity       uint32            `json:"quantity"`
+	UnitPriceCents int64             `json:"unit_price_cents"`
+	LineTotalCents int64             `json:"line_total_cents"`
+	CurrencyCode   string            `json:"currency_code"`
+	Product        *OrderViewProduct `json:"product"` // null — товар удалён или каталог недоступен
+	Stock          *OrderViewStock   `json:"stock"`   // null — остатки недоступны
+	Reorder        *OrderViewReorder `json:"reorder"` // null — неизвестно
+}
+
+// OrderViewProduct — текущие данные товара из каталога
+type OrderViewProduct struct {
+	Name         string `json:"name"`
+	SKU          string `json:"sku"`
+	ImageURL     string `json:"image_url,omitempty"`
+	PriceCents   int64  `json:"price_cents"` // текущая цена, может отличаться от цены в заказе
+	CurrencyCode string `json:"currency_code"`
+	IsActive     bool   `json:"is_active"`
+}
+
+// OrderViewStock — остаток на складе сейчас
+type OrderViewStock struct {
+	Available int32  `json:"available"`
+	InStock   bool   `json:"in_stock"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// OrderViewReorder — можно ли заказать позицию повторно в том же количестве
+type OrderViewReorder struct {
+	Eligible bool   `json:"eligible"`
+	Reason   string `json:"reason,omitempty" example:"insufficient_stock"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+// MeResponse — профиль текущего пользователя
+type MeResponse struct {
+	UserId             string `json:"user_id"`
+	Email              string `json:"email"`
+	Role               string `json:"role"`
+	IsEmailVerified    bool   `json:"is_email_verified"`
+	IsGuest            bool   `json:"is_guest"`
+	DisplayName        string `json:"display_name,omitempty"`
+	Phone              string `json:"phone,omitempty"`
+	IsPhoneVerified    bool   `json:"is_phone_verified"`
+	Locale             string `json:"locale" example:"ru"`
+	TimeZone           string `json:"time_zone" example:"Europe/Moscow"`
+	MarketingConsent   bool   `json:"marketing_consent"`
+	MarketingConsentAt string `json:"marketing_consent_at,omitempty"`
+	CreatedAt          string `json:"created_at"`
+}
+
+// UpdateMeRequest — частичное обновление профиля: отсутствующие поля не меняются,
+// пустая строка очищает имя или телефон
+type UpdateMeRequest struct {
+	DisplayName      *string `json:"display_name,omitempty" binding:"omitempty,max=100"`
+	Phone            *string `json:"phone,omitempty" example:"+79991234567"`
+	Locale           *string `json:"locale,omitempty" example:"en-US"`
+	TimeZone         *string `json:"time_zone,omitempty" example:"Europe/Moscow"`
+	MarketingConsent *bool   `json:"marketing_consent,omitempty"`
+}
+
+type RequestPhoneVerificationResponse struct {
+	ExpiresAt string `json:"expires_at" example:"2025-01-01T12:10:00Z"`
+}
+
+type ConfirmPhoneVerificationRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric" example:"123456"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+type Permission struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+type ListPermissionsResponse struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+type RolePermissionsResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+// RolePermissionRequest — выдача права роли
+type RolePermissionRequest struct {
+	Permission string `json:"permission" binding:"required,max=64"`
+}
+
+// SetUserRoleRequest — смена роли пользователя
+type SetUserRoleRequest struct {
+	Role string `json:"role" binding:"required,max=32"`
+}
+
+// ImpersonateRequest — вход от имени пользователя; причина попадает в журнал аудита
+type ImpersonateRequest struct {
+	Reason string `json:"reason" binding:"required,min=3,max=500"`
+}
+
+// ImpersonateResponse — короткоживущий access-токен без refresh-токена
+type ImpersonateResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpUnix     int64  `json:"exp_unix"`
+	UserID      string `json:"user_id"`
+	ActorID     string `json:"actor_id"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+// SubmitVendorApplicationRequest — заявка на статус продавца
+type SubmitVendorApplicationRequest struct {
+	CompanyName string `json:"company_name" binding:"required,min=2,max=200"`
+	TaxID       string `json:"tax_id" binding:"required,numeric,min=10,max=12"`
+	Website     string `json:"website" binding:"omitempty,max=255"`
+	Phone       string `json:"phone" binding:"omitempty,max=32"`
+	Description string `json:"description" binding:"omitempty,max=2000"`
+}
+
+// RejectVendorApplicationRequest — причина отклонения заявки
+type RejectVendorApplicationRequest struct {
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+type VendorApplication struct {
+	ID           string `json:"id"`
+	UserID       string `json:"user_id"`
+	CompanyName  string `json:"company_name"`
+	TaxID        string `json:"tax_id"`
+	Website      string `json:"website,omitempty"`
+	Phone        string `json:"phone,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Status       string `json:"status"` // PENDING | APPROVED | REJECTED
+	RejectReason string `json:"reject_reason,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	ReviewedAt   string `json:"reviewed_at,omitempty"`
+}
+
+type ListVendorApplicationsResponse struct {
+	Applications []VendorApplication `json:"applications"`
+	Total        int32               `json:"total"`
+}
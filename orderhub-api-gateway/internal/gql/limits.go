@@ -0,0 +1,223 @@
+package gql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/ast"
+	qerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	// defaultListSize — оценка длины списка без аргумента limit (позиции заказа)
+	defaultListSize = 10
+	// maxPageSize — больше сервисы за страницу не отдают
+	maxPageSize = 100
+
+	codeTooDeep    = "QUERY_TOO_DEEP"
+	codeTooComplex = "QUERY_TOO_COMPLEX"
+
+	// ruleMaxDepth — правило валидации graphql-go для graphql.MaxDepth
+	ruleMaxDepth = "MaxDepthExceeded"
+)
+
+// Limits — ограничения запроса. Нулевое значение отключает проверку.
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// complexity разбирает запрос и считает сложность выбранной операции. Сложность поля — 1
+// плюс сложность вложенного выбора, умноженная на ожидаемую длину списка: limit для
+// постраничных полей (nodes соединения уже учтён им), defaultListSize для прочих списков.
+// Поля интроспекции (__schema, __type) в gRPC не ходят и не считаются, так что нулевая
+// сложность — запрос только к интроспекции. Неизвестную операцию и ошибки схемы отклонит
+// graphql-go, здесь они не проверяются.
+func complexity(schema *ast.Schema, query, operationName string, vars map[string]any) (int, *qerrors.QueryError) {
+	doc, err := parseQuery(query)
+	if err != nil {
+		return 0, &qerrors.QueryError{Message: err.Error()}
+	}
+	op := doc.operation(operationName)
+	if op == nil {
+		return 0, nil
+	}
+	w := walker{schema: schema, doc: doc, op: op, vars: vars, visiting: make(map[string]bool), fragments: make(map[fragmentKey]int)}
+	return w.selection(op.selections, schema.RootOperationTypes[op.kind], false), nil
+}
+
+// checkComplexity возвращает сложность запроса и ошибку QUERY_TOO_COMPLEX при превышении лимита
+func checkComplexity(schema *ast.Schema, limits Limits, query, operationName string, vars map[string]any) (int, []*qerrors.QueryError) {
+	c, err := complexity(schema, query, operationName, vars)
+	if err != nil {
+		return 0, []*qerrors.QueryError{err}
+	}
+	if limits.MaxComplexity > 0 && c > limits.MaxComplexity {
+		rejected.WithLabelValues("complexity").Inc()
+		return c, []*qerrors.QueryError{limitError(codeTooComplex, fmt.Sprintf("query complexity %d exceeds limit %d", c, limits.MaxComplexity))}
+	}
+	return c, nil
+}
+
+// markDepthErrors проставляет QUERY_TOO_DEEP ошибкам graphql.MaxDepth
+func markDepthErrors(resp *graphql.Response) {
+	deep := false
+	for _, e := range resp.Errors {
+		if e.Rule == ruleMaxDepth {
+			e.Extensions = map[string]any{"code": codeTooDeep}
+			deep = true
+		}
+	}
+	if deep {
+		rejected.WithLabelValues("depth").Inc()
+	}
+}
+
+func limitError(code, msg string) *qerrors.QueryError {
+	return &qerrors.QueryError{Message: msg, Extensions: map[string]any{"code": code}}
+}
+
+type fragmentKey struct {
+	name  string
+	paged bool
+}
+
+type walker struct {
+	schema *ast.Schema
+	doc    *queryDoc
+	op     *queryOp
+	vars   map[string]any
+	// visiting — фрагменты на текущем пути: запрос ещё не прошёл валидацию graphql-go, и
+	// цикл фрагментов не должен зациклить обход
+	visiting map[string]bool
+	// fragments — посчитанные фрагменты: вложенные повторы не раздувают обход экспоненциально
+	fragments map[fragmentKey]int
+}
+
+// selection возвращает сложность набора полей типа parent (nil — тип неизвестен). paged —
+// набор принадлежит постраничному полю, и его списки уже умножены на limit.
+func (w *walker) selection(set []querySelection, parent ast.NamedType, paged bool) int {
+	complexity := 0
+	for _, sel := range set {
+		switch {
+		case sel.spread != "":
+			complexity += w.fragment(sel.spread, paged)
+		case sel.inline:
+			complexity += w.selection(sel.selections, w.typeOr(sel.typeCond, parent), paged)
+		case strings.HasPrefix(sel.name, "__"):
+		default:
+			complexity += w.field(sel, parent, paged)
+		}
+	}
+	return complexity
+}
+
+func (w *walker) fragment(name string, paged bool) int {
+	key := fragmentKey{name: name, paged: paged}
+	if c, ok := w.fragments[key]; ok {
+		return c
+	}
+	f := w.doc.fragments[name]
+	if f == nil || w.visiting[name] {
+		return 0
+	}
+	w.visiting[name] = true
+	c := w.selection(f.selections, w.schema.Types[f.typeCond], paged)
+	delete(w.visiting, name)
+	w.fragments[key] = c
+	return c
+}
+
+func (w *walker) field(sel querySelection, parent ast.NamedType, paged bool) int {
+	if len(sel.selections) == 0 {
+		return 1
+	}
+	def := fieldDefinition(parent, sel.name)
+	if def == nil {
+		return 1 + w.selection(sel.selections, nil, false)
+	}
+	mult, childPaged := 1, false
+	if arg := def.Arguments.Get("limit"); arg != nil {
+		childPaged = true
+		v, ok := sel.args["limit"]
+		if !ok && arg.Default != nil {
+			v = arg.Default.Deserialize(nil)
+		}
+		if n, ok := toInt(w.resolve(v)); ok && n > 1 {
+			mult = min(n, maxPageSize)
+		}
+	} else if isList(def.Type) && !paged {
+		mult = defaultListSize
+	}
+	return 1 + mult*w.selection(sel.selections, namedType(def.Type), childPaged)
+}
+
+// resolve подставляет значение переменной: из запроса или по умолчанию из операции
+func (w *walker) resolve(v any) any {
+	name, ok := v.(queryVar)
+	if !ok {
+		return v
+	}
+	if val, ok := w.vars[string(name)]; ok {
+		return val
+	}
+	return w.op.defaults[string(name)]
+}
+
+func (w *walker) typeOr(name string, parent ast.NamedType) ast.NamedType {
+	if name == "" {
+		return parent
+	}
+	return w.schema.Types[name]
+}
+
+func fieldDefinition(t ast.NamedType, name string) *ast.FieldDefinition {
+	switch t := t.(type) {
+	case *ast.ObjectTypeDefinition:
+		return t.Fields.Get(name)
+	case *ast.InterfaceTypeDefinition:
+		return t.Fields.Get(name)
+	}
+	return nil
+}
+
+func isList(t ast.Type) bool {
+	if nn, ok := t.(*ast.NonNull); ok {
+		t = nn.OfType
+	}
+	_, ok := t.(*ast.List)
+	return ok
+}
+
+func namedType(t ast.Type) ast.NamedType {
+	for {
+		switch w := t.(type) {
+		case *ast.NonNull:
+			t = w.OfType
+		case *ast.List:
+			t = w.OfType
+		default:
+			named, _ := t.(ast.NamedType)
+			return named
+		}
+	}
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
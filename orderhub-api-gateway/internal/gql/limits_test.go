@@ -0,0 +1,157 @@
+package gql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// itemsQuery — заказы с товарами и остатками позиций: глубина 6, сложность 1 + limit*32
+const itemsQuery = `nodes { items { product { stock { available } } } }`
+
+func testServer(t *testing.T, limits Limits) *Server {
+	t.Helper()
+	// клиенты сервисов не нужны: отклонённый запрос и интроспекция до резолверов не доходят
+	s, err := NewServer(nil, nil, nil, nil, limits, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestServer_Limits(t *testing.T) {
+	defaults := Limits{MaxDepth: 8, MaxComplexity: 2000}
+	tests := []struct {
+		name     string
+		limits   Limits
+		query    string
+		vars     map[string]any
+		wantCode string
+		wantErr  string // ошибка без кода лимита
+	}{
+		{
+			name:     "too deep",
+			limits:   Limits{MaxDepth: 5, MaxComplexity: 2000},
+			query:    `{ me { orders { ` + itemsQuery + ` } } }`,
+			wantCode: codeTooDeep,
+		},
+		{
+			name:     "too deep through fragment",
+			limits:   Limits{MaxDepth: 5, MaxComplexity: 2000},
+			query:    `{ me { ...Orders } } fragment Orders on User { orders { ` + itemsQuery + ` } }`,
+			wantCode: codeTooDeep,
+		},
+		{
+			name:     "too complex by limit literal",
+			limits:   defaults,
+			query:    `{ orders(limit: 100) { ` + itemsQuery + ` } }`,
+			wantCode: codeTooComplex,
+		},
+		{
+			name:     "too complex by variable",
+			limits:   defaults,
+			query:    `query Q($n: Int) { orders(limit: $n) { ` + itemsQuery + ` } }`,
+			vars:     map[string]any{"n": float64(100)},
+			wantCode: codeTooComplex,
+		},
+		{
+			name:     "too complex by variable default",
+			limits:   defaults,
+			query:    `query Q($n: Int = 100) { orders(limit: $n) { ` + itemsQuery + ` } }`,
+			wantCode: codeTooComplex,
+		},
+		{
+			name:     "too complex through fragment",
+			limits:   defaults,
+			query:    `{ ...Q } fragment Q on Query { orders(limit: 100) { ` + itemsQuery + ` } }`,
+			wantCode: codeTooComplex,
+		},
+		{
+			name:     "aliases add up",
+			limits:   Limits{MaxDepth: 8, MaxComplexity: 1000},
+			query:    `{ a: orders { ` + itemsQuery + ` } b: orders { ` + itemsQuery + ` } }`,
+			wantCode: codeTooComplex,
+		},
+		{
+			name:   "introspection is not limited",
+			limits: Limits{MaxDepth: 3, MaxComplexity: 1},
+			query:  `{ __schema { types { fields { type { ofType { ofType { ofType { name } } } } } } } }`,
+		},
+		{
+			name:    "fragment cycle is rejected by validation",
+			limits:  defaults,
+			query:   `{ ...A } fragment A on Query { ...B } fragment B on Query { ...A }`,
+			wantErr: "fragment",
+		},
+		{
+			name:    "syntax error",
+			limits:  defaults,
+			query:   `{ me { id `,
+			wantErr: "syntax error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testServer(t, tt.limits)
+			resp := s.Exec(context.Background(), Viewer{}, tt.query, "", tt.vars)
+			if tt.wantCode == "" && tt.wantErr == "" {
+				if len(resp.Errors) > 0 {
+					t.Fatalf("unexpected errors: %v", resp.Errors)
+				}
+				return
+			}
+			if len(resp.Errors) == 0 {
+				t.Fatal("query must be rejected")
+			}
+			for _, e := range resp.Errors {
+				code, _ := e.Extensions["code"].(string)
+				if code != tt.wantCode {
+					t.Errorf("want code %q, got %q (%s)", tt.wantCode, code, e.Message)
+				}
+				if tt.wantErr != "" && !strings.Contains(strings.ToLower(e.Message), tt.wantErr) {
+					t.Errorf("want error containing %q, got %q", tt.wantErr, e.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestComplexity(t *testing.T) {
+	s := testServer(t, Limits{})
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{`{ me { id email } }`, 3},
+		// позиции заказа — список без limit
+		{`{ order(id: "1") { items { productId } } }`, 1 + 1 + defaultListSize},
+		// orders без limit — значение по умолчанию из схемы (20); nodes уже учтён им
+		{`{ orders { nodes { id } } }`, 1 + 20*2},
+		{`{ orders(limit: 5) { total nodes { id } } }`, 1 + 5*3},
+		// limit больше страницы сервис не отдаст
+		{`{ orders(limit: 100000) { nodes { id } } }`, 1 + maxPageSize*2},
+		{`{ me { __typename id } __schema { types { name } } }`, 2},
+		{`{ order(id: "1") { ... on Order { id status } } }`, 3},
+		{"# комментарий\n{ products(query: \"\"\"тел\"\"\", limit: 2) { nodes { id } } }", 1 + 2*2},
+	}
+	for _, tt := range tests {
+		c, err := complexity(s.schema.AST(), tt.query, "", nil)
+		if err != nil || c != tt.want {
+			t.Errorf("%s: want %d, got %d (err %v)", tt.query, tt.want, c, err)
+		}
+	}
+}
+
+func TestServer_SubscribeDepthLimit(t *testing.T) {
+	s := testServer(t, Limits{MaxDepth: 3, MaxComplexity: 2000})
+	out, err := s.Subscribe(context.Background(), Viewer{}, `subscription { orderStatusChanged { order { items { product { id } } } } }`, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := <-out
+	if len(resp.Errors) == 0 || resp.Errors[0].Extensions["code"] != codeTooDeep {
+		t.Fatalf("want %s, got %+v", codeTooDeep, resp.Errors)
+	}
+}
@@ -0,0 +1,106 @@
+package gql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// loaderWait — сколько загрузчик копит ключи перед пакетным запросом
+	loaderWait = 2 * time.Millisecond
+	// loaderMaxBatch — предел ключей в одном пакетном запросе
+	loaderMaxBatch = 100
+)
+
+// Loader собирает ключи, запрошенные резолверами в течение loaderWait, в один пакетный вызов
+// и кэширует результаты до конца запроса. Отсутствующий в ответе ключ — нулевое значение V.
+// Загрузчик живёт один запрос: кэш не сбрасывается.
+type Loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu    sync.Mutex
+	cache map[K]*loaderResult[V]
+	batch *loaderBatch[K, V]
+}
+
+type loaderResult[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+}
+
+// NewLoader — ctx передаётся в fetch и должен нести метаданные authorization пользователя.
+func NewLoader[K comparable, V any](ctx context.Context, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{ctx: ctx, fetch: fetch, cache: make(map[K]*loaderResult[V])}
+}
+
+// Load ждёт значение ключа из ближайшего пакета или из кэша.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	r := l.enqueue(key)
+	select {
+	case <-r.done:
+		return r.val, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Prefetch ставит ключи в очередь, не дожидаясь ответа. Родительский резолвер вызывает его
+// для всех дочерних ключей сразу, чтобы пакет не зависел от того, сколько резолверов
+// graphql-go выполняет параллельно.
+func (l *Loader[K, V]) Prefetch(keys ...K) {
+	for _, k := range keys {
+		l.enqueue(k)
+	}
+}
+
+func (l *Loader[K, V]) enqueue(key K) *loaderResult[V] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r, ok := l.cache[key]; ok {
+		return r
+	}
+	r := &loaderResult[V]{done: make(chan struct{})}
+	l.cache[key] = r
+
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{}
+		l.batch = b
+		time.AfterFunc(loaderWait, func() {
+			l.mu.Lock()
+			if l.batch != b {
+				// пакет уже ушёл по заполнению
+				l.mu.Unlock()
+				return
+			}
+			l.batch = nil
+			l.mu.Unlock()
+			l.run(b)
+		})
+	}
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	if len(b.keys) >= loaderMaxBatch {
+		l.batch = nil
+		go l.run(b)
+	}
+	return r
+}
+
+func (l *Loader[K, V]) run(b *loaderBatch[K, V]) {
+	vals, err := l.fetch(l.ctx, b.keys)
+	for i, k := range b.keys {
+		r := b.results[i]
+		r.val, r.err = vals[k], err
+		close(r.done)
+	}
+}
@@ -0,0 +1,143 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// recordingFetch запоминает пакеты ключей и отвечает значением key*10
+type recordingFetch struct {
+	mu      sync.Mutex
+	batches [][]int
+	err     error
+}
+
+func (f *recordingFetch) fetch(ctx context.Context, keys []int) (map[int]int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, slices.Clone(keys))
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make(map[int]int, len(keys))
+	for _, k := range keys {
+		if k >= 0 {
+			out[k] = k * 10
+		}
+	}
+	return out, nil
+}
+
+func (f *recordingFetch) calls() [][]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.batches)
+}
+
+// loadAll загружает ключи параллельно, как резолверы соседних полей
+func loadAll(t *testing.T, l *Loader[int, int], keys ...int) []int {
+	t.Helper()
+	vals := make([]int, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, k := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vals[i], errs[i] = l.Load(context.Background(), k)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+	}
+	return vals
+}
+
+func TestLoader_BatchesAndDeduplicates(t *testing.T) {
+	f := &recordingFetch{}
+	l := NewLoader(context.Background(), f.fetch)
+
+	// родительский резолвер ставит ключи в очередь, дочерние ждут их параллельно
+	l.Prefetch(1, 2, 1, 3, 2)
+	vals := loadAll(t, l, 1, 2, 1, 3, 2)
+	if !slices.Equal(vals, []int{10, 20, 10, 30, 20}) {
+		t.Fatalf("unexpected values %v", vals)
+	}
+	calls := f.calls()
+	if len(calls) != 1 {
+		t.Fatalf("want one batch, got %v", calls)
+	}
+	if !slices.Equal(calls[0], []int{1, 2, 3}) {
+		t.Fatalf("batch must hold each key once, got %v", calls[0])
+	}
+}
+
+func TestLoader_CachesForTheRequest(t *testing.T) {
+	f := &recordingFetch{}
+	l := NewLoader(context.Background(), f.fetch)
+
+	l.Prefetch(1, 2)
+	loadAll(t, l, 1, 2)
+	vals := loadAll(t, l, 2, 1)
+	if !slices.Equal(vals, []int{20, 10}) {
+		t.Fatalf("unexpected values %v", vals)
+	}
+	loadAll(t, l, 1, 4)
+	calls := f.calls()
+	if len(calls) != 2 || !slices.Equal(calls[1], []int{4}) {
+		t.Fatalf("cached keys must not be fetched again, got %v", calls)
+	}
+}
+
+func TestLoader_SplitsAtMaxBatch(t *testing.T) {
+	f := &recordingFetch{}
+	l := NewLoader(context.Background(), f.fetch)
+
+	keys := make([]int, loaderMaxBatch+1)
+	for i := range keys {
+		keys[i] = i
+	}
+	l.Prefetch(keys...)
+	loadAll(t, l, keys...)
+	calls := f.calls()
+	if len(calls) != 2 || len(calls[0]) != loaderMaxBatch || len(calls[1]) != 1 {
+		t.Fatalf("want batches of %d and 1 keys, got %d batches", loaderMaxBatch, len(calls))
+	}
+}
+
+func TestLoader_MissingKeyAndError(t *testing.T) {
+	f := &recordingFetch{}
+	l := NewLoader(context.Background(), f.fetch)
+	if v, err := l.Load(context.Background(), -1); err != nil || v != 0 {
+		t.Fatalf("missing key must give the zero value, got %d err=%v", v, err)
+	}
+
+	f = &recordingFetch{err: errors.New("unavailable")}
+	l = NewLoader(context.Background(), f.fetch)
+	l.Prefetch(1, 2)
+	for _, k := range []int{1, 2} {
+		if _, err := l.Load(context.Background(), k); !errors.Is(err, f.err) {
+			t.Fatalf("every key of a failed batch must get the error, got %v", err)
+		}
+	}
+}
+
+func TestLoader_LoadHonoursContext(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	l := NewLoader(context.Background(), func(ctx context.Context, keys []int) (map[int]int, error) {
+		<-block
+		return nil, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.Load(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("want context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package gql
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// type: query | subscription; result: ok | error
+	operations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_operations_total",
+		Help: "GraphQL operations executed by the gateway.",
+	}, []string{"type", "result"})
+
+	// reason: depth | complexity
+	rejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_rejected_total",
+		Help: "GraphQL operations rejected by depth or complexity limits.",
+	}, []string{"reason"})
+
+	// loader: products | stock
+	batches = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_loader_batch_size",
+		Help:    "Keys per batched backend call made by GraphQL dataloaders.",
+		Buckets: []float64{1, 2, 5, 10, 20, 50, 100},
+	}, []string{"loader"})
+)
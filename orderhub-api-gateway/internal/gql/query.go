@@ -0,0 +1,349 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/scanner"
+)
+
+// queryDoc — запрос, разобранный ровно настолько, насколько нужно для подсчёта сложности:
+// операции, фрагменты, поля с аргументами. Разбор внутри graphql-go недоступен снаружи, а
+// сложность нужно знать до выполнения. Лексер тот же, что у graphql-go (text/scanner).
+type queryDoc struct {
+	operations []*queryOp
+	fragments  map[string]*queryFragment
+}
+
+type queryOp struct {
+	kind       string // query, mutation, subscription
+	name       string
+	defaults   map[string]any // значения переменных по умолчанию
+	selections []querySelection
+}
+
+type queryFragment struct {
+	typeCond   string
+	selections []querySelection
+}
+
+// querySelection — поле, именованный фрагмент (spread) или встроенный фрагмент (inline)
+type querySelection struct {
+	name       string
+	args       map[string]any
+	spread     string
+	inline     bool
+	typeCond   string
+	selections []querySelection
+}
+
+// queryVar — аргумент-переменная
+type queryVar string
+
+type querySyntaxError string
+
+func (e querySyntaxError) Error() string { return "syntax error: " + string(e) }
+
+// operation возвращает операцию по имени; пустое имя — единственная операция запроса
+func (d *queryDoc) operation(name string) *queryOp {
+	if name == "" {
+		if len(d.operations) == 1 {
+			return d.operations[0]
+		}
+		return nil
+	}
+	for _, op := range d.operations {
+		if op.name == name {
+			return op
+		}
+	}
+	return nil
+}
+
+type queryParser struct {
+	sc   scanner.Scanner
+	tok  rune
+	text string
+}
+
+func parseQuery(query string) (doc *queryDoc, err error) {
+	p := &queryParser{}
+	p.sc.Init(strings.NewReader(query))
+	p.sc.Mode = scanner.ScanIdents | scanner.ScanInts | scanner.ScanFloats | scanner.ScanStrings
+	p.sc.Error = func(_ *scanner.Scanner, msg string) { p.fail(msg) }
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(querySyntaxError)
+			if !ok {
+				panic(r)
+			}
+			doc, err = nil, e
+		}
+	}()
+
+	p.next()
+	doc = &queryDoc{fragments: make(map[string]*queryFragment)}
+	for p.tok != scanner.EOF {
+		p.definition(doc)
+	}
+	return doc, nil
+}
+
+func (p *queryParser) fail(msg string) {
+	panic(querySyntaxError(fmt.Sprintf("%s at %s", msg, p.sc.Pos())))
+}
+
+// next читает следующий токен, пропуская запятые и комментарии
+func (p *queryParser) next() {
+	for {
+		p.tok = p.sc.Scan()
+		switch p.tok {
+		case ',':
+			continue
+		case '#':
+			for r := p.sc.Peek(); r != '\n' && r != '\r' && r != scanner.EOF; r = p.sc.Peek() {
+				p.sc.Next()
+			}
+			continue
+		}
+		p.text = p.sc.TokenText()
+		return
+	}
+}
+
+func (p *queryParser) expect(tok rune) {
+	if p.tok != tok {
+		p.fail(fmt.Sprintf("unexpected %q, expecting %q", p.text, scanner.TokenString(tok)))
+	}
+	p.next()
+}
+
+func (p *queryParser) name() string {
+	name := p.text
+	p.expect(scanner.Ident)
+	return name
+}
+
+func (p *queryParser) definition(doc *queryDoc) {
+	if p.tok == '{' {
+		doc.operations = append(doc.operations, &queryOp{kind: "query", selections: p.selectionSet()})
+		return
+	}
+	switch kind := p.name(); kind {
+	case "query", "mutation", "subscription":
+		op := &queryOp{kind: kind, defaults: make(map[string]any)}
+		if p.tok == scanner.Ident {
+			op.name = p.name()
+		}
+		if p.tok == '(' {
+			p.next()
+			for p.tok != ')' {
+				p.expect('$')
+				v := p.name()
+				p.expect(':')
+				p.typeRef()
+				if p.tok == '=' {
+					p.next()
+					op.defaults[v] = p.value()
+				}
+				p.directives()
+			}
+			p.next()
+		}
+		p.directives()
+		op.selections = p.selectionSet()
+		doc.operations = append(doc.operations, op)
+	case "fragment":
+		name := p.name()
+		if p.name() != "on" {
+			p.fail(`expecting "on"`)
+		}
+		f := &queryFragment{typeCond: p.name()}
+		p.directives()
+		f.selections = p.selectionSet()
+		doc.fragments[name] = f
+	default:
+		p.fail(fmt.Sprintf("unexpected %q, expecting operation or fragment", kind))
+	}
+}
+
+func (p *queryParser) typeRef() {
+	if p.tok == '[' {
+		p.next()
+		p.typeRef()
+		p.expect(']')
+	} else {
+		p.name()
+	}
+	if p.tok == '!' {
+		p.next()
+	}
+}
+
+func (p *queryParser) directives() {
+	for p.tok == '@' {
+		p.next()
+		p.name()
+		if p.tok == '(' {
+			p.arguments()
+		}
+	}
+}
+
+func (p *queryParser) selectionSet() []querySelection {
+	p.expect('{')
+	var set []querySelection
+	for p.tok != '}' {
+		if p.tok == scanner.EOF {
+			p.fail("unexpected end of query")
+		}
+		set = append(set, p.selection())
+	}
+	p.next()
+	return set
+}
+
+func (p *queryParser) selection() querySelection {
+	if p.tok == '.' {
+		for range 3 {
+			p.expect('.')
+		}
+		if p.tok == scanner.Ident && p.text != "on" {
+			sel := querySelection{spread: p.name()}
+			p.directives()
+			return sel
+		}
+		sel := querySelection{inline: true}
+		if p.tok == scanner.Ident {
+			p.next()
+			sel.typeCond = p.name()
+		}
+		p.directives()
+		sel.selections = p.selectionSet()
+		return sel
+	}
+
+	sel := querySelection{name: p.name()}
+	if p.tok == ':' {
+		// алиас: считается имя поля в схеме
+		p.next()
+		sel.name = p.name()
+	}
+	if p.tok == '(' {
+		sel.args = p.arguments()
+	}
+	p.directives()
+	if p.tok == '{' {
+		sel.selections = p.selectionSet()
+	}
+	return sel
+}
+
+func (p *queryParser) arguments() map[string]any {
+	p.expect('(')
+	args := make(map[string]any)
+	for p.tok != ')' {
+		name := p.name()
+		p.expect(':')
+		args[name] = p.value()
+	}
+	p.next()
+	return args
+}
+
+// value читает значение аргумента. Числа, логические значения и переменные нужны для
+// limit; строки, перечисления, списки и объекты разбираются, чтобы их пропустить.
+func (p *queryParser) value() any {
+	switch p.tok {
+	case '$':
+		p.next()
+		return queryVar(p.name())
+	case '-':
+		p.next()
+		switch v := p.value().(type) {
+		case int:
+			return -v
+		case float64:
+			return -v
+		}
+		p.fail("expecting number after '-'")
+	case scanner.Int:
+		n, err := strconv.Atoi(p.text)
+		if err != nil {
+			p.fail(fmt.Sprintf("invalid int %q", p.text))
+		}
+		p.next()
+		return n
+	case scanner.Float:
+		f, err := strconv.ParseFloat(p.text, 64)
+		if err != nil {
+			p.fail(fmt.Sprintf("invalid float %q", p.text))
+		}
+		p.next()
+		return f
+	case scanner.String:
+		if p.text == `""` && p.sc.Peek() == '"' {
+			p.blockString()
+		}
+		p.next()
+		return nil
+	case scanner.Ident:
+		v := p.text
+		p.next()
+		switch v {
+		case "true":
+			return true
+		case "false":
+			return false
+		case "null":
+			return nil
+		}
+		return v
+	case '[':
+		p.next()
+		var list []any
+		for p.tok != ']' {
+			if p.tok == scanner.EOF {
+				p.fail("unexpected end of query")
+			}
+			list = append(list, p.value())
+		}
+		p.next()
+		return list
+	case '{':
+		p.next()
+		obj := make(map[string]any)
+		for p.tok != '}' {
+			name := p.name()
+			p.expect(':')
+			obj[name] = p.value()
+		}
+		p.next()
+		return obj
+	}
+	p.fail(fmt.Sprintf("unexpected %q, expecting value", p.text))
+	return nil
+}
+
+// blockString пропускает тело """строки""": сканер видит в начале пустую строку ""
+func (p *queryParser) blockString() {
+	p.sc.Next()
+	quotes := 0
+	for {
+		switch p.sc.Next() {
+		case scanner.EOF:
+			p.fail("unterminated block string")
+		case '\\':
+			quotes = 0
+			if p.sc.Peek() == '"' {
+				p.sc.Next()
+			}
+		case '"':
+			if quotes++; quotes == 3 {
+				return
+			}
+		default:
+			quotes = 0
+		}
+	}
+}
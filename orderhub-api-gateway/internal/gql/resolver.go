@@ -0,0 +1,265 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/orderstatus"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
+	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
+	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// resolver — корневой резолвер Query и Subscription. Права проверяют сервисы по токену из
+// метаданных ctx; сам gateway только сужает подписку на статусы, как REST-стрим.
+type resolver struct {
+	auth      *auth.Client
+	orders    orderv1.OrderServiceClient
+	inventory inventoryv1.InventoryServiceClient
+	hub       *orderstatus.Hub
+	log       *zap.Logger
+}
+
+// loaders — загрузчики одного запроса (или одного события подписки)
+type loaders struct {
+	r        *resolver
+	products *Loader[string, *inventoryv1.Product]
+	stock    *Loader[string, *inventoryv1.Stock]
+}
+
+func (r *resolver) newLoaders(ctx context.Context) *loaders {
+	return &loaders{
+		r: r,
+		products: NewLoader(ctx, func(ctx context.Context, ids []string) (map[string]*inventoryv1.Product, error) {
+			batches.WithLabelValues("products").Observe(float64(len(ids)))
+			resp, err := r.inventory.BatchGetProducts(ctx, &inventoryv1.BatchGetProductsRequest{ProductIds: toUUIDs(ids)})
+			if err != nil {
+				return nil, err
+			}
+			out := make(map[string]*inventoryv1.Product, len(resp.GetProducts()))
+			for _, p := range resp.GetProducts() {
+				out[p.GetId().GetValue()] = p
+			}
+			return out, nil
+		}),
+		stock: NewLoader(ctx, func(ctx context.Context, ids []string) (map[string]*inventoryv1.Stock, error) {
+			batches.WithLabelValues("stock").Observe(float64(len(ids)))
+			resp, err := r.inventory.BatchGetStock(ctx, &inventoryv1.BatchGetStockRequest{ProductIds: toUUIDs(ids)})
+			if err != nil {
+				return nil, err
+			}
+			out := make(map[string]*inventoryv1.Stock, len(resp.GetStocks()))
+			for _, st := range resp.GetStocks() {
+				out[st.GetProductId().GetValue()] = st
+			}
+			return out, nil
+		}),
+	}
+}
+
+// prefetchOrders ставит в очередь товары (и их остатки) всех заказов страницы, если они
+// выбраны в запросе: prefix — путь до заказа относительно текущего поля.
+func (l *loaders) prefetchOrders(ctx context.Context, prefix string, orders ...*orderResolver) {
+	if !graphql.HasSelectedField(ctx, prefix+"items.product") {
+		return
+	}
+	withStock := graphql.HasSelectedField(ctx, prefix+"items.product.stock")
+	for _, o := range orders {
+		ids := o.productIDs()
+		l.products.Prefetch(ids...)
+		if withStock {
+			l.stock.Prefetch(ids...)
+		}
+	}
+}
+
+func toUUIDs(ids []string) []*commonv1.UUID {
+	out := make([]*commonv1.UUID, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, &commonv1.UUID{Value: id})
+	}
+	return out
+}
+
+func (r *resolver) Me(ctx context.Context) (*userResolver, error) {
+	me, err := r.auth.GetMe(ctx)
+	if err != nil {
+		return nil, r.grpcError("GetMe", err)
+	}
+	return &userResolver{r: r, me: me}, nil
+}
+
+func (r *resolver) Order(ctx context.Context, args struct{ ID graphql.ID }) (*orderResolver, error) {
+	if _, err := uuid.Parse(string(args.ID)); err != nil {
+		return nil, badInput("invalid order id")
+	}
+	l := loadersFrom(ctx)
+	o, err := r.order(ctx, l, string(args.ID))
+	if o != nil {
+		l.prefetchOrders(ctx, "", o)
+	}
+	return o, err
+}
+
+// order — заказ по ID или nil, если его нет или он чужой
+func (r *resolver) order(ctx context.Context, l *loaders, id string) (*orderResolver, error) {
+	resp, err := r.orders.GetOrder(ctx, &orderv1.GetOrderRequest{OrderId: &commonv1.UUID{Value: id}})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, r.grpcError("GetOrder", err)
+	}
+	return &orderResolver{o: resp.GetOrder(), l: l}, nil
+}
+
+type ordersArgs struct {
+	Limit  int32
+	Offset int32
+	Status *string
+	UserID *graphql.ID
+}
+
+func (r *resolver) Orders(ctx context.Context, args ordersArgs) (*orderConnectionResolver, error) {
+	userID := ""
+	if args.UserID != nil {
+		if _, err := uuid.Parse(string(*args.UserID)); err != nil {
+			return nil, badInput("invalid user id")
+		}
+		userID = string(*args.UserID)
+	}
+	return r.listOrders(ctx, args, userID)
+}
+
+func (r *resolver) listOrders(ctx context.Context, args ordersArgs, userID string) (*orderConnectionResolver, error) {
+	req := &orderv1.ListOrdersRequest{Limit: args.Limit, Offset: args.Offset}
+	if args.Status != nil {
+		req.Status = commonv1.OrderStatus(commonv1.OrderStatus_value["ORDER_STATUS_"+*args.Status])
+	}
+	if userID != "" {
+		req.UserId = &commonv1.UUID{Value: userID}
+	}
+	resp, err := r.orders.ListOrders(ctx, req)
+	if err != nil {
+		return nil, r.grpcError("ListOrders", err)
+	}
+	l := loadersFrom(ctx)
+	out := &orderConnectionResolver{total: resp.GetTotal(), nextOffset: resp.GetNextOffset()}
+	for _, o := range resp.GetOrders() {
+		out.nodes = append(out.nodes, &orderResolver{o: o, l: l})
+	}
+	l.prefetchOrders(ctx, "nodes.", out.nodes...)
+	return out, nil
+}
+
+func (r *resolver) Product(ctx context.Context, args struct{ ID graphql.ID }) (*productResolver, error) {
+	if _, err := uuid.Parse(string(args.ID)); err != nil {
+		return nil, badInput("invalid product id")
+	}
+	l := loadersFrom(ctx)
+	p, err := l.products.Load(ctx, string(args.ID))
+	if err != nil {
+		return nil, r.grpcError("BatchGetProducts", err)
+	}
+	if p == nil {
+		return nil, nil
+	}
+	return &productResolver{p: p, l: l}, nil
+}
+
+type productsArgs struct {
+	Query      string
+	VendorID   *graphql.ID
+	OnlyActive *bool
+	Limit      int32
+	Offset     int32
+}
+
+func (r *resolver) Products(ctx context.Context, args productsArgs) (*productConnectionResolver, error) {
+	req := &inventoryv1.ListProductsRequest{
+		Query:      args.Query,
+		OnlyActive: deref(args.OnlyActive, false),
+		Limit:      args.Limit,
+		Offset:     args.Offset,
+	}
+	if args.VendorID != nil {
+		if _, err := uuid.Parse(string(*args.VendorID)); err != nil {
+			return nil, badInput("invalid vendor id")
+		}
+		req.VendorId = &commonv1.UUID{Value: string(*args.VendorID)}
+	}
+	resp, err := r.inventory.ListProducts(ctx, req)
+	if err != nil {
+		return nil, r.grpcError("ListProducts", err)
+	}
+	l := loadersFrom(ctx)
+	withStock := graphql.HasSelectedField(ctx, "nodes.stock")
+	out := &productConnectionResolver{total: resp.GetTotal(), nextOffset: resp.GetNextOffset()}
+	for _, p := range resp.GetProducts() {
+		out.nodes = append(out.nodes, &productResolver{p: p, l: l})
+		if withStock {
+			l.stock.Prefetch(p.GetId().GetValue())
+		}
+	}
+	return out, nil
+}
+
+// OrderStatusChanged подписывает на смены статусов так же, как REST-стрим: пользователь
+// получает свои заказы, с правом order:read:any — любые. Пропущенные события подписка
+// не догоняет — для этого есть /api/v1/orders/status/stream с Last-Event-ID.
+func (r *resolver) OrderStatusChanged(ctx context.Context, args struct{ OrderID *graphql.ID }) (<-chan *orderStatusEventResolver, error) {
+	if r.hub == nil {
+		return nil, errors.New("order status streaming is disabled")
+	}
+	v := viewerFrom(ctx)
+	filter := orderstatus.Filter{UserID: v.UserID}
+	if slices.Contains(v.Perms, authz.PermOrderReadAny) {
+		filter.UserID = ""
+	}
+	if args.OrderID != nil {
+		if _, err := uuid.Parse(string(*args.OrderID)); err != nil {
+			return nil, badInput("invalid order id")
+		}
+		filter.OrderID = string(*args.OrderID)
+	}
+
+	sub := r.hub.Subscribe(filter)
+	out := make(chan *orderStatusEventResolver)
+	go func() {
+		defer close(out)
+		defer sub.Close()
+		for {
+			select {
+			case ev, ok := <-sub.Events():
+				if !ok {
+					// медленного подписчика hub отключает: подписка завершается
+					return
+				}
+				select {
+				case out <- &orderStatusEventResolver{r: r, ev: ev}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
@@ -0,0 +1,193 @@
+package gql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/orderstatus"
+
+	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
+	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
+	"github.com/graph-gophers/graphql-go"
+	qerrors "github.com/graph-gophers/graphql-go/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Viewer — пользователь запроса, как его определил AuthRequired
+type Viewer struct {
+	UserID string
+	Role   string
+	Perms  []string
+}
+
+type viewerKey struct{}
+type loadersKey struct{}
+
+func viewerFrom(ctx context.Context) Viewer {
+	v, _ := ctx.Value(viewerKey{}).(Viewer)
+	return v
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	l, _ := ctx.Value(loadersKey{}).(*loaders)
+	return l
+}
+
+// Server исполняет GraphQL-операции. ctx каждого вызова должен нести метаданные
+// authorization пользователя: резолверы передают их в gRPC-сервисы как есть.
+type Server struct {
+	schema *graphql.Schema
+	// introspection — та же схема без MaxDepth: запрос GraphiQL за схемой глубже лимита, но
+	// в сервисы не ходит
+	introspection *graphql.Schema
+	limits        Limits
+	r             *resolver
+}
+
+// NewServer — hub может быть nil: тогда подписки возвращают ошибку.
+func NewServer(authClient *auth.Client, orders orderv1.OrderServiceClient, inventory inventoryv1.InventoryServiceClient, hub *orderstatus.Hub, limits Limits, log *zap.Logger) (*Server, error) {
+	r := &resolver{auth: authClient, orders: orders, inventory: inventory, hub: hub, log: log}
+	// глубину проверяет graphql-go при валидации, сложность — checkComplexity до выполнения
+	schema, err := graphql.ParseSchema(schemaSDL, r, graphql.UseStringDescriptions(), graphql.MaxDepth(max(limits.MaxDepth, 0)))
+	if err != nil {
+		return nil, err
+	}
+	introspection, err := schema.Clone(r, graphql.MaxDepth(0))
+	if err != nil {
+		return nil, err
+	}
+	return &Server{schema: schema, introspection: introspection, limits: limits, r: r}, nil
+}
+
+// plan проверяет сложность запроса и выбирает схему: запрос только к интроспекции (нулевая
+// сложность) выполняется без лимита глубины.
+func (s *Server) plan(query, operationName string, vars map[string]any) (*graphql.Schema, []*qerrors.QueryError) {
+	if s.limits.MaxDepth <= 0 && s.limits.MaxComplexity <= 0 {
+		return s.schema, nil
+	}
+	c, errs := checkComplexity(s.schema.AST(), s.limits, query, operationName, vars)
+	if errs != nil {
+		return nil, errs
+	}
+	if c == 0 {
+		return s.introspection, nil
+	}
+	return s.schema, nil
+}
+
+func (s *Server) withRequest(ctx context.Context, v Viewer) context.Context {
+	ctx = context.WithValue(ctx, viewerKey{}, v)
+	return context.WithValue(ctx, loadersKey{}, s.r.newLoaders(ctx))
+}
+
+// Exec выполняет запрос. Превышение лимитов возвращается ошибкой в ответе, как и прочие
+// ошибки GraphQL.
+func (s *Server) Exec(ctx context.Context, v Viewer, query, operationName string, vars map[string]any) *graphql.Response {
+	schema, errs := s.plan(query, operationName, vars)
+	if errs != nil {
+		operations.WithLabelValues("query", "error").Inc()
+		return &graphql.Response{Errors: errs}
+	}
+	resp := schema.Exec(s.withRequest(ctx, v), query, operationName, vars)
+	markDepthErrors(resp)
+	operations.WithLabelValues("query", result(resp)).Inc()
+	return resp
+}
+
+// Subscribe выполняет операцию с потоком ответов: подписка шлёт ответ на каждое событие,
+// запрос — один ответ. Канал закрывается по завершении или отмене ctx.
+func (s *Server) Subscribe(ctx context.Context, v Viewer, query, operationName string, vars map[string]any) (<-chan *graphql.Response, error) {
+	schema, errs := s.plan(query, operationName, vars)
+	if errs != nil {
+		operations.WithLabelValues("subscription", "error").Inc()
+		out := make(chan *graphql.Response, 1)
+		out <- &graphql.Response{Errors: errs}
+		close(out)
+		return out, nil
+	}
+	ctx = s.withRequest(ctx, v)
+	in, err := schema.Subscribe(ctx, query, operationName, vars)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *graphql.Response)
+	go func() {
+		defer close(out)
+		first := true
+		for msg := range in {
+			resp, ok := msg.(*graphql.Response)
+			if !ok {
+				continue
+			}
+			if first {
+				markDepthErrors(resp)
+				operations.WithLabelValues("subscription", result(resp)).Inc()
+				first = false
+			}
+			select {
+			case out <- resp:
+			case <-ctx.Done():
+				// graphql-go закроет in сам, когда заметит отмену
+			}
+		}
+	}()
+	return out, nil
+}
+
+func result(resp *graphql.Response) string {
+	if len(resp.Errors) > 0 {
+		return "error"
+	}
+	return "ok"
+}
+
+// gqlError — ошибка резолвера с кодом в extensions.code
+type gqlError struct {
+	msg  string
+	code string
+}
+
+func (e *gqlError) Error() string              { return e.msg }
+func (e *gqlError) Extensions() map[string]any { return map[string]any{"code": e.code} }
+
+func badInput(msg string) error {
+	return &gqlError{msg: msg, code: "BAD_USER_INPUT"}
+}
+
+// grpcError переводит ошибку сервиса в ошибку GraphQL, как writeError REST-хендлеров
+// переводит её в HTTP-статус.
+func (r *resolver) grpcError(op string, err error) error {
+	if errors.Is(err, context.Canceled) {
+		return &gqlError{msg: "request cancelled", code: "CANCELLED"}
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		r.log.Error(op+" failed (non-status error)", zap.Error(err))
+		return &gqlError{msg: "internal error", code: "INTERNAL"}
+	}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return &gqlError{msg: st.Message(), code: "BAD_USER_INPUT"}
+	case codes.Unauthenticated:
+		return &gqlError{msg: st.Message(), code: "UNAUTHENTICATED"}
+	case codes.PermissionDenied:
+		return &gqlError{msg: st.Message(), code: "FORBIDDEN"}
+	case codes.NotFound:
+		return &gqlError{msg: st.Message(), code: "NOT_FOUND"}
+	case codes.Unavailable, codes.DeadlineExceeded:
+		r.log.Warn(op+" failed: service unavailable", zap.String("code", st.Code().String()), zap.Error(err))
+		return &gqlError{msg: "service unavailable", code: "SERVICE_UNAVAILABLE"}
+	case codes.Canceled:
+		return &gqlError{msg: "request cancelled", code: "CANCELLED"}
+	default:
+		r.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+		return &gqlError{msg: "internal error", code: "INTERNAL"}
+	}
+}
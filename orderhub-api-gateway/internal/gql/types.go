@@ -0,0 +1,246 @@
+package gql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"api-gateway/internal/dto"
+	"api-gateway/internal/orderstatus"
+
+	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
+	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
+	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Long — скаляр для int64 (суммы в копейках): Int в GraphQL 32-битный.
+type Long int64
+
+func (Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *Long) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case int64:
+		*l = Long(v)
+	case float64:
+		*l = Long(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = Long(n)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", input)
+	}
+	return nil
+}
+
+func (l Long) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(l))
+}
+
+// orderStatus переводит ORDER_STATUS_PENDING в значение enum схемы PENDING
+func orderStatus(s string) string {
+	return strings.TrimPrefix(s, "ORDER_STATUS_")
+}
+
+func optString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nextOffset(n int32) *int32 {
+	// сервисы возвращают -1, когда страниц больше нет
+	if n < 0 {
+		return nil
+	}
+	return &n
+}
+
+type userResolver struct {
+	r  *resolver
+	me *dto.MeResponse
+}
+
+func (u *userResolver) ID() graphql.ID        { return graphql.ID(u.me.UserId) }
+func (u *userResolver) Email() string         { return u.me.Email }
+func (u *userResolver) Role() string          { return u.me.Role }
+func (u *userResolver) DisplayName() *string  { return optString(u.me.DisplayName) }
+func (u *userResolver) Phone() *string        { return optString(u.me.Phone) }
+func (u *userResolver) Locale() string        { return u.me.Locale }
+func (u *userResolver) TimeZone() string      { return u.me.TimeZone }
+func (u *userResolver) IsGuest() bool         { return u.me.IsGuest }
+func (u *userResolver) IsEmailVerified() bool { return u.me.IsEmailVerified }
+func (u *userResolver) IsPhoneVerified() bool { return u.me.IsPhoneVerified }
+
+func (u *userResolver) CreatedAt() graphql.Time {
+	t, _ := time.Parse(time.RFC3339, u.me.CreatedAt)
+	return graphql.Time{Time: t}
+}
+
+type userOrdersArgs struct {
+	Limit  int32
+	Offset int32
+	Status *string
+}
+
+// Orders — заказы самого пользователя. order-service сам ограничивает выборку владельцем
+// токена, а явный user_id принимает только от администратора, которому без него вернулись
+// бы заказы всех пользователей.
+func (u *userResolver) Orders(ctx context.Context, args userOrdersArgs) (*orderConnectionResolver, error) {
+	userID := ""
+	if viewerFrom(ctx).Role == commonv1.Role_ROLE_ADMIN.String() {
+		userID = u.me.UserId
+	}
+	return u.r.listOrders(ctx, ordersArgs{Limit: args.Limit, Offset: args.Offset, Status: args.Status}, userID)
+}
+
+type orderResolver struct {
+	o *orderv1.Order
+	l *loaders
+}
+
+func (o *orderResolver) ID() graphql.ID        { return graphql.ID(o.o.GetId().GetValue()) }
+func (o *orderResolver) UserID() graphql.ID    { return graphql.ID(o.o.GetUserId().GetValue()) }
+func (o *orderResolver) Status() string        { return orderStatus(o.o.GetStatus().String()) }
+func (o *orderResolver) TotalPriceCents() Long { return Long(o.o.GetTotalPriceCents()) }
+func (o *orderResolver) CurrencyCode() string  { return o.o.GetCurrencyCode() }
+func (o *orderResolver) CancelReason() *string { return optString(o.o.GetCancelReason()) }
+func (o *orderResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: o.o.GetCreatedAt().AsTime()}
+}
+func (o *orderResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: o.o.GetUpdatedAt().AsTime()}
+}
+
+func (o *orderResolver) Items() []*orderItemResolver {
+	out := make([]*orderItemResolver, 0, len(o.o.GetItems()))
+	for _, it := range o.o.GetItems() {
+		out = append(out, &orderItemResolver{it: it, l: o.l})
+	}
+	return out
+}
+
+// productIDs — ID товаров заказа для предзагрузки
+func (o *orderResolver) productIDs() []string {
+	ids := make([]string, 0, len(o.o.GetItems()))
+	for _, it := range o.o.GetItems() {
+		ids = append(ids, it.GetProductId().GetValue())
+	}
+	return ids
+}
+
+type orderItemResolver struct {
+	it *orderv1.OrderItem
+	l  *loaders
+}
+
+func (i *orderItemResolver) ProductID() graphql.ID { return graphql.ID(i.it.GetProductId().GetValue()) }
+func (i *orderItemResolver) Quantity() int32       { return int32(i.it.GetQuantity()) }
+func (i *orderItemResolver) UnitPriceCents() Long  { return Long(i.it.GetUnitPriceCents()) }
+func (i *orderItemResolver) LineTotalCents() Long  { return Long(i.it.GetLineTotalCents()) }
+func (i *orderItemResolver) CurrencyCode() string  { return i.it.GetCurrencyCode() }
+
+func (i *orderItemResolver) Product(ctx context.Context) (*productResolver, error) {
+	p, err := i.l.products.Load(ctx, i.it.GetProductId().GetValue())
+	if err != nil {
+		return nil, i.l.r.grpcError("BatchGetProducts", err)
+	}
+	if p == nil {
+		return nil, nil
+	}
+	return &productResolver{p: p, l: i.l}, nil
+}
+
+type productResolver struct {
+	p *inventoryv1.Product
+	l *loaders
+}
+
+func (p *productResolver) ID() graphql.ID       { return graphql.ID(p.p.GetId().GetValue()) }
+func (p *productResolver) VendorID() graphql.ID { return graphql.ID(p.p.GetVendorId().GetValue()) }
+func (p *productResolver) Sku() string          { return p.p.GetSku() }
+func (p *productResolver) Name() string         { return p.p.GetName() }
+func (p *productResolver) Description() string  { return p.p.GetDescription() }
+func (p *productResolver) ImageURL() *string    { return optString(p.p.GetImageUrl()) }
+func (p *productResolver) PriceCents() Long     { return Long(p.p.GetPriceCents()) }
+func (p *productResolver) CurrencyCode() string { return p.p.GetCurrencyCode() }
+func (p *productResolver) IsActive() bool       { return p.p.GetIsActive() }
+func (p *productResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: p.p.GetCreatedAt().AsTime()}
+}
+func (p *productResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: p.p.GetUpdatedAt().AsTime()}
+}
+
+func (p *productResolver) Stock(ctx context.Context) (*stockResolver, error) {
+	st, err := p.l.stock.Load(ctx, p.p.GetId().GetValue())
+	if err != nil {
+		return nil, p.l.r.grpcError("BatchGetStock", err)
+	}
+	if st == nil {
+		return nil, nil
+	}
+	return &stockResolver{st: st}, nil
+}
+
+type stockResolver struct {
+	st *inventoryv1.Stock
+}
+
+func (s *stockResolver) Available() int32 { return s.st.GetAvailable() }
+func (s *stockResolver) Reserved() int32  { return s.st.GetReserved() }
+func (s *stockResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: s.st.GetUpdatedAt().AsTime()}
+}
+
+type orderConnectionResolver struct {
+	nodes      []*orderResolver
+	total      int32
+	nextOffset int32
+}
+
+func (c *orderConnectionResolver) Nodes() []*orderResolver { return c.nodes }
+func (c *orderConnectionResolver) Total() int32            { return c.total }
+func (c *orderConnectionResolver) NextOffset() *int32      { return nextOffset(c.nextOffset) }
+
+type productConnectionResolver struct {
+	nodes      []*productResolver
+	total      int32
+	nextOffset int32
+}
+
+func (c *productConnectionResolver) Nodes() []*productResolver { return c.nodes }
+func (c *productConnectionResolver) Total() int32              { return c.total }
+func (c *productConnectionResolver) NextOffset() *int32        { return nextOffset(c.nextOffset) }
+
+type orderStatusEventResolver struct {
+	r  *resolver
+	ev orderstatus.Event
+}
+
+func (e *orderStatusEventResolver) ID() graphql.ID      { return graphql.ID(e.ev.ID) }
+func (e *orderStatusEventResolver) OrderID() graphql.ID { return graphql.ID(e.ev.OrderID) }
+func (e *orderStatusEventResolver) UserID() graphql.ID  { return graphql.ID(e.ev.UserID) }
+func (e *orderStatusEventResolver) Status() string      { return orderStatus(e.ev.Status) }
+func (e *orderStatusEventResolver) PreviousStatus() *string {
+	return optString(orderStatus(e.ev.PreviousStatus))
+}
+func (e *orderStatusEventResolver) Reason() *string { return optString(e.ev.Reason) }
+func (e *orderStatusEventResolver) ChangedAt() graphql.Time {
+	return graphql.Time{Time: e.ev.ChangedAt}
+}
+
+// Order запрашивает заказ заново: кэш загрузчиков подписки живёт одно событие.
+func (e *orderStatusEventResolver) Order(ctx context.Context) (*orderResolver, error) {
+	return e.r.order(ctx, e.r.newLoaders(ctx), e.ev.OrderID)
+}
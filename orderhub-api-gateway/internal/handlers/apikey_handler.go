@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHandler — персональные API-ключи для программного доступа
+// (заголовок "Authorization: ApiKey <ключ>")
+type APIKeyHandler struct {
+	authClient *auth.Client
+	log        *zap.Logger
+}
+
+func NewAPIKeyHandler(authClient *auth.Client, log *zap.Logger) *APIKeyHandler {
+	return &APIKeyHandler{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+func (h *APIKeyHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// CreateAPIKey godoc
+// @Summary Создать API-ключ
+// @Description Выпускает именованный ключ с правами из числа прав пользователя. Ключ показывается только в этом ответе. Управлять ключами можно только после входа по паролю, не по самому ключу.
+// @Security BearerAuth
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param key body dto.CreateAPIKeyRequest true "Название, права и срок действия"
+// @Success 201 {object} dto.CreateAPIKeyResponse "Ключ создан"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Права не выданы пользователю или запрос сделан по API-ключу"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.CreateAPIKey(withBearer(c), req)
+	if err != nil {
+		h.writeError(c, "CreateApiKey", err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListAPIKeys godoc
+// @Summary Мои API-ключи
+// @Description Ключи текущего пользователя, включая отозванные; открытые значения не возвращаются
+// @Security BearerAuth
+// @Tags api-keys
+// @Produce json
+// @Success 200 {object} dto.ListAPIKeysResponse "Ключи"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Запрос сделан по API-ключу"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	resp, err := h.authClient.ListAPIKeys(withBearer(c))
+	if err != nil {
+		h.writeError(c, "ListApiKeys", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RevokeAPIKey godoc
+// @Summary Отозвать API-ключ
+// @Description Ключ перестаёт приниматься; сервисы, закэшировавшие проверку, отпустят его не позже чем через 30 секунд
+// @Security BearerAuth
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "ID ключа"
+// @Success 200 {object} dto.SuccessResponse "Ключ отозван"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный ID"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Запрос сделан по API-ключу"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Ключ не найден или уже отозван"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/api-keys/{id} [delete]
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	if err := h.authClient.RevokeAPIKey(withBearer(c), c.Param("id")); err != nil {
+		h.writeError(c, "RevokeApiKey", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("api key revoked"))
+}
@@ -1,15 +1,22 @@
 package handlers
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
 	"net/http"
 	"strings"
 
+	"api-gateway/config"
 	"api-gateway/internal/auth"
 	"api-gateway/internal/dto"
 	"api-gateway/internal/middleware"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -17,12 +24,14 @@ import (
 
 type AuthHandler struct {
 	authClient *auth.Client
+	cookies    config.Cookies
 	log        *zap.Logger
 }
 
-func NewAuthHandler(authClient *auth.Client, log *zap.Logger) *AuthHandler {
+func NewAuthHandler(authClient *auth.Client, cookies config.Cookies, log *zap.Logger) *AuthHandler {
 	return &AuthHandler{
 		authClient: authClient,
+		cookies:    cookies,
 		log:        log,
 	}
 }
@@ -56,7 +65,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 			switch st.Code() {
 			case codes.InvalidArgument:
 				h.log.Warn("Validation failed at auth service", zap.String("email", req.Email), zap.Error(err))
-				c.JSON(http.StatusBadRequest, dto.NewValidationError("validation failed", []dto.FieldError{}))
+				c.JSON(http.StatusBadRequest, dto.NewValidationError("validation failed", fieldErrorsFromStatus(st)))
 				return
 			case codes.AlreadyExists:
 				h.log.Warn("User already exists", zap.String("email", req.Email))
@@ -79,14 +88,16 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 // LoginHandler godoc
 // @Summary Авторизация пользователя
-// @Description Авторизует пользователя и выдаёт пару токенов (access/refresh)
+// @Description Авторизует пользователя и выдаёт пару токенов (access/refresh). С refresh_cookie=true refresh-токен приходит в HttpOnly-cookie (путь /api/v1/auth) вместе с CSRF-cookie, а не в теле ответа.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param login body dto.LoginRequest true "Данные авторизации"
+// @Param DPoP header string false "DPoP-доказательство (RFC 9449): токены привязываются к ключу клиента"
 // @Success 200 {object} dto.LoginResponse
 // @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
 // @Failure 401 {object} dto.UnauthorizedErrorResponse "Ошибка авторизации"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Аккаунт заблокирован или требуется сброс пароля"
 // @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
 // @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
 // @Router /api/v1/auth/login [post]
@@ -98,11 +109,17 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, verr)
 		return
 	}
+	req.DPoP = middleware.DPoPFromRequest(c)
 
-	resp, err := h.authClient.Login(c.Request.Context(), req)
+	resp, err := h.authClient.Login(withClientMeta(c), req)
+	middleware.SetDPoPNonce(c, req.DPoP)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
+			if writeDPoPError(c, st) {
+				h.log.Warn("DPoP proof rejected", zap.String("email", req.Email), zap.Error(err))
+				return
+			}
 			switch st.Code() {
 			case codes.InvalidArgument:
 				h.log.Warn("Validation failed at auth service", zap.String("email", req.Email), zap.Error(err))
@@ -116,6 +133,15 @@ func (h *AuthHandler) Login(c *gin.Context) {
 				h.log.Warn("User not authenticated", zap.String("email", req.Email))
 				c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError("user not authenticated"))
 				return
+			case codes.PermissionDenied:
+				h.log.Warn("Account disabled", zap.String("email", req.Email))
+				c.JSON(http.StatusForbidden, dto.NewForbiddenError("account disabled"))
+				return
+			case codes.FailedPrecondition:
+				// после «это был не я» вход возможен только после сброса пароля
+				h.log.Warn("Password reset required", zap.String("email", req.Email))
+				c.JSON(http.StatusForbidden, dto.NewForbiddenError(trimStatusMessage(st.Message())))
+				return
 			default:
 				h.log.Error("Internal service error", zap.String("code", st.Code().String()), zap.Error(err))
 				c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
@@ -127,34 +153,55 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	if req.RefreshCookie {
+		setRefreshCookie(c, h.cookies, resp.Tokens.RefreshToken, resp.Tokens.RefreshExpiresIn)
+		resp.Tokens.RefreshToken = ""
+	}
 	c.JSON(http.StatusOK, resp)
 }
 
 // RefreshHandler godoc
 // @Summary Обновление токена
-// @Description Обновляет пару токенов по refresh токену
+// @Description Обновляет пару токенов по refresh токену из тела. Без него токен берётся из refresh-cookie: тогда обязателен заголовок X-CSRF-Token со значением CSRF-cookie, а новый токен снова приходит в cookie.
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Param refresh body dto.RefreshRequest true "Данные для обновления токена"
+// @Param refresh body dto.RefreshRequest false "Данные для обновления токена"
+// @Param DPoP header string false "DPoP-доказательство; обязательно для токенов, привязанных к ключу"
+// @Param X-CSRF-Token header string false "Значение CSRF-cookie; обязательно в cookie-режиме"
 // @Success 200 {object} dto.RefreshResponse
 // @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
 // @Failure 401 {object} dto.UnauthorizedErrorResponse "Ошибка авторизации"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Неверный CSRF-токен"
 // @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
 // @Router /api/v1/auth/refresh [post]
 func (h *AuthHandler) Refresh(c *gin.Context) {
 	var req dto.RefreshRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
 		h.log.Warn("Invalid refresh request", zap.Error(err))
 		verr := dto.NewValidationError("invalid request body", []dto.FieldError{})
 		c.JSON(http.StatusBadRequest, verr)
 		return
 	}
+	cookieMode := false
+	if req.RefreshToken == "" {
+		if req.RefreshToken = refreshFromCookie(c, h.cookies); req.RefreshToken == "" {
+			c.JSON(http.StatusBadRequest, dto.NewValidationError("refresh_token is required", []dto.FieldError{}))
+			return
+		}
+		cookieMode = true
+	}
+	req.DPoP = middleware.DPoPFromRequest(c)
 
 	resp, err := h.authClient.Refresh(c.Request.Context(), req)
+	middleware.SetDPoPNonce(c, req.DPoP)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
+			if writeDPoPError(c, st) {
+				h.log.Warn("DPoP proof rejected", zap.Error(err))
+				return
+			}
 			switch st.Code() {
 			case codes.InvalidArgument:
 				h.log.Warn("Validation failed at auth service", zap.String("refresh_token", req.RefreshToken), zap.Error(err))
@@ -162,15 +209,22 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 				return
 			case codes.NotFound:
 				h.log.Warn("Refresh token not found", zap.String("refresh_token", req.RefreshToken))
+				if cookieMode {
+					clearRefreshCookie(c, h.cookies)
+				}
 				c.JSON(http.StatusNotFound, dto.NewNotFoundError("user with this refresh token not found"))
 				return
 			case codes.Unauthenticated:
 				h.log.Warn("User not authenticated", zap.String("refresh_token", req.RefreshToken))
+				if cookieMode {
+					clearRefreshCookie(c, h.cookies)
+				}
 				c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError("user not authenticated"))
 				return
 			default:
 				h.log.Error("Internal service error", zap.String("code", st.Code().String()), zap.Error(err))
 				c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+				return
 			}
 		}
 		h.log.Error("Refresh failed (non-status error)", zap.Error(err))
@@ -178,6 +232,10 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		return
 	}
 
+	if cookieMode {
+		setRefreshCookie(c, h.cookies, resp.Tokens.RefreshToken, resp.Tokens.RefreshExpiresIn)
+		resp.Tokens.RefreshToken = ""
+	}
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -262,7 +320,7 @@ func (h *AuthHandler) ConfirmPasswordReset(c *gin.Context) {
 				return
 			case codes.InvalidArgument:
 				h.log.Warn("Password reset confirm failed (invalid argument)", zap.String("code", req.Code))
-				c.JSON(http.StatusBadRequest, dto.NewValidationError("password reset confirm failed (invalid argument)", []dto.FieldError{}))
+				c.JSON(http.StatusBadRequest, dto.NewValidationError("password reset confirm failed (invalid argument)", fieldErrorsFromStatus(st)))
 				return
 			default:
 				h.log.Error("Internal service error", zap.String("code", st.Code().String()), zap.Error(err))
@@ -294,6 +352,69 @@ func (h *AuthHandler) GetJwks(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// clientIDCookie — постоянный идентификатор браузера, по нему auth-service узнаёт устройство
+const clientIDCookie = "cid"
+
+// withClientMeta передаёт в auth-service адрес, user agent и cid клиента. Если cookie
+// ещё нет, gateway выдаёт новый cid, иначе каждый вход выглядел бы как вход с нового устройства.
+func withClientMeta(c *gin.Context) context.Context {
+	clientID := strings.TrimSpace(c.GetHeader("X-Client-ID"))
+	if clientID == "" {
+		clientID, _ = c.Cookie(clientIDCookie)
+	}
+	if clientID == "" {
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err == nil {
+			clientID = hex.EncodeToString(buf)
+			c.SetSameSite(http.SameSiteLaxMode)
+			c.SetCookie(clientIDCookie, clientID, 5*365*24*60*60, "/", "", true, true)
+		}
+	}
+
+	md := metadata.Pairs(
+		"x-forwarded-for", c.ClientIP(),
+		"grpcgateway-user-agent", c.Request.UserAgent(),
+	)
+	if clientID != "" {
+		md.Set("x-client-id", clientID)
+	}
+	return metadata.NewOutgoingContext(c.Request.Context(), md)
+}
+
+// writeDPoPError отвечает на отказ auth-service по DPoP-доказательству кодами RFC 9449;
+// false — ошибка не связана с DPoP
+func writeDPoPError(c *gin.Context, st *status.Status) bool {
+	switch st.Message() {
+	case "invalid_dpop_proof":
+		c.JSON(http.StatusBadRequest, dto.NewDPoPError("invalid_dpop_proof", "invalid DPoP proof"))
+	case "use_dpop_nonce":
+		c.JSON(http.StatusBadRequest, dto.NewDPoPError("use_dpop_nonce", "use the nonce from the DPoP-Nonce header"))
+	default:
+		return false
+	}
+	return true
+}
+
+// fieldErrorsFromStatus достаёт ошибки полей из деталей BadRequest gRPC-статуса
+// (например, нарушения политики паролей); Tag — машинный код нарушения
+func fieldErrorsFromStatus(st *status.Status) []dto.FieldError {
+	out := []dto.FieldError{}
+	for _, d := range st.Details() {
+		br, ok := d.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range br.GetFieldViolations() {
+			out = append(out, dto.FieldError{
+				Field:   v.GetField(),
+				Message: v.GetDescription(),
+				Tag:     v.GetReason(),
+			})
+		}
+	}
+	return out
+}
+
 func trimStatusMessage(msg string) string {
 	// Убираем возможные приставки вроде "validation failed:" чтобы клиенту было чище
 	lower := strings.ToLower(msg)
@@ -309,24 +430,29 @@ func trimStatusMessage(msg string) string {
 
 // LogoutHandler godoc
 // @Summary Выход из системы
-// @Description Логаут по refresh_token (single) или массовый логаут по all=true
+// @Description Логаут по refresh_token (single) или массовый логаут по all=true. Без refresh_token в теле используется refresh-cookie (с заголовком X-CSRF-Token); cookie удаляются.
 // @Security BearerAuth
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Param logout body dto.LogoutRequest true "Refresh token или all=true"
+// @Param logout body dto.LogoutRequest false "Refresh token или all=true"
+// @Param X-CSRF-Token header string false "Значение CSRF-cookie; обязательно в cookie-режиме"
 // @Success 200 {object} dto.SuccessResponse "Успешный логаут"
 // @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Неверный CSRF-токен"
 // @Failure 404 {object} dto.NotFoundErrorResponse "Токен не найден"
 // @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
 // @Router /api/v1/auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
 	var req dto.LogoutRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
 		h.log.Warn("Invalid logout request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
 		return
 	}
+	if strings.TrimSpace(req.RefreshToken) == "" && !req.All {
+		req.RefreshToken = refreshFromCookie(c, h.cookies)
+	}
 
 	if strings.TrimSpace(req.RefreshToken) == "" && !req.All {
 		c.JSON(http.StatusBadRequest, dto.NewValidationError("specify refresh_token or all=true", []dto.FieldError{}))
@@ -350,6 +476,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 				c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
 				return
 			case codes.NotFound:
+				clearRefreshCookie(c, h.cookies)
 				c.JSON(http.StatusNotFound, dto.NewNotFoundError("refresh token not found or revoked"))
 				return
 			default:
@@ -363,6 +490,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 		return
 	}
 
+	clearRefreshCookie(c, h.cookies)
 	c.JSON(http.StatusOK, dto.NewSuccessResponse("logged out"))
 }
 
@@ -469,3 +597,260 @@ func (h *AuthHandler) ConfirmEmailVerification(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.NewSuccessResponse("email verified"))
 }
+
+// DeleteAccountHandler godoc
+// @Summary Удаление аккаунта
+// @Description Удаляет учётную запись текущего пользователя (требуется пароль). Все токены и сессии отзываются
+// @Security BearerAuth
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param account body dto.DeleteAccountRequest true "Пароль для подтверждения"
+// @Success 200 {object} dto.SuccessResponse "Аккаунт удалён"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Неверный пароль"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/account [delete]
+func (h *AuthHandler) DeleteAccount(c *gin.Context) {
+	var req dto.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("Invalid delete account request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	if err := h.authClient.DeleteAccount(withBearer(c), req); err != nil {
+		h.writeAccountError(c, "DeleteAccount", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("account deleted"))
+}
+
+// CreateGuestHandler godoc
+// @Summary Гостевой аккаунт
+// @Description Создаёт анонимного покупателя и выдаёт пару токенов, чтобы оформить заказ без регистрации
+// @Tags auth
+// @Produce json
+// @Success 200 {object} dto.CreateGuestResponse
+// @Failure 429 {object} dto.TooManyRequestsErrorResponse "Слишком много запросов"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/guest [post]
+func (h *AuthHandler) CreateGuest(c *gin.Context) {
+	resp, err := h.authClient.CreateGuest(withClientMeta(c))
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.ResourceExhausted {
+			h.log.Warn("Guest creation rate limited", zap.String("ip", c.ClientIP()))
+			c.JSON(http.StatusTooManyRequests, dto.NewTooManyRequestsError("too many requests"))
+			return
+		}
+		h.log.Error("CreateGuest failed", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpgradeGuestHandler godoc
+// @Summary Регистрация гостя
+// @Description Привязывает email и пароль к текущему гостевому аккаунту. ID пользователя и заказы сохраняются, на почту уходит письмо подтверждения
+// @Security BearerAuth
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param guest body dto.UpgradeGuestRequest true "Email и пароль"
+// @Success 200 {object} dto.UpgradeGuestResponse
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Аккаунт уже зарегистрирован"
+// @Failure 409 {object} dto.ConflictErrorResponse "Email уже занят"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/guest/upgrade [post]
+func (h *AuthHandler) UpgradeGuest(c *gin.Context) {
+	var req dto.UpgradeGuestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Warn("Invalid upgrade guest request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.UpgradeGuest(withBearer(c), req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				c.JSON(http.StatusBadRequest, dto.NewValidationError("validation failed", fieldErrorsFromStatus(st)))
+				return
+			case codes.AlreadyExists:
+				c.JSON(http.StatusConflict, dto.NewConflictError("user with this email already exists"))
+				return
+			case codes.FailedPrecondition:
+				c.JSON(http.StatusForbidden, dto.NewForbiddenError(trimStatusMessage(st.Message())))
+				return
+			}
+		}
+		h.writeAccountError(c, "UpgradeGuest", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetMeHandler godoc
+// @Summary Мой профиль
+// @Description Профиль текущего пользователя: имя, телефон, язык писем, часовой пояс и согласие на рассылки
+// @Security BearerAuth
+// @Tags auth
+// @Produce json
+// @Success 200 {object} dto.MeResponse "Профиль"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/me [get]
+func (h *AuthHandler) GetMe(c *gin.Context) {
+	resp, err := h.authClient.GetMe(withBearer(c))
+	if err != nil {
+		h.writeAccountError(c, "GetMe", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateMeHandler godoc
+// @Summary Обновить профиль
+// @Description Меняет только переданные поля. Телефон — в формате E.164, язык — BCP 47 (ru, en-US), часовой пояс — IANA (Europe/Moscow). Пустая строка очищает имя или телефон. Согласие на рассылки нельзя менять под impersonation-токеном.
+// @Security BearerAuth
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param profile body dto.UpdateMeRequest true "Изменяемые поля профиля"
+// @Success 200 {object} dto.MeResponse "Обновлённый профиль"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Согласие на рассылки под impersonation-токеном"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/me [patch]
+func (h *AuthHandler) UpdateMe(c *gin.Context) {
+	var req dto.UpdateMeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.UpdateMe(withBearer(c), req)
+	if err != nil {
+		h.writeAccountError(c, "UpdateMe", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RequestPhoneVerificationHandler godoc
+// @Summary Отправить SMS-код
+// @Description Отправляет 6-значный код на телефон из профиля. Код действует 10 минут, новый можно запросить не чаще раза в минуту.
+// @Security BearerAuth
+// @Tags auth
+// @Produce json
+// @Success 200 {object} dto.RequestPhoneVerificationResponse "Код отправлен"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 409 {object} dto.ConflictErrorResponse "Телефон не указан или уже подтверждён"
+// @Failure 429 {object} dto.TooManyRequestsErrorResponse "Слишком много запросов"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/phone/verification/request [post]
+func (h *AuthHandler) RequestPhoneVerification(c *gin.Context) {
+	resp, err := h.authClient.RequestPhoneVerification(withBearer(c))
+	if err != nil {
+		h.writePhoneError(c, "RequestPhoneVerification", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ConfirmPhoneVerificationHandler godoc
+// @Summary Подтвердить телефон
+// @Description Подтверждает телефон кодом из SMS. На один код даётся 5 попыток, после этого нужно запросить новый.
+// @Security BearerAuth
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param confirm body dto.ConfirmPhoneVerificationRequest true "Код из SMS"
+// @Success 200 {object} dto.SuccessResponse "Телефон подтверждён"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный или истёкший код"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 409 {object} dto.ConflictErrorResponse "Телефон не указан или уже подтверждён"
+// @Failure 429 {object} dto.TooManyRequestsErrorResponse "Попытки исчерпаны"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/phone/verification/confirm [post]
+func (h *AuthHandler) ConfirmPhoneVerification(c *gin.Context) {
+	var req dto.ConfirmPhoneVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	if err := h.authClient.ConfirmPhoneVerification(withBearer(c), req); err != nil {
+		h.writePhoneError(c, "ConfirmPhoneVerification", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("phone verified"))
+}
+
+func (h *AuthHandler) writePhoneError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, dto.NewConflictError(st.Message()))
+			return
+		case codes.ResourceExhausted:
+			c.JSON(http.StatusTooManyRequests, dto.NewTooManyRequestsError(st.Message()))
+			return
+		}
+	}
+	h.writeAccountError(c, op, err)
+}
+
+// ExportMyDataHandler godoc
+// @Summary Выгрузка персональных данных
+// @Description Возвращает JSON-архив всех данных, которые auth-service хранит о текущем пользователе
+// @Security BearerAuth
+// @Tags auth
+// @Produce json
+// @Success 200 {file} file "JSON-архив"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/me/export [get]
+func (h *AuthHandler) ExportMyData(c *gin.Context) {
+	data, err := h.authClient.ExportMyData(withBearer(c))
+	if err != nil {
+		h.writeAccountError(c, "ExportMyData", err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="orderhub-my-data.json"`)
+	c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+}
+
+func (h *AuthHandler) writeAccountError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
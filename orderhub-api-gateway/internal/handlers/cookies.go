@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"api-gateway/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// refreshCookiePath — refresh-cookie уходит только на эндпоинты auth, а не с каждым запросом
+const refreshCookiePath = "/api/v1/auth"
+
+// setRefreshCookie кладёт refresh-токен в HttpOnly-cookie до его истечения (expiresAt —
+// unix-время) и выставляет CSRF-cookie для double submit. CSRF-токен выдаётся заново при
+// каждом входе и обновлении: значение, подложенное до входа (fixation), не переживёт его.
+// Вкладки не ломаются — SPA читает актуальное значение из общей cookie перед запросом.
+func setRefreshCookie(c *gin.Context, cfg config.Cookies, token string, expiresAt int64) {
+	expires := time.Unix(expiresAt, 0)
+	maxAge := int(time.Until(expires).Seconds())
+	if maxAge <= 0 {
+		return
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     cfg.RefreshName,
+		Value:    token,
+		Path:     refreshCookiePath,
+		Domain:   cfg.Domain,
+		Expires:  expires,
+		MaxAge:   maxAge,
+		Secure:   cfg.Secure,
+		HttpOnly: true,
+		SameSite: cfg.SameSite,
+	})
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return
+	}
+	csrf := hex.EncodeToString(buf)
+	// путь "/" — SPA читает значение из document.cookie на любой странице
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     cfg.CSRFName,
+		Value:    csrf,
+		Path:     "/",
+		Domain:   cfg.Domain,
+		Expires:  expires,
+		MaxAge:   maxAge,
+		Secure:   cfg.Secure,
+		SameSite: cfg.SameSite,
+	})
+}
+
+// clearRefreshCookie удаляет refresh- и CSRF-cookie (logout или недействительный токен)
+func clearRefreshCookie(c *gin.Context, cfg config.Cookies) {
+	for _, ck := range []struct{ name, path string }{{cfg.RefreshName, refreshCookiePath}, {cfg.CSRFName, "/"}} {
+		http.SetCookie(c.Writer, &http.Cookie{
+			Name:     ck.name,
+			Path:     ck.path,
+			Domain:   cfg.Domain,
+			MaxAge:   -1,
+			Secure:   cfg.Secure,
+			HttpOnly: ck.name == cfg.RefreshName,
+			SameSite: cfg.SameSite,
+		})
+	}
+}
+
+// refreshFromCookie — refresh-токен из cookie; пусто, если клиент работает в режиме тела
+func refreshFromCookie(c *gin.Context, cfg config.Cookies) string {
+	token, _ := c.Cookie(cfg.RefreshName)
+	return token
+}
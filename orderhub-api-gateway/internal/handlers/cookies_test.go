@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"api-gateway/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+func testCookies() config.Cookies {
+	return config.Cookies{RefreshName: "orderhub_refresh", CSRFName: "orderhub_csrf", Secure: true, SameSite: http.SameSiteStrictMode}
+}
+
+// issueCookies вызывает setRefreshCookie на запросе с cookie из sent и возвращает выданные
+func issueCookies(t *testing.T, sent ...*http.Cookie) map[string]*http.Cookie {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+	for _, ck := range sent {
+		c.Request.AddCookie(ck)
+	}
+	setRefreshCookie(c, testCookies(), "refresh-token", time.Now().Add(time.Hour).Unix())
+
+	got := make(map[string]*http.Cookie)
+	for _, ck := range w.Result().Cookies() {
+		got[ck.Name] = ck
+	}
+	return got
+}
+
+func TestSetRefreshCookie(t *testing.T) {
+	got := issueCookies(t)
+	rt, csrf := got["orderhub_refresh"], got["orderhub_csrf"]
+	if rt == nil || csrf == nil {
+		t.Fatalf("want refresh and CSRF cookies, got %v", got)
+	}
+	if !rt.HttpOnly || rt.Path != refreshCookiePath || !rt.Secure || rt.SameSite != http.SameSiteStrictMode {
+		t.Errorf("refresh cookie attributes: %+v", rt)
+	}
+	if csrf.HttpOnly || csrf.Path != "/" || len(csrf.Value) != 64 {
+		t.Errorf("CSRF cookie must be readable from JS on every path, got %+v", csrf)
+	}
+}
+
+func TestSetRefreshCookie_IssuesFreshCSRFToken(t *testing.T) {
+	// значение, подложенное до входа, не должно пережить вход
+	planted := &http.Cookie{Name: "orderhub_csrf", Value: "attacker-known-value"}
+	first := issueCookies(t, planted)["orderhub_csrf"]
+	if first == nil || first.Value == planted.Value {
+		t.Fatalf("login must replace a pre-existing CSRF cookie, got %+v", first)
+	}
+
+	// обновление тоже выдаёт новый токен
+	second := issueCookies(t, first)["orderhub_csrf"]
+	if second == nil || second.Value == first.Value {
+		t.Fatalf("refresh must rotate the CSRF token, got %+v", second)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"net/http"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeviceHandler — доверенные устройства и ссылка «это был не я» из письма о новом входе
+type DeviceHandler struct {
+	authClient *auth.Client
+	log        *zap.Logger
+}
+
+func NewDeviceHandler(authClient *auth.Client, log *zap.Logger) *DeviceHandler {
+	return &DeviceHandler{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+func (h *DeviceHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// ListTrustedDevices godoc
+// @Summary Мои устройства
+// @Description Браузеры и сети, из которых выполнялся вход; вход с незнакомых отправляет письмо о новом входе
+// @Security BearerAuth
+// @Tags devices
+// @Produce json
+// @Success 200 {object} dto.ListTrustedDevicesResponse "Устройства, недавние первыми"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/devices [get]
+func (h *DeviceHandler) ListTrustedDevices(c *gin.Context) {
+	resp, err := h.authClient.ListTrustedDevices(withBearer(c))
+	if err != nil {
+		h.writeError(c, "ListTrustedDevices", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ForgetTrustedDevice godoc
+// @Summary Забыть устройство
+// @Description Следующий вход с этого устройства снова вызовет письмо о новом входе
+// @Security BearerAuth
+// @Tags devices
+// @Produce json
+// @Param id path string true "ID устройства"
+// @Success 200 {object} dto.SuccessResponse "Устройство удалено"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный ID"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Устройство не найдено"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/devices/{id} [delete]
+func (h *DeviceHandler) ForgetTrustedDevice(c *gin.Context) {
+	if err := h.authClient.ForgetTrustedDevice(withBearer(c), c.Param("id")); err != nil {
+		h.writeError(c, "ForgetTrustedDevice", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("device forgotten"))
+}
+
+// ReportSignIn godoc
+// @Summary Это был не я
+// @Description Токен из письма о новом входе. Завершает все сеансы, отзывает токены и блокирует вход до сброса пароля; код сброса приходит на почту
+// @Tags devices
+// @Accept json
+// @Produce json
+// @Param report body dto.ReportSignInRequest true "Токен из письма"
+// @Success 200 {object} dto.SuccessResponse "Сеансы завершены"
+// @Failure 400 {object} dto.ValidationErrorResponse "Ссылка недействительна или истекла"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/auth/sign-in-alerts/report [post]
+func (h *DeviceHandler) ReportSignIn(c *gin.Context) {
+	var req dto.ReportSignInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	if err := h.authClient.ReportSignIn(c.Request.Context(), req); err != nil {
+		h.writeError(c, "ReportSignIn", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("sessions revoked, password reset required"))
+}
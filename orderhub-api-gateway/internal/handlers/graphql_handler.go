@@ -0,0 +1,346 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"api-gateway/internal/dto"
+	"api-gateway/internal/gql"
+	"api-gateway/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// протокол graphql-transport-ws (библиотека graphql-ws, Apollo Client, urql)
+	gqlWSProtocol = "graphql-transport-ws"
+	// gqlInitTimeout — сколько ждать connection_init после открытия соединения
+	gqlInitTimeout = 10 * time.Second
+	// gqlMaxOperations — сколько операций одновременно идёт в одном соединении
+	gqlMaxOperations = 32
+)
+
+// коды закрытия graphql-transport-ws
+const (
+	gqlCloseBadRequest       = 4400
+	gqlCloseUnauthorized     = 4401
+	gqlCloseNotAcceptable    = 4406
+	gqlCloseInitTimeout      = 4408
+	gqlCloseSubscriberExists = 4409
+	gqlCloseTooManyInits     = 4429
+)
+
+// GraphQLHandler — /graphql: запросы по POST, подписки по WebSocket
+type GraphQLHandler struct {
+	server   *gql.Server
+	upgrader websocket.Upgrader
+	log      *zap.Logger
+}
+
+func NewGraphQLHandler(server *gql.Server, allowedOrigins []string, log *zap.Logger) *GraphQLHandler {
+	return &GraphQLHandler{
+		server: server,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  4096,
+			WriteBufferSize: 4096,
+			Subprotocols:    []string{gqlWSProtocol},
+			CheckOrigin: func(r *http.Request) bool {
+				return originAllowed(r.Header.Get("Origin"), allowedOrigins)
+			},
+		},
+		log: log,
+	}
+}
+
+func viewer(c *gin.Context) gql.Viewer {
+	perms, _ := c.Get(middleware.CtxUserPerms)
+	return gql.Viewer{
+		UserID: c.GetString(middleware.CtxUserID),
+		Role:   c.GetString(middleware.CtxUserRole),
+		Perms:  asStrings(perms),
+	}
+}
+
+// Query godoc
+// @Summary GraphQL
+// @Description Запросы к схеме internal/gql/schema.graphql: пользователь, заказы с товарами и остатками, каталог.
+// @Description Товары и остатки позиций загружаются пакетно (BatchGetProducts/BatchGetStock) на весь запрос.
+// @Description Запрос глубже GRAPHQL_MAX_DEPTH или сложнее GRAPHQL_MAX_COMPLEXITY отклоняется с кодом
+// @Description QUERY_TOO_DEEP / QUERY_TOO_COMPLEX в extensions.code. Ошибки GraphQL возвращаются со статусом 200.
+// @Description Подписки — по WebSocket на GET /graphql (протокол graphql-transport-ws).
+// @Security BearerAuth
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body dto.GraphQLRequest true "Запрос GraphQL"
+// @Success 200 {object} dto.GraphQLResponse "Результат"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверное тело запроса"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req dto.GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+	resp := h.server.Exec(withBearer(c), viewer(c), req.Query, req.OperationName, req.Variables)
+	c.JSON(http.StatusOK, resp)
+}
+
+// Subscribe godoc
+// @Summary GraphQL-подписки (WebSocket)
+// @Description WebSocket с подпротоколом graphql-transport-ws: connection_init → connection_ack, затем subscribe/next/complete.
+// @Description Подписка orderStatusChanged отдаёт смены статусов своих заказов, с правом order:read:any — любых.
+// @Description Токен проверяется при открытии соединения: заголовок Authorization или параметр access_token
+// @Description (payload connection_init не читается). Пропущенные события не догоняются — для этого есть SSE-стрим с Last-Event-ID.
+// @Description Когда токен истекает или отозван, соединение закрывается с кодом 4401. В соединении одновременно
+// @Description идёт не больше 32 операций: следующий subscribe закрывает его с кодом 4400.
+// @Security BearerAuth
+// @Tags graphql
+// @Param access_token query string false "Access-токен, если нельзя передать заголовок Authorization"
+// @Success 101 {object} dto.GraphQLResponse "Переключение на WebSocket"
+// @Failure 400 {object} dto.ValidationErrorResponse "Не WebSocket-запрос"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Router /graphql [get]
+func (h *GraphQLHandler) Subscribe(c *gin.Context) {
+	if !websocket.IsWebSocketUpgrade(c.Request) {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("websocket upgrade required, use POST for queries", []dto.FieldError{}))
+		return
+	}
+	v := viewer(c)
+	// метаданные authorization нужны резолверам всё время жизни соединения; соединение
+	// закрывается, когда токен истекает или отозван
+	ctx, cancel := middleware.SessionContext(c, withBearer(c))
+	defer cancel()
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		h.log.Debug("websocket upgrade failed", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+	c.Set(middleware.CtxLongLived, true)
+
+	s := &gqlSession{h: h, conn: conn, viewer: v, ops: make(map[string]*gqlOp)}
+	if conn.Subprotocol() != gqlWSProtocol {
+		s.close(gqlCloseNotAcceptable, "subprotocol not acceptable")
+		return
+	}
+	go s.keepalive(ctx)
+	go func() {
+		<-ctx.Done()
+		if middleware.SessionExpired(ctx) {
+			s.close(gqlCloseUnauthorized, "token expired")
+			_ = conn.Close() // serve выходит из ReadMessage
+		}
+	}()
+	s.serve(ctx)
+	s.cancelAll()
+}
+
+type gqlMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// gqlSession — одно соединение graphql-transport-ws. Читает только serve,
+// пишут serve, keepalive и горутины операций — под writeMu.
+type gqlSession struct {
+	h      *GraphQLHandler
+	conn   *websocket.Conn
+	viewer gql.Viewer
+
+	writeMu sync.Mutex
+	opsMu   sync.Mutex
+	ops     map[string]*gqlOp
+	acked   bool
+}
+
+func (s *gqlSession) serve(ctx context.Context) {
+	s.conn.SetReadLimit(64 << 10)
+	_ = s.conn.SetReadDeadline(time.Now().Add(gqlInitTimeout))
+	s.conn.SetPongHandler(func(string) error { return s.conn.SetReadDeadline(time.Now().Add(pongWait)) })
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if !s.acked && isTimeout(err) {
+				s.close(gqlCloseInitTimeout, "connection initialisation timeout")
+			}
+			return
+		}
+		var msg gqlMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			s.close(gqlCloseBadRequest, "invalid message")
+			return
+		}
+		if s.acked {
+			_ = s.conn.SetReadDeadline(time.Now().Add(pongWait))
+		}
+
+		switch msg.Type {
+		case "connection_init":
+			if s.acked {
+				s.close(gqlCloseTooManyInits, "too many initialisation requests")
+				return
+			}
+			s.acked = true
+			_ = s.conn.SetReadDeadline(time.Now().Add(pongWait))
+			s.write(gqlMessage{Type: "connection_ack"})
+		case "ping":
+			s.write(gqlMessage{Type: "pong"})
+		case "pong":
+		case "subscribe":
+			if !s.acked {
+				s.close(gqlCloseUnauthorized, "unauthorized")
+				return
+			}
+			var req dto.GraphQLRequest
+			if msg.ID == "" || json.Unmarshal(msg.Payload, &req) != nil || req.Query == "" {
+				s.close(gqlCloseBadRequest, "invalid subscribe message")
+				return
+			}
+			if code, reason := s.start(ctx, msg.ID, req); code != 0 {
+				s.close(code, reason)
+				return
+			}
+		case "complete":
+			s.stop(msg.ID)
+		default:
+			s.close(gqlCloseBadRequest, "unknown message type "+msg.Type)
+			return
+		}
+	}
+}
+
+// start запускает операцию. Ненулевой код — операцию запускать нельзя и соединение
+// закрывается с этим кодом: id уже занят или операций слишком много.
+func (s *gqlSession) start(ctx context.Context, id string, req dto.GraphQLRequest) (int, string) {
+	s.opsMu.Lock()
+	if _, ok := s.ops[id]; ok {
+		s.opsMu.Unlock()
+		return gqlCloseSubscriberExists, "subscriber for " + id + " already exists"
+	}
+	if len(s.ops) >= gqlMaxOperations {
+		s.opsMu.Unlock()
+		return gqlCloseBadRequest, "too many operations"
+	}
+	opCtx, cancel := context.WithCancel(ctx)
+	op := &gqlOp{cancel: cancel}
+	s.ops[id] = op
+	s.opsMu.Unlock()
+
+	go func() {
+		responses, err := s.h.server.Subscribe(opCtx, s.viewer, req.Query, req.OperationName, req.Variables)
+		if err != nil {
+			s.finish(id, op)
+			s.writePayload(id, "error", []dto.GraphQLError{{Message: err.Error()}})
+			return
+		}
+		first := true
+		for resp := range responses {
+			// ошибка до выполнения (разбор, валидация, лимиты) — сообщение error без complete
+			if first && len(resp.Data) == 0 && len(resp.Errors) > 0 {
+				s.finish(id, op)
+				s.writePayload(id, "error", resp.Errors)
+				return
+			}
+			first = false
+			s.writePayload(id, "next", resp)
+		}
+		// id освобождается до complete: клиент вправе сразу переиспользовать его
+		if s.finish(id, op) {
+			s.write(gqlMessage{ID: id, Type: "complete"})
+		}
+	}()
+	return 0, ""
+}
+
+// gqlOp — идущая операция; указатель отличает её от новой операции с тем же id
+type gqlOp struct {
+	cancel context.CancelFunc
+}
+
+// stop — complete от клиента
+func (s *gqlSession) stop(id string) {
+	s.opsMu.Lock()
+	defer s.opsMu.Unlock()
+	if op, ok := s.ops[id]; ok {
+		op.cancel()
+		delete(s.ops, id)
+	}
+}
+
+// finish снимает завершившуюся операцию; false — её уже остановил клиент
+func (s *gqlSession) finish(id string, op *gqlOp) bool {
+	s.opsMu.Lock()
+	defer s.opsMu.Unlock()
+	op.cancel()
+	if s.ops[id] != op {
+		return false
+	}
+	delete(s.ops, id)
+	return true
+}
+
+func (s *gqlSession) cancelAll() {
+	s.opsMu.Lock()
+	defer s.opsMu.Unlock()
+	for id, op := range s.ops {
+		op.cancel()
+		delete(s.ops, id)
+	}
+}
+
+func (s *gqlSession) keepalive(ctx context.Context) {
+	t := time.NewTicker(heartbeatInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			s.writeMu.Lock()
+			err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+			s.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *gqlSession) writePayload(id, typ string, payload any) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		s.h.log.Error("graphql ws payload marshal failed", zap.Error(err))
+		return
+	}
+	s.write(gqlMessage{ID: id, Type: typ, Payload: raw})
+}
+
+func (s *gqlSession) write(msg gqlMessage) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	if err := s.conn.WriteJSON(msg); err != nil {
+		s.h.log.Debug("graphql ws write failed", zap.Error(err))
+	}
+}
+
+func (s *gqlSession) close(code int, reason string) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeWait))
+}
+
+func isTimeout(err error) bool {
+	var ne interface{ Timeout() bool }
+	return errors.As(err, &ne) && ne.Timeout()
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"api-gateway/internal/gql"
+	"api-gateway/internal/orderstatus"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// dialGraphQL открывает graphql-transport-ws к /graphql и проходит connection_init
+func dialGraphQL(t *testing.T) *websocket.Conn {
+	t.Helper()
+	server, err := gql.NewServer(nil, nil, nil, orderstatus.NewHub(8), gql.Limits{}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/graphql", NewGraphQLHandler(server, nil, zap.NewNop()).Subscribe)
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{gqlWSProtocol}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/graphql", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	send(t, conn, `{"type":"connection_init"}`)
+	var ack gqlMessage
+	if err := conn.ReadJSON(&ack); err != nil || ack.Type != "connection_ack" {
+		t.Fatalf("want connection_ack, got %+v err=%v", ack, err)
+	}
+	return conn
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg string) {
+	t.Helper()
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func subscribe(t *testing.T, conn *websocket.Conn, id int) {
+	t.Helper()
+	send(t, conn, fmt.Sprintf(`{"id":"%d","type":"subscribe","payload":{"query":"subscription { orderStatusChanged { id } }"}}`, id))
+}
+
+// closeCode читает соединение до закрытия и возвращает код
+func closeCode(t *testing.T, conn *websocket.Conn) int {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, data, err := conn.ReadMessage()
+		if ce, ok := err.(*websocket.CloseError); ok {
+			return ce.Code
+		}
+		if err != nil {
+			t.Fatalf("want close frame, got %v", err)
+		}
+		t.Fatalf("unexpected message %s", data)
+	}
+}
+
+func TestGraphQLSubscribe_LimitsOperationsPerConnection(t *testing.T) {
+	conn := dialGraphQL(t)
+	for id := range gqlMaxOperations {
+		subscribe(t, conn, id)
+	}
+	// complete освобождает место
+	send(t, conn, `{"id":"0","type":"complete"}`)
+	subscribe(t, conn, gqlMaxOperations)
+
+	subscribe(t, conn, gqlMaxOperations+1)
+	if code := closeCode(t, conn); code != gqlCloseBadRequest {
+		t.Fatalf("want close code %d, got %d", gqlCloseBadRequest, code)
+	}
+}
+
+func TestGraphQLSubscribe_DuplicateID(t *testing.T) {
+	conn := dialGraphQL(t)
+	subscribe(t, conn, 1)
+	subscribe(t, conn, 1)
+	if code := closeCode(t, conn); code != gqlCloseSubscriberExists {
+		t.Fatalf("want close code %d, got %d", gqlCloseSubscriberExists, code)
+	}
+}
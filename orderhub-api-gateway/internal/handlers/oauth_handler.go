@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OAuthHandler — интроспекция (RFC 7662) и отзыв (RFC 7009) токенов для сторонних
+// resource server'ов и управление их учётными данными
+type OAuthHandler struct {
+	authClient *auth.Client
+	log        *zap.Logger
+}
+
+func NewOAuthHandler(authClient *auth.Client, log *zap.Logger) *OAuthHandler {
+	return &OAuthHandler{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+// oauthForm — тело запроса application/x-www-form-urlencoded
+type oauthForm struct {
+	Token         string `form:"token"`
+	TokenTypeHint string `form:"token_type_hint"`
+	ClientID      string `form:"client_id"`
+	ClientSecret  string `form:"client_secret"`
+}
+
+// clientCredentials достаёт учётные данные клиента из HTTP Basic (client_secret_basic)
+// или из полей формы (client_secret_post). Значения в Basic закодированы как form-urlencoded
+// (RFC 6749, раздел 2.3.1).
+func clientCredentials(c *gin.Context, form oauthForm) (string, string, bool) {
+	if id, secret, ok := c.Request.BasicAuth(); ok {
+		id, errID := url.QueryUnescape(id)
+		secret, errSecret := url.QueryUnescape(secret)
+		return id, secret, errID == nil && errSecret == nil && id != ""
+	}
+	return form.ClientID, form.ClientSecret, form.ClientID != ""
+}
+
+func oauthError(c *gin.Context, httpStatus int, code, description string) {
+	if httpStatus == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(httpStatus, dto.OAuthErrorResponse{Error: code, ErrorDescription: description})
+}
+
+// bindOAuthForm разбирает форму и учётные данные клиента; при ошибке ответ уже записан
+func (h *OAuthHandler) bindOAuthForm(c *gin.Context) (form oauthForm, clientID, clientSecret string, ok bool) {
+	if err := c.ShouldBind(&form); err != nil {
+		oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+		return form, "", "", false
+	}
+	clientID, clientSecret, ok = clientCredentials(c, form)
+	if !ok {
+		oauthError(c, http.StatusUnauthorized, "invalid_client", "client authentication required")
+		return form, "", "", false
+	}
+	if form.Token == "" {
+		oauthError(c, http.StatusBadRequest, "invalid_request", "token is required")
+		return form, "", "", false
+	}
+	return form, clientID, clientSecret, true
+}
+
+func (h *OAuthHandler) writeOAuthError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			oauthError(c, http.StatusBadRequest, "invalid_request", trimStatusMessage(st.Message()))
+			return
+		case codes.Unauthenticated:
+			oauthError(c, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+			return
+		}
+		h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+	} else {
+		h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	}
+	oauthError(c, http.StatusServiceUnavailable, "temporarily_unavailable", "")
+}
+
+// Introspect godoc
+// @Summary Интроспекция токена (RFC 7662)
+// @Description Для сторонних resource server'ов. Клиент аутентифицируется через HTTP Basic или поля client_id/client_secret. Недействительный, просроченный или отозванный токен — ответ 200 с active=false.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Security BasicAuth
+// @Param token formData string true "Access или refresh токен"
+// @Param token_type_hint formData string false "access_token или refresh_token"
+// @Param client_id formData string false "client_id, если не передан в Authorization"
+// @Param client_secret formData string false "client_secret, если не передан в Authorization"
+// @Success 200 {object} dto.IntrospectTokenResponse "Сведения о токене"
+// @Failure 400 {object} dto.OAuthErrorResponse "invalid_request"
+// @Failure 401 {object} dto.OAuthErrorResponse "invalid_client"
+// @Failure 503 {object} dto.OAuthErrorResponse "temporarily_unavailable"
+// @Router /oauth/introspect [post]
+func (h *OAuthHandler) Introspect(c *gin.Context) {
+	form, clientID, clientSecret, ok := h.bindOAuthForm(c)
+	if !ok {
+		return
+	}
+	resp, err := h.authClient.IntrospectToken(c.Request.Context(), clientID, clientSecret, form.Token, form.TokenTypeHint)
+	if err != nil {
+		h.writeOAuthError(c, "IntrospectToken", err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, resp)
+}
+
+// Revoke godoc
+// @Summary Отзыв токена (RFC 7009)
+// @Description Отзывает refresh-токен (и его сессию, если других токенов в ней нет) или access-токен. На неизвестный или уже недействительный токен тоже отвечает 200.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Security BasicAuth
+// @Param token formData string true "Access или refresh токен"
+// @Param token_type_hint formData string false "access_token или refresh_token"
+// @Param client_id formData string false "client_id, если не передан в Authorization"
+// @Param client_secret formData string false "client_secret, если не передан в Authorization"
+// @Success 200 "Токен отозван или уже недействителен"
+// @Failure 400 {object} dto.OAuthErrorResponse "invalid_request"
+// @Failure 401 {object} dto.OAuthErrorResponse "invalid_client"
+// @Failure 503 {object} dto.OAuthErrorResponse "temporarily_unavailable"
+// @Router /oauth/revoke [post]
+func (h *OAuthHandler) Revoke(c *gin.Context) {
+	form, clientID, clientSecret, ok := h.bindOAuthForm(c)
+	if !ok {
+		return
+	}
+	if err := h.authClient.RevokeToken(c.Request.Context(), clientID, clientSecret, form.Token, form.TokenTypeHint); err != nil {
+		h.writeOAuthError(c, "RevokeToken", err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func (h *OAuthHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// CreateClient godoc
+// @Summary Зарегистрировать OAuth-клиент
+// @Description Выдаёт client_id и client_secret стороннему resource server'у для /oauth/introspect и /oauth/revoke. Секрет показывается только в этом ответе.
+// @Security BearerAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param client body dto.CreateOAuthClientRequest true "Название клиента"
+// @Success 201 {object} dto.CreateOAuthClientResponse "Клиент создан"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права oauth_client:manage"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/oauth-clients [post]
+func (h *OAuthHandler) CreateClient(c *gin.Context) {
+	var req dto.CreateOAuthClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.CreateOAuthClient(withBearer(c), req)
+	if err != nil {
+		h.writeError(c, "CreateOAuthClient", err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListClients godoc
+// @Summary OAuth-клиенты
+// @Description Зарегистрированные resource server'ы; секреты не возвращаются
+// @Security BearerAuth
+// @Tags admin
+// @Produce json
+// @Success 200 {object} dto.ListOAuthClientsResponse "Клиенты"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права oauth_client:manage"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/oauth-clients [get]
+func (h *OAuthHandler) ListClients(c *gin.Context) {
+	resp, err := h.authClient.ListOAuthClients(withBearer(c))
+	if err != nil {
+		h.writeError(c, "ListOAuthClients", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteClient godoc
+// @Summary Удалить OAuth-клиент
+// @Description Учётные данные клиента сразу перестают приниматься
+// @Security BearerAuth
+// @Tags admin
+// @Produce json
+// @Param client_id path string true "client_id"
+// @Success 200 {object} dto.SuccessResponse "Клиент удалён"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права oauth_client:manage"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Клиент не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/oauth-clients/{client_id} [delete]
+func (h *OAuthHandler) DeleteClient(c *gin.Context) {
+	if err := h.authClient.DeleteOAuthClient(withBearer(c), c.Param("client_id")); err != nil {
+		h.writeError(c, "DeleteOAuthClient", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("oauth client deleted"))
+}
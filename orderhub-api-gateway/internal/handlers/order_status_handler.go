@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"api-gateway/internal/dto"
+	"api-gateway/internal/middleware"
+	"api-gateway/internal/orderstatus"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// heartbeatInterval — период SSE-комментария ": ping" и WebSocket ping
+	heartbeatInterval = 15 * time.Second
+	// pongWait — сколько ждать pong (или любого кадра) от WebSocket-клиента
+	pongWait = 2*heartbeatInterval + 5*time.Second
+	// writeWait — таймаут записи кадра WebSocket
+	writeWait = 10 * time.Second
+	// sseRetry — через сколько EventSource переподключается после обрыва
+	sseRetry = 3 * time.Second
+
+	eventOrderStatus = "order_status"
+)
+
+// OrderStatusHandler стримит смены статусов заказов по SSE и WebSocket. Пользователь получает
+// события своих заказов, с правом order:read:any — любых.
+type OrderStatusHandler struct {
+	hub      *orderstatus.Hub
+	broker   orderstatus.Broker
+	upgrader websocket.Upgrader
+	log      *zap.Logger
+}
+
+func NewOrderStatusHandler(hub *orderstatus.Hub, broker orderstatus.Broker, allowedOrigins []string, log *zap.Logger) *OrderStatusHandler {
+	return &OrderStatusHandler{
+		hub:    hub,
+		broker: broker,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin: func(r *http.Request) bool {
+				return originAllowed(r.Header.Get("Origin"), allowedOrigins)
+			},
+		},
+		log: log,
+	}
+}
+
+// originAllowed повторяет правила CORS_ALLOWED_ORIGINS: "*" — любой источник, в элементе
+// списка допустима одна звёздочка ("https://*.example.com"). Клиенты без Origin — не браузеры.
+func originAllowed(origin string, allowed []string) bool {
+	if origin == "" {
+		return true
+	}
+	for _, a := range allowed {
+		if a == "*" || a == origin {
+			return true
+		}
+		if prefix, suffix, ok := strings.Cut(a, "*"); ok &&
+			len(origin) >= len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscribe проверяет параметры, открывает подписку и читает пропущенные события.
+// Подписка открывается до чтения истории, чтобы не потерять события между ними.
+func (h *OrderStatusHandler) subscribe(c *gin.Context) (*orderstatus.Subscription, []orderstatus.Event, string, bool) {
+	userID := c.GetString(middleware.CtxUserID)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError("unauthorized"))
+		return nil, nil, "", false
+	}
+	filter := orderstatus.Filter{UserID: userID}
+	if perms, _ := c.Get(middleware.CtxUserPerms); slices.Contains(asStrings(perms), authz.PermOrderReadAny) {
+		filter.UserID = ""
+	}
+	if orderID := c.Query("order_id"); orderID != "" {
+		if _, err := uuid.Parse(orderID); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid order_id", []dto.FieldError{{Field: "order_id", Message: "must be a UUID"}}))
+			return nil, nil, "", false
+		}
+		filter.OrderID = orderID
+	}
+
+	lastID := c.GetHeader("Last-Event-ID")
+	if lastID == "" {
+		lastID = c.Query("last_event_id")
+	}
+	if lastID != "" && !orderstatus.ValidID(lastID) {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid last event id", []dto.FieldError{{Field: "last_event_id", Message: "expected <ms>-<seq>"}}))
+		return nil, nil, "", false
+	}
+
+	sub := h.hub.Subscribe(filter)
+	backlog, err := orderstatus.Replay(c.Request.Context(), h.broker, filter, lastID)
+	if err != nil {
+		sub.Close()
+		h.log.Error("order status replay failed", zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, dto.NewServiceUnavailableError("order status history unavailable"))
+		return nil, nil, "", false
+	}
+	// соединение живёт долго: в гистограмму длительности запросов его не пишем
+	c.Set(middleware.CtxLongLived, true)
+	return sub, backlog, lastID, true
+}
+
+func asStrings(v any) []string {
+	list, _ := v.([]string)
+	return list
+}
+
+// StreamSSE godoc
+// @Summary Статусы заказов (SSE)
+// @Description Поток text/event-stream: событие order_status с id для возобновления, комментарий ": ping" каждые 15 с.
+// @Description Пользователь получает события своих заказов, с правом order:read:any — любых. EventSource не умеет
+// @Description заголовки, поэтому токен можно передать в параметре access_token. После обрыва EventSource сам
+// @Description переподключается с Last-Event-ID и получает пропущенное; медленного клиента сервер отключает.
+// @Description Поток закрывается, когда токен истекает или отозван: переподключитесь с новым токеном.
+// @Security BearerAuth
+// @Tags orders
+// @Produce text/event-stream
+// @Param order_id query string false "Только этот заказ"
+// @Param last_event_id query string false "Продолжить после события (вместо заголовка Last-Event-ID)"
+// @Param access_token query string false "Access-токен, если нельзя передать заголовок Authorization"
+// @Success 200 {object} orderstatus.Event "Поток событий"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные параметры"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 503 {object} dto.ServiceUnavailableErrorResponse "История событий недоступна"
+// @Router /api/v1/orders/status/stream [get]
+func (h *OrderStatusHandler) StreamSSE(c *gin.Context) {
+	sub, backlog, lastID, ok := h.subscribe(c)
+	if !ok {
+		return
+	}
+	defer sub.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // nginx не должен буферизовать поток
+	c.Status(http.StatusOK)
+	if _, err := fmt.Fprintf(c.Writer, "retry: %d\n\n", sseRetry.Milliseconds()); err != nil {
+		return
+	}
+	c.Writer.Flush()
+
+	// поток закрывается, когда токен истекает или отозван; EventSource переподключится
+	// и получит 401, если токен не обновлён
+	ctx, cancel := middleware.SessionContext(c, c.Request.Context())
+	defer cancel()
+	err := orderstatus.Stream(ctx, sub, backlog, lastID, sseSink{c}, heartbeatInterval)
+	if err != nil && !errors.Is(err, orderstatus.ErrSlowConsumer) {
+		h.log.Debug("sse stream closed", zap.Error(err))
+	}
+}
+
+type sseSink struct{ c *gin.Context }
+
+func (s sseSink) Send(ev orderstatus.Event) error {
+	if err := sse.Encode(s.c.Writer, sse.Event{Id: ev.ID, Event: eventOrderStatus, Data: ev}); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+func (s sseSink) Ping() error {
+	if _, err := s.c.Writer.WriteString(": ping\n\n"); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+// StreamWS godoc
+// @Summary Статусы заказов (WebSocket)
+// @Description Каждое сообщение — JSON события со полем id. Сервер шлёт ping каждые 15 с и закрывает соединение,
+// @Description если pong не пришёл. Медленный клиент отключается с кодом 1013: переподключитесь с last_event_id
+// @Description последнего полученного события. Токен можно передать в параметре access_token. Когда токен
+// @Description истекает или отозван, соединение закрывается с кодом 1008.
+// @Security BearerAuth
+// @Tags orders
+// @Param order_id query string false "Только этот заказ"
+// @Param last_event_id query string false "Продолжить после события"
+// @Param access_token query string false "Access-токен, если нельзя передать заголовок Authorization"
+// @Success 101 {object} orderstatus.Event "Переключение на WebSocket"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные параметры"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 503 {object} dto.ServiceUnavailableErrorResponse "История событий недоступна"
+// @Router /api/v1/orders/status/ws [get]
+func (h *OrderStatusHandler) StreamWS(c *gin.Context) {
+	sub, backlog, lastID, ok := h.subscribe(c)
+	if !ok {
+		return
+	}
+	defer sub.Close()
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade уже ответил клиенту
+		h.log.Debug("websocket upgrade failed", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	// после hijack контекст запроса не отменяется: закрытие соединения замечает читатель,
+	// истечение или отзыв токена — SessionContext
+	ctx, cancel := middleware.SessionContext(c, c.Request.Context())
+	defer cancel()
+	go func() {
+		defer cancel()
+		conn.SetReadLimit(512)
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		conn.SetPongHandler(func(string) error { return conn.SetReadDeadline(time.Now().Add(pongWait)) })
+		for {
+			// входящие сообщения клиента не нужны, чтение обрабатывает pong и close
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = orderstatus.Stream(ctx, sub, backlog, lastID, wsSink{conn}, heartbeatInterval)
+	closeCode, reason := websocket.CloseNormalClosure, ""
+	switch {
+	case err == nil && middleware.SessionExpired(ctx):
+		closeCode, reason = websocket.ClosePolicyViolation, "token expired"
+	case errors.Is(err, orderstatus.ErrSlowConsumer):
+		closeCode, reason = websocket.CloseTryAgainLater, "slow consumer, reconnect with last_event_id"
+	case err != nil:
+		h.log.Debug("websocket stream closed", zap.Error(err))
+		return
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, reason), time.Now().Add(writeWait))
+}
+
+type wsSink struct{ conn *websocket.Conn }
+
+func (s wsSink) Send(ev orderstatus.Event) error {
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return s.conn.WriteJSON(ev)
+}
+
+func (s wsSink) Ping() error {
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"api-gateway/internal/dto"
+	"api-gateway/internal/orderview"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderViewHandler — BFF-эндпоинты для страниц фронтенда
+type OrderViewHandler struct {
+	composer *orderview.Composer
+	log      *zap.Logger
+}
+
+func NewOrderViewHandler(composer *orderview.Composer, log *zap.Logger) *OrderViewHandler {
+	return &OrderViewHandler{
+		composer: composer,
+		log:      log,
+	}
+}
+
+func (h *OrderViewHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		case codes.Unavailable, codes.DeadlineExceeded:
+			h.log.Warn(op+" failed: order service unavailable", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusServiceUnavailable, dto.NewServiceUnavailableError("order service unavailable"))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// GetOrderView godoc
+// @Summary Страница заказа
+// @Description Заказ вместе с названиями и изображениями товаров, текущими остатками и возможностью повторить заказ —
+// @Description один запрос вместо N+1. Товары и остатки запрашиваются параллельно одним пакетным вызовом каждый.
+// @Description Если inventory-service недоступен, заказ возвращается с partial=true, а недостающие части перечислены в unavailable.
+// @Description Пользователь видит свои заказы, с правом order:read:any — любые.
+// @Security BearerAuth
+// @Tags orders
+// @Produce json
+// @Param id path string true "ID заказа"
+// @Success 200 {object} dto.OrderViewResponse "Заказ с данными товаров"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный ID"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Чужой заказ"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Заказ не найден"
+// @Failure 503 {object} dto.ServiceUnavailableErrorResponse "order-service недоступен"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/orders/{id}/view [get]
+func (h *OrderViewHandler) GetOrderView(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid order id", []dto.FieldError{{Field: "id", Message: "must be a UUID"}}))
+		return
+	}
+	resp, err := h.composer.View(withBearer(c), id)
+	if err != nil {
+		h.writeError(c, "GetOrderView", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
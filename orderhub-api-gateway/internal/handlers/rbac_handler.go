@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/dto"
+	"api-gateway/internal/middleware"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RBACHandler — администрирование прав ролей
+type RBACHandler struct {
+	authClient *auth.Client
+	log        *zap.Logger
+}
+
+func NewRBACHandler(authClient *auth.Client, log *zap.Logger) *RBACHandler {
+	return &RBACHandler{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+// withBearer пробрасывает access-токен или API-ключ клиента в auth-service
+// вместе с его адресом (для last_used_ip ключей)
+func withBearer(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	authz := c.GetHeader("Authorization")
+	if strings.TrimSpace(authz) == "" {
+		return ctx
+	}
+	if scheme, key, ok := apikey.ParseAuthorization(authz); ok && scheme == apikey.SchemeAPIKey {
+		return metadata.NewOutgoingContext(ctx, metadata.Pairs(
+			"authorization", apikey.SchemeAPIKey+" "+key,
+			"x-forwarded-for", c.ClientIP(),
+		))
+	}
+	if token, ok := middleware.ExtractBearerToken(authz); ok && token != "" {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+	return ctx
+}
+
+func (h *RBACHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		case codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, dto.NewConflictError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// ListPermissions godoc
+// @Summary Каталог прав
+// @Description Возвращает все известные права доступа
+// @Security BearerAuth
+// @Tags rbac
+// @Produce json
+// @Success 200 {object} dto.ListPermissionsResponse "Список прав"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права rbac:manage"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/rbac/permissions [get]
+func (h *RBACHandler) ListPermissions(c *gin.Context) {
+	resp, err := h.authClient.ListPermissions(withBearer(c))
+	if err != nil {
+		h.writeError(c, "ListPermissions", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListRolePermissions godoc
+// @Summary Права роли
+// @Description Возвращает права, выданные роли (customer, vendor, admin)
+// @Security BearerAuth
+// @Tags rbac
+// @Produce json
+// @Param role path string true "Роль"
+// @Success 200 {object} dto.RolePermissionsResponse "Права роли"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неизвестная роль"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права rbac:manage"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/rbac/roles/{role}/permissions [get]
+func (h *RBACHandler) ListRolePermissions(c *gin.Context) {
+	resp, err := h.authClient.ListRolePermissions(withBearer(c), c.Param("role"))
+	if err != nil {
+		h.writeError(c, "ListRolePermissions", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GrantRolePermission godoc
+// @Summary Выдать право роли
+// @Description Добавляет право роли. Действует на новые access-токены
+// @Security BearerAuth
+// @Tags rbac
+// @Accept json
+// @Produce json
+// @Param role path string true "Роль"
+// @Param permission body dto.RolePermissionRequest true "Право"
+// @Success 200 {object} dto.SuccessResponse "Право выдано"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права rbac:manage"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Право не найдено"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/rbac/roles/{role}/permissions [post]
+func (h *RBACHandler) GrantRolePermission(c *gin.Context) {
+	var req dto.RolePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	if err := h.authClient.GrantRolePermission(withBearer(c), c.Param("role"), req.Permission); err != nil {
+		h.writeError(c, "GrantRolePermission", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("permission granted"))
+}
+
+// RevokeRolePermission godoc
+// @Summary Отозвать право роли
+// @Description Удаляет право у роли. Действует на новые access-токены
+// @Security BearerAuth
+// @Tags rbac
+// @Produce json
+// @Param role path string true "Роль"
+// @Param permission path string true "Право"
+// @Success 200 {object} dto.SuccessResponse "Право отозвано"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права rbac:manage"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Право не выдано"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/rbac/roles/{role}/permissions/{permission} [delete]
+func (h *RBACHandler) RevokeRolePermission(c *gin.Context) {
+	if err := h.authClient.RevokeRolePermission(withBearer(c), c.Param("role"), c.Param("permission")); err != nil {
+		h.writeError(c, "RevokeRolePermission", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("permission revoked"))
+}
+
+// SetUserRole godoc
+// @Summary Сменить роль пользователя
+// @Description Назначает роль (customer, vendor, admin); выданные ранее access-токены пользователя отзываются
+// @Security BearerAuth
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID пользователя"
+// @Param role body dto.SetUserRoleRequest true "Роль"
+// @Success 200 {object} dto.SuccessResponse "Роль изменена"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права user:manage"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/users/{id}/role [put]
+func (h *RBACHandler) SetUserRole(c *gin.Context) {
+	var req dto.SetUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	if err := h.authClient.SetUserRole(withBearer(c), c.Param("id"), req.Role); err != nil {
+		h.writeError(c, "SetUserRole", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("role changed"))
+}
+
+// DisableUser godoc
+// @Summary Заблокировать пользователя
+// @Description Блокирует учётную запись и отзывает все её токены и сессии
+// @Security BearerAuth
+// @Tags users
+// @Produce json
+// @Param id path string true "ID пользователя"
+// @Success 200 {object} dto.SuccessResponse "Пользователь заблокирован"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный ID"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права user:manage"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/users/{id}/disable [post]
+func (h *RBACHandler) DisableUser(c *gin.Context) {
+	if err := h.authClient.DisableUser(withBearer(c), c.Param("id")); err != nil {
+		h.writeError(c, "DisableUser", err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewSuccessResponse("user disabled"))
+}
+
+// Impersonate godoc
+// @Summary Войти от имени пользователя
+// @Description Выдаёт короткоживущий access-токен пользователя с claim'ом act (администратор). Refresh-токен не выдаётся; вход пишется в журнал аудита. Смена пароля, удаление аккаунта и управление API-ключами с таким токеном запрещены
+// @Security BearerAuth
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "ID пользователя"
+// @Param request body dto.ImpersonateRequest true "Причина (номер обращения)"
+// @Success 200 {object} dto.ImpersonateResponse "Токен выдан"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права user:impersonate или цель — администратор"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Пользователь не найден"
+// @Failure 409 {object} dto.ConflictErrorResponse "Учётная запись заблокирована"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/users/{id}/impersonate [post]
+func (h *RBACHandler) Impersonate(c *gin.Context) {
+	var req dto.ImpersonateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.Impersonate(withBearer(c), c.Param("id"), req.Reason)
+	if err != nil {
+		h.writeError(c, "Impersonate", err)
+		return
+	}
+	h.log.Info("impersonation token issued", zap.String("user_id", resp.UserID), zap.String("actor_id", resp.ActorID))
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"api-gateway/internal/auth"
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VendorHandler — заявки на статус продавца
+type VendorHandler struct {
+	authClient *auth.Client
+	log        *zap.Logger
+}
+
+func NewVendorHandler(authClient *auth.Client, log *zap.Logger) *VendorHandler {
+	return &VendorHandler{
+		authClient: authClient,
+		log:        log,
+	}
+}
+
+func (h *VendorHandler) writeError(c *gin.Context, op string, err error) {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, dto.NewValidationError(trimStatusMessage(st.Message()), []dto.FieldError{}))
+			return
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, dto.NewUnauthorizedError(st.Message()))
+			return
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, dto.NewForbiddenError(st.Message()))
+			return
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, dto.NewNotFoundError(st.Message()))
+			return
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, dto.NewConflictError(st.Message()))
+			return
+		default:
+			h.log.Error(op+" failed", zap.String("code", st.Code().String()), zap.Error(err))
+			c.JSON(http.StatusInternalServerError, dto.NewInternalError(trimStatusMessage(st.Message())))
+			return
+		}
+	}
+	h.log.Error(op+" failed (non-status error)", zap.Error(err))
+	c.JSON(http.StatusInternalServerError, dto.NewInternalError(""))
+}
+
+// SubmitApplication godoc
+// @Summary Заявка на статус продавца
+// @Description Покупатель отправляет реквизиты компании; заявка ждёт решения администратора
+// @Security BearerAuth
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Param application body dto.SubmitVendorApplicationRequest true "Реквизиты компании"
+// @Success 201 {object} dto.VendorApplication "Заявка создана"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 409 {object} dto.ConflictErrorResponse "Заявка уже на рассмотрении или пользователь уже продавец"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/vendor/applications [post]
+func (h *VendorHandler) SubmitApplication(c *gin.Context) {
+	var req dto.SubmitVendorApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.SubmitVendorApplication(withBearer(c), req)
+	if err != nil {
+		h.writeError(c, "SubmitVendorApplication", err)
+		return
+	}
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListApplications godoc
+// @Summary Список заявок продавцов
+// @Description Заявки в порядке поступления, с фильтром по статусу
+// @Security BearerAuth
+// @Tags vendor
+// @Produce json
+// @Param status query string false "PENDING, APPROVED или REJECTED"
+// @Param limit query int false "Размер страницы (1..100)" default(20)
+// @Param offset query int false "Смещение" default(0)
+// @Success 200 {object} dto.ListVendorApplicationsResponse "Заявки"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные параметры"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права vendor:review"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/vendor-applications [get]
+func (h *VendorHandler) ListApplications(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid limit", []dto.FieldError{}))
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid offset", []dto.FieldError{}))
+		return
+	}
+
+	resp, err := h.authClient.ListVendorApplications(withBearer(c), c.Query("status"), int32(limit), int32(offset))
+	if err != nil {
+		h.writeError(c, "ListVendorApplications", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ApproveApplication godoc
+// @Summary Одобрить заявку продавца
+// @Description Пользователь получает роль ROLE_VENDOR; выданные ранее access-токены отзываются
+// @Security BearerAuth
+// @Tags vendor
+// @Produce json
+// @Param id path string true "ID заявки"
+// @Success 200 {object} dto.VendorApplication "Заявка одобрена"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверный ID"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права vendor:review"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Заявка не найдена"
+// @Failure 409 {object} dto.ConflictErrorResponse "Заявка уже рассмотрена"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/vendor-applications/{id}/approve [post]
+func (h *VendorHandler) ApproveApplication(c *gin.Context) {
+	resp, err := h.authClient.ApproveVendorApplication(withBearer(c), c.Param("id"))
+	if err != nil {
+		h.writeError(c, "ApproveVendorApplication", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// RejectApplication godoc
+// @Summary Отклонить заявку продавца
+// @Description Заявка отклоняется, пользователю уходит письмо с причиной
+// @Security BearerAuth
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Param id path string true "ID заявки"
+// @Param reason body dto.RejectVendorApplicationRequest false "Причина"
+// @Success 200 {object} dto.VendorApplication "Заявка отклонена"
+// @Failure 400 {object} dto.ValidationErrorResponse "Неверные данные"
+// @Failure 401 {object} dto.UnauthorizedErrorResponse "Не авторизован"
+// @Failure 403 {object} dto.ForbiddenErrorResponse "Нет права vendor:review"
+// @Failure 404 {object} dto.NotFoundErrorResponse "Заявка не найдена"
+// @Failure 409 {object} dto.ConflictErrorResponse "Заявка уже рассмотрена"
+// @Failure 500 {object} dto.InternalErrorResponse "Внутренняя ошибка"
+// @Router /api/v1/admin/vendor-applications/{id}/reject [post]
+func (h *VendorHandler) RejectApplication(c *gin.Context) {
+	var req dto.RejectVendorApplicationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewValidationError("invalid request body", []dto.FieldError{}))
+			return
+		}
+	}
+
+	resp, err := h.authClient.RejectVendorApplication(withBearer(c), c.Param("id"), req.Reason)
+	if err != nil {
+		h.writeError(c, "RejectVendorApplication", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// queryAccessToken — параметр, которым EventSource и WebSocket передают access-токен
+const queryAccessToken = "access_token"
+
+// ctxQueryToken — access-токен, снятый StripAccessToken с URL; забирает TokenFromQuery
+const ctxQueryToken = "query_access_token"
+
+// StripAccessToken убирает access_token из URL до логгера и otelgin: access-лог и спан
+// сохраняют адрес до c.Next(), и токен из URL оказался бы в логах и трассах. Значение
+// остаётся в контексте gin — его берёт TokenFromQuery на потоковых маршрутах, остальные
+// маршруты токен из URL не принимают. Ставится первым.
+func StripAccessToken() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// разбираем любой непустой запрос: имя может прийти закодированным (access%5Ftoken)
+		if q := c.Request.URL.Query(); q.Has(queryAccessToken) {
+			if token := q.Get(queryAccessToken); token != "" {
+				c.Set(ctxQueryToken, token)
+			}
+			q.Del(queryAccessToken)
+			c.Request.URL.RawQuery = q.Encode()
+			c.Request.RequestURI = c.Request.URL.RequestURI()
+		}
+		c.Next()
+	}
+}
+
+// AccessLog — access-лог в формате gin.Logger, который маскирует access_token в адресе:
+// вторая линия защиты, если маршрут обошёл StripAccessToken
+func AccessLog(out io.Writer) gin.HandlerFunc {
+	return gin.LoggerWithConfig(gin.LoggerConfig{
+		Output: out,
+		Formatter: func(p gin.LogFormatterParams) string {
+			if p.Latency > time.Minute {
+				p.Latency = p.Latency.Truncate(time.Second)
+			}
+			return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v\n%s",
+				p.TimeStamp.Format("2006/01/02 - 15:04:05"),
+				p.StatusCode,
+				p.Latency,
+				p.ClientIP,
+				p.Method,
+				redactAccessToken(p.Path),
+				p.ErrorMessage,
+			)
+		},
+	})
+}
+
+func redactAccessToken(path string) string {
+	base, rawQuery, ok := strings.Cut(path, "?")
+	if !ok || !strings.Contains(rawQuery, queryAccessToken) {
+		return path
+	}
+	q, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return base + "?REDACTED"
+	}
+	if q.Has(queryAccessToken) {
+		q.Set(queryAccessToken, "REDACTED")
+	}
+	return base + "?" + q.Encode()
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func accessLogRouter(out *bytes.Buffer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(StripAccessToken(), AccessLog(out))
+	echo := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetHeader("Authorization")+"|"+c.Request.URL.RawQuery)
+	}
+	r.GET("/api/v1/orders/status/stream", TokenFromQuery(), echo)
+	r.GET("/api/v1/orders", echo)
+	return r
+}
+
+func TestAccessLog_TokenNotLogged(t *testing.T) {
+	var out bytes.Buffer
+	r := accessLogRouter(&out)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/orders/status/stream?access_token=secret.jwt.value&order_id=42", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if got := w.Body.String(); got != "Bearer secret.jwt.value|order_id=42" {
+		t.Fatalf("stream route must get the token as a header and keep other params, got %q", got)
+	}
+	line := out.String()
+	if strings.Contains(line, "secret.jwt.value") {
+		t.Fatalf("token leaked into the access log: %s", line)
+	}
+	if !strings.Contains(line, "/api/v1/orders/status/stream?order_id=42") {
+		t.Errorf("access log must keep the path and other params: %s", line)
+	}
+	if req.RequestURI != "/api/v1/orders/status/stream?order_id=42" {
+		t.Errorf("RequestURI must not carry the token, got %q", req.RequestURI)
+	}
+}
+
+func TestAccessLog_QueryTokenOnlyOnStreamRoutes(t *testing.T) {
+	var out bytes.Buffer
+	r := accessLogRouter(&out)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/orders?access%5Ftoken=secret.jwt.value", nil))
+	if got := w.Body.String(); got != "|" {
+		t.Fatalf("regular route must not accept a token from the URL, got %q", got)
+	}
+	if strings.Contains(out.String(), "secret.jwt.value") {
+		t.Fatalf("token leaked into the access log: %s", out.String())
+	}
+}
+
+func TestRedactAccessToken(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"/graphql", "/graphql"},
+		{"/graphql?x=1", "/graphql?x=1"},
+		{"/graphql?access_token=abc&x=1", "/graphql?access_token=REDACTED&x=1"},
+		{"/graphql?access_token=abc;x", "/graphql?REDACTED"},
+	}
+	for _, tt := range tests {
+		if got := redactAccessToken(tt.in); got != tt.want {
+			t.Errorf("redactAccessToken(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
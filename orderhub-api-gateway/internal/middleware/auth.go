@@ -3,20 +3,30 @@ package middleware
 import (
 	"api-gateway/internal/auth"
 	"api-gateway/internal/dto"
+	"context"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/dpop"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Context keys for user info
 const (
-	CtxUserID   = "user_id"
-	CtxUserRole = "user_role"
+	CtxUserID    = "user_id"
+	CtxUserRole  = "user_role"
+	CtxUserPerms = "user_perms"
+	CtxActorID   = "actor_id" // администратор, действующий от имени пользователя
 )
 
-// AuthRequired validates Bearer token using auth service Introspect and injects user info into context.
+// AuthRequired validates Bearer token using auth service Introspect (or "ApiKey <key>" using
+// ResolveApiKey) and injects user info into context. Токен со схемой DPoP проверяется вместе с
+// доказательством из заголовка DPoP; привязанный к ключу токен со схемой Bearer не принимается.
 func AuthRequired(authClient *auth.Client, log *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authz := c.GetHeader("Authorization")
@@ -24,6 +34,35 @@ func AuthRequired(authClient *auth.Client, log *zap.Logger) gin.HandlerFunc {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.NewUnauthorizedError("missing Authorization header"))
 			return
 		}
+		if scheme, key, ok := apikey.ParseAuthorization(authz); ok && scheme == apikey.SchemeAPIKey {
+			resp, err := authClient.ResolveAPIKey(c.Request.Context(), key, c.ClientIP())
+			if authUnavailable(c, err, log) {
+				return
+			}
+			if err != nil || !resp.Active {
+				if err != nil {
+					log.Warn("api key resolution failed", zap.Error(err))
+				}
+				c.AbortWithStatusJSON(http.StatusUnauthorized, dto.NewUnauthorizedError("invalid api key"))
+				return
+			}
+			c.Set(CtxUserID, resp.UserId)
+			c.Set(CtxUserRole, resp.Role)
+			c.Set(CtxUserPerms, resp.Scopes)
+			ip := c.ClientIP()
+			setSession(c, resp.ExpUnix, func(ctx context.Context) (bool, error) {
+				resp, err := authClient.ResolveAPIKey(ctx, key, ip)
+				if err != nil {
+					return false, err
+				}
+				return resp.Active, nil
+			})
+			if !rateLimitAuthenticated(c, resp.UserId, resp.KeyId) {
+				return
+			}
+			c.Next()
+			return
+		}
 		token, ok := ExtractBearerToken(authz)
 		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.NewUnauthorizedError("invalid Authorization header"))
@@ -34,25 +73,125 @@ func AuthRequired(authClient *auth.Client, log *zap.Logger) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := authClient.Introspect(c.Request.Context(), dto.IntrospectRequest{AccessToken: token})
+		in := dto.IntrospectRequest{AccessToken: token}
+		dpopScheme := isDPoPScheme(authz)
+		if dpopScheme {
+			if in.DPoP = DPoPFromRequest(c); in.DPoP == nil {
+				abortDPoP(c, "invalid_dpop_proof", "missing DPoP header")
+				return
+			}
+		}
+
+		resp, err := authClient.Introspect(c.Request.Context(), in)
+		SetDPoPNonce(c, in.DPoP)
+		if status.Code(err) == codes.FailedPrecondition {
+			abortDPoP(c, "use_dpop_nonce", "use the nonce from the DPoP-Nonce header")
+			return
+		}
+		if authUnavailable(c, err, log) {
+			return
+		}
 		if err != nil || !resp.Active {
 			if err != nil {
 				log.Warn("introspect failed", zap.Error(err))
 			}
+			if dpopScheme {
+				abortDPoP(c, "invalid_token", "invalid token or DPoP proof")
+				return
+			}
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.NewUnauthorizedError("invalid token"))
 			return
 		}
+		if !dpopScheme && resp.Jkt != "" {
+			abortDPoP(c, "invalid_token", "token is bound to a key and must be sent with the DPoP scheme")
+			return
+		}
 
 		// put user info into Gin context
 		c.Set(CtxUserID, resp.UserId)
 		c.Set(CtxUserRole, resp.Role)
+		c.Set(CtxUserPerms, resp.Scopes)
+		// владение ключом DPoP уже доказано; перепроверка смотрит только на отзыв токена
+		setSession(c, resp.ExpUnix, func(ctx context.Context) (bool, error) {
+			resp, err := authClient.Introspect(ctx, dto.IntrospectRequest{AccessToken: token})
+			if err != nil {
+				return false, err
+			}
+			return resp.Active, nil
+		})
+		if resp.ActorId != "" {
+			c.Set(CtxActorID, resp.ActorId)
+			if c.Request.Method != http.MethodGet {
+				log.Info("impersonated request",
+					zap.String("method", c.Request.Method),
+					zap.String("path", c.FullPath()),
+					zap.String("actor_id", resp.ActorId),
+					zap.String("user_id", resp.UserId),
+				)
+			}
+		}
+		if !rateLimitAuthenticated(c, resp.UserId, "") {
+			return
+		}
+		c.Next()
+	}
+}
+
+// DenyImpersonation запрещает маршрут токенам, выданным через имперсонацию
+// (смена пароля, удаление аккаунта и т.п.). После AuthRequired использует уже
+// проверенный контекст; на публичных маршрутах проверяет Bearer-токен, если он есть.
+func DenyImpersonation(authClient *auth.Client, log *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor := c.GetString(CtxActorID)
+		if _, checked := c.Get(CtxUserID); !checked {
+			if token, ok := ExtractBearerToken(c.GetHeader("Authorization")); ok && token != "" {
+				resp, err := authClient.Introspect(c.Request.Context(), dto.IntrospectRequest{AccessToken: token})
+				if err != nil {
+					log.Warn("introspect failed", zap.Error(err))
+				} else if resp.Active {
+					actor = resp.ActorId
+				}
+			}
+		}
+		if actor != "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.NewForbiddenError("operation is not allowed while impersonating"))
+			return
+		}
 		c.Next()
 	}
 }
 
-// ExtractBearerToken извлекает токен из заголовка Authorization, устойчиво к лишним символам
+// RequirePermission пропускает запрос только при наличии у пользователя права perm.
+// Должен стоять после AuthRequired. Окончательная проверка всё равно выполняется в сервисе.
+func RequirePermission(perm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		perms, _ := c.Get(CtxUserPerms)
+		if list, ok := perms.([]string); !ok || !slices.Contains(list, perm) {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.NewForbiddenError("permission "+perm+" required"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// TokenFromQuery переносит access-токен из параметра access_token в заголовок Authorization:
+// EventSource и WebSocket в браузере не умеют задавать заголовки. Заголовок, если он есть,
+// главнее. Ставится перед AuthRequired только на потоковых маршрутах; сам параметр ещё до
+// логгера снимает с URL StripAccessToken.
+func TokenFromQuery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token := c.GetString(ctxQueryToken); token != "" && c.GetHeader("Authorization") == "" {
+			c.Request.Header.Set("Authorization", "Bearer "+token)
+		}
+		c.Next()
+	}
+}
+
+// ExtractBearerToken извлекает токен из заголовка Authorization, устойчиво к лишним символам.
+// Схема DPoP тоже принимается: доказательство проверяет AuthRequired.
 // Примеры допустимых значений:
 // - "Bearer abc.def.ghi"
+// - "DPoP abc.def.ghi"
 // - "Bearer \"abc.def.ghi\""
 // - "Bearer abc.def.ghi, extra"
 func ExtractBearerToken(authz string) (string, bool) {
@@ -60,7 +199,7 @@ func ExtractBearerToken(authz string) (string, bool) {
 		return "", false
 	}
 	parts := strings.SplitN(authz, " ", 2)
-	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+	if len(parts) != 2 || !(strings.EqualFold(parts[0], "Bearer") || strings.EqualFold(parts[0], dpop.Scheme)) {
 		return "", false
 	}
 	t := strings.TrimSpace(parts[1])
@@ -80,3 +219,16 @@ func ExtractBearerToken(authz string) (string, bool) {
 	t = strings.Trim(t, " \"'")
 	return t, true
 }
+
+// authUnavailable отвечает 503, если auth-service недоступен (в том числе при разомкнутом
+// breaker'е или истёкшем дедлайне): это не повод считать токен недействительным
+func authUnavailable(c *gin.Context, err error, log *zap.Logger) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		log.Error("auth service unavailable", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.NewServiceUnavailableError("authentication service unavailable"))
+		return true
+	default:
+		return false
+	}
+}
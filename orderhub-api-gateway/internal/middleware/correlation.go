@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/gin-gonic/gin"
+)
+
+// Correlation принимает X-Request-ID и traceparent клиента (или создаёт новые), возвращает их
+// в ответе и кладёт в контекст запроса — оттуда их забирает клиентский перехватчик gRPC.
+// Ставится после otelgin: если трассировка включена, в ответ уходит traceparent спана gateway.
+func Correlation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ids := correlation.Resolve(c.GetHeader(correlation.HeaderRequestID), c.GetHeader(correlation.HeaderTraceparent))
+		ctx := correlation.WithIDs(c.Request.Context(), ids)
+		c.Request = c.Request.WithContext(ctx)
+		ids = correlation.FromContext(ctx)
+		c.Header(correlation.HeaderRequestID, ids.RequestID)
+		c.Header(correlation.HeaderTraceparent, ids.Traceparent)
+		c.Next()
+	}
+}
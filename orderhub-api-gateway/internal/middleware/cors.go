@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// CORS разрешает источники origins. "*" пускает любой источник, но без credentials —
+// браузер не примет Access-Control-Allow-Origin: * вместе с cookie. Явный список (можно с
+// маской https://*.example.com) разрешает credentials для cookie-режима refresh-токена.
+func CORS(origins []string) gin.HandlerFunc {
+	cfg := cors.Config{
+		AllowMethods:  []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:  []string{"Authorization", "Content-Type", "DPoP", "X-Request-ID", "traceparent", HeaderCSRF},
+		ExposeHeaders: []string{"Content-Length", "X-Request-ID", "traceparent", "DPoP-Nonce", "WWW-Authenticate", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
+	}
+	if len(origins) == 0 || slices.Contains(origins, "*") {
+		cfg.AllowAllOrigins = true
+	} else {
+		cfg.AllowOrigins = origins
+		cfg.AllowWildcard = true
+		cfg.AllowCredentials = true
+	}
+	return cors.New(cfg)
+}
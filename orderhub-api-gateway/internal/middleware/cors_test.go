@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func preflight(origins []string, origin string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(CORS(origins))
+	r.POST("/api/v1/auth/refresh", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/auth/refresh", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-csrf-token")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestCORS_PreflightWithCredentials(t *testing.T) {
+	w := preflight([]string{"https://app.example.com", "https://*.orderhub.dev"}, "https://shop.orderhub.dev")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("preflight: want 204, got %d", w.Code)
+	}
+	h := w.Header()
+	if got := h.Get("Access-Control-Allow-Origin"); got != "https://shop.orderhub.dev" {
+		t.Errorf("Allow-Origin: want the request origin, got %q", got)
+	}
+	if h.Get("Access-Control-Allow-Credentials") != "true" {
+		t.Error("explicit origins must allow credentials")
+	}
+	if !strings.Contains(strings.ToLower(h.Get("Access-Control-Allow-Headers")), "x-csrf-token") {
+		t.Errorf("X-CSRF-Token must be allowed, got %q", h.Get("Access-Control-Allow-Headers"))
+	}
+}
+
+func TestCORS_PreflightFromUnknownOrigin(t *testing.T) {
+	w := preflight([]string{"https://app.example.com"}, "https://evil.example.net")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("want 403, got %d", w.Code)
+	}
+	if w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("unknown origin must not be echoed")
+	}
+}
+
+func TestCORS_WildcardWithoutCredentials(t *testing.T) {
+	w := preflight([]string{"*"}, "https://anywhere.example.net")
+	h := w.Header()
+	if h.Get("Access-Control-Allow-Origin") != "*" {
+		t.Errorf("Allow-Origin: want *, got %q", h.Get("Access-Control-Allow-Origin"))
+	}
+	if h.Get("Access-Control-Allow-Credentials") != "" {
+		t.Error("wildcard origins must not allow credentials")
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"api-gateway/config"
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HeaderCSRF — заголовок, в котором SPA повторяет значение CSRF-cookie
+const HeaderCSRF = "X-CSRF-Token"
+
+// CSRF — защита double submit для cookie-режима refresh-токена: если запрос несёт
+// refresh-cookie, заголовок X-CSRF-Token должен совпадать с CSRF-cookie. Чужой сайт не может
+// прочитать cookie и повторить значение. Запросы без refresh-cookie (токен в теле) не
+// проверяются — браузер ничего не подставит за клиента.
+func CSRF(cookies config.Cookies) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, err := c.Cookie(cookies.RefreshName); err != nil {
+			c.Next()
+			return
+		}
+		cookie, _ := c.Cookie(cookies.CSRFName)
+		header := c.GetHeader(HeaderCSRF)
+		if cookie == "" || header == "" || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.NewCSRFError("missing or invalid CSRF token"))
+			return
+		}
+		c.Next()
+	}
+}
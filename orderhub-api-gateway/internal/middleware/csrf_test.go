@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"api-gateway/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+func testCookies() config.Cookies {
+	return config.Cookies{RefreshName: "orderhub_refresh", CSRFName: "orderhub_csrf", Secure: true, SameSite: http.SameSiteStrictMode}
+}
+
+func csrfRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/api/v1/auth/refresh", CSRF(testCookies()), func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	return r
+}
+
+func TestCSRF(t *testing.T) {
+	tests := []struct {
+		name    string
+		refresh string
+		cookie  string
+		header  string
+		want    int
+	}{
+		{name: "token in body, no cookies", want: http.StatusNoContent},
+		{name: "missing header", refresh: "rt", cookie: "abc", want: http.StatusForbidden},
+		{name: "missing csrf cookie", refresh: "rt", header: "abc", want: http.StatusForbidden},
+		{name: "mismatched header", refresh: "rt", cookie: "abc", header: "abd", want: http.StatusForbidden},
+		{name: "double submit", refresh: "rt", cookie: "abc", header: "abc", want: http.StatusNoContent},
+	}
+	r := csrfRouter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", nil)
+			if tt.refresh != "" {
+				req.AddCookie(&http.Cookie{Name: "orderhub_refresh", Value: tt.refresh})
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "orderhub_csrf", Value: tt.cookie})
+			}
+			if tt.header != "" {
+				req.Header.Set(HeaderCSRF, tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Fatalf("want %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
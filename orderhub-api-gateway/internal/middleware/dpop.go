@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"api-gateway/internal/dto"
+	"net/http"
+	"strings"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/dpop"
+	"github.com/gin-gonic/gin"
+)
+
+// DPoPFromRequest собирает доказательство из заголовка DPoP для текущего запроса;
+// nil, если заголовка нет
+func DPoPFromRequest(c *gin.Context) *dto.DPoPProof {
+	proof := strings.TrimSpace(c.GetHeader(dpop.HeaderName))
+	if proof == "" {
+		return nil
+	}
+	return &dto.DPoPProof{Proof: proof, Method: c.Request.Method, URL: externalURL(c)}
+}
+
+// SetDPoPNonce отдаёт клиенту nonce для следующего доказательства
+func SetDPoPNonce(c *gin.Context, p *dto.DPoPProof) {
+	if p != nil && p.Nonce != "" {
+		c.Header(dpop.NonceHeaderName, p.Nonce)
+	}
+}
+
+// externalURL восстанавливает URL, который видел клиент (htu): за балансировщиком
+// схема и хост приходят в X-Forwarded-Proto / X-Forwarded-Host
+func externalURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if p := firstForwarded(c.GetHeader("X-Forwarded-Proto")); p != "" {
+		scheme = p
+	}
+	host := c.Request.Host
+	if h := firstForwarded(c.GetHeader("X-Forwarded-Host")); h != "" {
+		host = h
+	}
+	return scheme + "://" + host + c.Request.URL.Path
+}
+
+func firstForwarded(v string) string {
+	if i := strings.IndexByte(v, ','); i >= 0 {
+		v = v[:i]
+	}
+	return strings.TrimSpace(v)
+}
+
+// isDPoPScheme — токен предъявлен как "Authorization: DPoP <token>"
+func isDPoPScheme(authz string) bool {
+	scheme, _, ok := strings.Cut(strings.TrimSpace(authz), " ")
+	return ok && strings.EqualFold(scheme, dpop.Scheme)
+}
+
+// abortDPoP отвечает 401 с вызовом WWW-Authenticate: DPoP (RFC 9449, 7.1)
+func abortDPoP(c *gin.Context, code, msg string) {
+	c.Header("WWW-Authenticate", dpop.Scheme+` algs="ES256 RS256", error="`+code+`"`)
+	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.NewDPoPError(code, msg))
+}
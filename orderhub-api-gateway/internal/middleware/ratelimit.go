@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"api-gateway/internal/dto"
+	"api-gateway/internal/ratelimit"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/gin-gonic/gin"
+)
+
+// ctxRateLimitDeferred — проверки лимита, отложенные до аутентификации (ключ — пользователь
+// или API-ключ)
+const ctxRateLimitDeferred = "ratelimit_deferred"
+
+// RateLimit ограничивает частоту запросов по политике маршрута из файла политик и отдаёт
+// заголовки RateLimit-Policy / RateLimit-Limit / RateLimit-Remaining / RateLimit-Reset.
+// Политики с ключом user и api_key проверяет AuthRequired, когда пользователь и ID ключа
+// уже известны; запросы без Authorization (для api_key — без API-ключа) считаются по IP.
+// Запрос с API-ключом дополнительно проходит политику api_key из файла на любом маршруте.
+func RateLimit(limiter *ratelimit.Limiter, policies *ratelimit.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authz := c.GetHeader("Authorization")
+		scheme, _, ok := apikey.ParseAuthorization(authz)
+		withKey := ok && scheme == apikey.SchemeAPIKey
+
+		var deferred []*ratelimit.Policy
+		if p := policies.APIKeyPolicy(); p != nil && withKey {
+			deferred = append(deferred, p)
+		}
+		if p := policies.PolicyFor(c.Request.Method, c.FullPath()); p != nil {
+			switch {
+			case p.Key == ratelimit.KeyByUser && authz != "", p.Key == ratelimit.KeyByAPIKey && withKey:
+				deferred = append(deferred, p)
+			default:
+				if !enforceRateLimit(c, limiter, p, "ip:"+c.ClientIP()) {
+					return
+				}
+			}
+		}
+		if len(deferred) > 0 {
+			c.Set(ctxRateLimitDeferred, func(userID, keyID string) bool {
+				for _, p := range deferred {
+					key := "user:" + userID
+					if p.Key == ratelimit.KeyByAPIKey && keyID != "" {
+						key = "key:" + keyID
+					}
+					if !enforceRateLimit(c, limiter, p, key) {
+						return false
+					}
+				}
+				return true
+			})
+		}
+		c.Next()
+	}
+}
+
+// rateLimitAuthenticated выполняет отложенные проверки после успешной аутентификации;
+// keyID — ID API-ключа, пустой для токена. false — запрос уже отклонён с 429
+func rateLimitAuthenticated(c *gin.Context, userID, keyID string) bool {
+	if v, ok := c.Get(ctxRateLimitDeferred); ok {
+		return v.(func(string, string) bool)(userID, keyID)
+	}
+	return true
+}
+
+func enforceRateLimit(c *gin.Context, limiter *ratelimit.Limiter, p *ratelimit.Policy, key string) bool {
+	res := limiter.Allow(c.Request.Context(), key, p)
+	c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d;burst=%d", p.Rate, int(p.Period.Seconds()), p.Burst))
+	c.Header("RateLimit-Limit", strconv.Itoa(p.Burst))
+	c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+	if !res.Allowed {
+		c.Header("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, dto.NewRateLimitedError("rate limit exceeded"))
+		return false
+	}
+	return true
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
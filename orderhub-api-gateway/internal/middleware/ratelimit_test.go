@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"api-gateway/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// rateLimitRouter — RateLimit и заглушка AuthRequired: ключ "ApiKey <id>" считается
+// ключом с ID <id> пользователя u1, остальные запросы — токеном пользователя u1
+func rateLimitRouter(policies *ratelimit.Config) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(RateLimit(ratelimit.NewLimiter(nil, zap.NewNop()), policies))
+	auth := func(c *gin.Context) {
+		keyID := ""
+		if id, ok := strings.CutPrefix(c.GetHeader("Authorization"), "ApiKey "); ok {
+			keyID = id
+		}
+		if !rateLimitAuthenticated(c, "u1", keyID) {
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+	r.GET("/orders", auth)
+	return r
+}
+
+func testPolicies() *ratelimit.Config {
+	return &ratelimit.Config{
+		APIKey: "keys",
+		Policies: map[string]*ratelimit.Policy{
+			"keys":  {Name: "keys", Key: ratelimit.KeyByAPIKey, Rate: 1, Period: time.Hour, Burst: 2},
+			"users": {Name: "users", Key: ratelimit.KeyByUser, Rate: 1, Period: time.Hour, Burst: 5},
+		},
+		Routes: map[string]string{"GET /orders": "users"},
+	}
+}
+
+func doGet(r *gin.Engine, path, authz string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if authz != "" {
+		req.Header.Set("Authorization", authz)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit_APIKeyPolicyByKeyID(t *testing.T) {
+	policies := testPolicies()
+	policies.Policies["users"].Burst = 100
+	r := rateLimitRouter(policies)
+
+	for i := range 2 {
+		if w := doGet(r, "/orders", "ApiKey key-1"); w.Code != http.StatusNoContent {
+			t.Fatalf("request %d within the key burst: got %d", i+1, w.Code)
+		}
+	}
+	w := doGet(r, "/orders", "ApiKey key-1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("third request with the same key must be limited, got %d", w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("limited response must carry Retry-After")
+	}
+
+	// другой ключ того же пользователя — свой бакет
+	if w := doGet(r, "/orders", "ApiKey key-2"); w.Code != http.StatusNoContent {
+		t.Fatalf("another key must not share the bucket, got %d", w.Code)
+	}
+	// токен того же пользователя политику api_key не проходит
+	for i := range 3 {
+		if w := doGet(r, "/orders", "Bearer token"); w.Code != http.StatusNoContent {
+			t.Fatalf("bearer request %d must not be limited by the api_key policy, got %d", i+1, w.Code)
+		}
+	}
+}
+
+func TestRateLimit_RoutePolicyStillApplies(t *testing.T) {
+	policies := testPolicies()
+	policies.Policies["keys"].Burst = 100
+	r := rateLimitRouter(policies)
+
+	// политика маршрута users (5 подряд) считает запросы пользователя по всем ключам
+	for i := range 5 {
+		key := "ApiKey key-" + string(rune('a'+i))
+		if w := doGet(r, "/orders", key); w.Code != http.StatusNoContent {
+			t.Fatalf("request %d: got %d", i+1, w.Code)
+		}
+	}
+	if w := doGet(r, "/orders", "ApiKey key-z"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("route policy must limit the user across keys, got %d", w.Code)
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrSessionExpired — причина отмены контекста долгого соединения: токен истёк или отозван
+var ErrSessionExpired = errors.New("session expired")
+
+const (
+	ctxAuthExpiry  = "auth_expiry"  // time.Time: когда истекает токен или API-ключ
+	ctxAuthRecheck = "auth_recheck" // recheckFunc: повторная проверка учётных данных запроса
+)
+
+// sessionRecheckInterval — как часто долгое соединение перепроверяет учётные данные: отзыв
+// (logout, сдвиг водяного знака, удаление ключа) иначе заметен только по истечении токена
+var sessionRecheckInterval = time.Minute
+
+// recheckFunc отвечает, действительны ли ещё учётные данные; ошибка — ответа нет
+type recheckFunc func(ctx context.Context) (bool, error)
+
+// SessionContext — контекст долгого соединения (SSE, WebSocket) поверх parent. Отменяется с
+// причиной ErrSessionExpired в момент истечения токена или API-ключа, а также когда
+// перепроверка показывает, что они отозваны; недоступность auth-service соединение не рвёт.
+// Ставится после AuthRequired; cancel освобождает таймеры.
+func SessionContext(c *gin.Context, parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(parent)
+	var expiry time.Time
+	if v, ok := c.Get(ctxAuthExpiry); ok {
+		expiry, _ = v.(time.Time)
+	}
+	var recheck recheckFunc
+	if v, ok := c.Get(ctxAuthRecheck); ok {
+		recheck, _ = v.(recheckFunc)
+	}
+
+	go func() {
+		var expired <-chan time.Time
+		if !expiry.IsZero() {
+			t := time.NewTimer(time.Until(expiry))
+			defer t.Stop()
+			expired = t.C
+		}
+		ticker := time.NewTicker(sessionRecheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expired:
+				cancel(ErrSessionExpired)
+				return
+			case <-ticker.C:
+				if recheck == nil {
+					continue
+				}
+				if active, err := recheck(ctx); err == nil && !active {
+					cancel(ErrSessionExpired)
+					return
+				}
+			}
+		}
+	}()
+	return ctx, func() { cancel(context.Canceled) }
+}
+
+// SessionExpired сообщает, что ctx из SessionContext отменён из-за истечения или отзыва токена
+func SessionExpired(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrSessionExpired)
+}
+
+// setSession запоминает срок действия и способ перепроверки учётных данных для SessionContext
+func setSession(c *gin.Context, expUnix int64, recheck recheckFunc) {
+	if expUnix > 0 {
+		c.Set(ctxAuthExpiry, time.Unix(expUnix, 0))
+	}
+	c.Set(ctxAuthRecheck, recheck)
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func sessionTestContext(expUnix int64, recheck recheckFunc) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/api/v1/orders/status/stream", nil)
+	setSession(c, expUnix, recheck)
+	return c
+}
+
+func waitDone(t *testing.T, ctx context.Context, within time.Duration) {
+	t.Helper()
+	select {
+	case <-ctx.Done():
+	case <-time.After(within):
+		t.Fatal("session context must be cancelled")
+	}
+}
+
+func TestSessionContext_ClosesAtTokenExpiry(t *testing.T) {
+	// exp в секундах: берём ближайшую секунду
+	exp := time.Now().Add(time.Second).Truncate(time.Second)
+	c := sessionTestContext(exp.Unix(), nil)
+
+	ctx, cancel := SessionContext(c, context.Background())
+	defer cancel()
+	waitDone(t, ctx, 2*time.Second)
+	if !SessionExpired(ctx) {
+		t.Fatalf("want ErrSessionExpired cause, got %v", context.Cause(ctx))
+	}
+	if time.Now().Before(exp) {
+		t.Fatal("session must not close before exp")
+	}
+}
+
+func TestSessionContext_ClosesWhenRevoked(t *testing.T) {
+	old := sessionRecheckInterval
+	sessionRecheckInterval = 5 * time.Millisecond
+	t.Cleanup(func() { sessionRecheckInterval = old })
+
+	var calls atomic.Int32
+	c := sessionTestContext(time.Now().Add(time.Hour).Unix(), func(context.Context) (bool, error) {
+		// первая проверка — auth-service недоступен, вторая — токен отозван
+		if calls.Add(1) == 1 {
+			return false, errors.New("unavailable")
+		}
+		return false, nil
+	})
+
+	ctx, cancel := SessionContext(c, context.Background())
+	defer cancel()
+	waitDone(t, ctx, time.Second)
+	if !SessionExpired(ctx) || calls.Load() < 2 {
+		t.Fatalf("revoked token must close the session after an outage, cause=%v calls=%d", context.Cause(ctx), calls.Load())
+	}
+}
+
+func TestSessionContext_StaysOpenWhileActive(t *testing.T) {
+	old := sessionRecheckInterval
+	sessionRecheckInterval = 5 * time.Millisecond
+	t.Cleanup(func() { sessionRecheckInterval = old })
+
+	var calls atomic.Int32
+	c := sessionTestContext(0, func(context.Context) (bool, error) {
+		calls.Add(1)
+		return true, nil
+	})
+	ctx, cancel := SessionContext(c, context.Background())
+	time.Sleep(30 * time.Millisecond)
+	if ctx.Err() != nil || calls.Load() == 0 {
+		t.Fatalf("active session must stay open and be rechecked, err=%v calls=%d", ctx.Err(), calls.Load())
+	}
+
+	cancel()
+	if SessionExpired(ctx) {
+		t.Fatal("cancel by the handler is not an expiry")
+	}
+}
@@ -0,0 +1,109 @@
+package orderstatus
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultHistory — сколько последних событий брокер хранит для возобновления потока
+const DefaultHistory = 10000
+
+// Broker раздаёт события всем репликам gateway и хранит короткую историю для Last-Event-ID.
+// Событие из Kafka читает одна реплика (общая consumer group) и публикует его в брокер,
+// а Subscribe доставляет его в Hub каждой реплики.
+type Broker interface {
+	// Publish присваивает событию ID, сохраняет его в истории и рассылает подписчикам
+	Publish(ctx context.Context, ev Event) (Event, error)
+	// Since возвращает до limit событий истории с ID больше lastID в порядке публикации
+	Since(ctx context.Context, lastID string, limit int) ([]Event, error)
+	// Subscribe вызывает fn для каждого опубликованного события, пока не отменён ctx
+	Subscribe(ctx context.Context, fn func(Event)) error
+}
+
+// MemoryBroker — брокер в памяти процесса. Годится только для одной реплики gateway:
+// события, прочитанные из Kafka другой репликой, сюда не попадут.
+type MemoryBroker struct {
+	mu      sync.Mutex
+	history []Event // кольцевой буфер
+	next    int
+	full    bool
+	lastMS  uint64
+	seq     uint64
+	subs    map[int]func(Event)
+	subSeq  int
+	now     func() time.Time
+}
+
+func NewMemoryBroker(history int) *MemoryBroker {
+	if history <= 0 {
+		history = DefaultHistory
+	}
+	return &MemoryBroker{history: make([]Event, history), subs: map[int]func(Event){}, now: time.Now}
+}
+
+func (b *MemoryBroker) Publish(_ context.Context, ev Event) (Event, error) {
+	b.mu.Lock()
+	ev.ID = b.nextID()
+	b.history[b.next] = ev
+	b.next = (b.next + 1) % len(b.history)
+	if b.next == 0 {
+		b.full = true
+	}
+	subs := make([]func(Event), 0, len(b.subs))
+	for _, fn := range b.subs {
+		subs = append(subs, fn)
+	}
+	b.mu.Unlock()
+
+	for _, fn := range subs {
+		fn(ev)
+	}
+	return ev, nil
+}
+
+// nextID выдаёт монотонные ID в формате Redis Streams, чтобы клиенту было всё равно, какой брокер включён
+func (b *MemoryBroker) nextID() string {
+	ms := uint64(b.now().UnixMilli())
+	if ms > b.lastMS {
+		b.lastMS, b.seq = ms, 0
+	} else {
+		b.seq++
+	}
+	return strconv.FormatUint(b.lastMS, 10) + "-" + strconv.FormatUint(b.seq, 10)
+}
+
+func (b *MemoryBroker) Since(_ context.Context, lastID string, limit int) ([]Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ordered := b.history[:b.next]
+	if b.full {
+		ordered = append(append([]Event(nil), b.history[b.next:]...), b.history[:b.next]...)
+	}
+	var out []Event
+	for _, ev := range ordered {
+		if compareID(ev.ID, lastID) > 0 {
+			out = append(out, ev)
+			if limit > 0 && len(out) == limit {
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, fn func(Event)) error {
+	b.mu.Lock()
+	id := b.subSeq
+	b.subSeq++
+	b.subs[id] = fn
+	b.mu.Unlock()
+
+	<-ctx.Done()
+
+	b.mu.Lock()
+	delete(b.subs, id)
+	b.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,123 @@
+package orderstatus
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testBroker — MemoryBroker с управляемыми часами
+func testBroker(history int, now *time.Time) *MemoryBroker {
+	b := NewMemoryBroker(history)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func publishN(t *testing.T, b *MemoryBroker, n int) []Event {
+	t.Helper()
+	out := make([]Event, 0, n)
+	for i := range n {
+		ev, err := b.Publish(context.Background(), Event{OrderID: "o" + strconv.Itoa(i), UserID: "u1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, ev)
+	}
+	return out
+}
+
+func TestMemoryBroker_NextID(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	b := testBroker(10, &now)
+
+	want := []string{"1700000000000-0", "1700000000000-1", "1700000000000-2"}
+	for i, ev := range publishN(t, b, 3) {
+		if ev.ID != want[i] {
+			t.Fatalf("event %d: want ID %s, got %s", i, want[i], ev.ID)
+		}
+	}
+
+	now = now.Add(time.Millisecond)
+	if ev, _ := b.Publish(context.Background(), Event{}); ev.ID != "1700000000001-0" {
+		t.Fatalf("new millisecond must reset the sequence, got %s", ev.ID)
+	}
+
+	// часы ушли назад: ID всё равно растут
+	now = now.Add(-time.Second)
+	ev, _ := b.Publish(context.Background(), Event{})
+	if ev.ID != "1700000000001-1" {
+		t.Fatalf("clock going backwards must keep IDs monotonic, got %s", ev.ID)
+	}
+}
+
+func TestMemoryBroker_RingBuffer(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	b := testBroker(3, &now)
+
+	events := publishN(t, b, 2)
+	got, _ := b.Since(context.Background(), "0-0", 0)
+	if len(got) != 2 || got[0].ID != events[0].ID {
+		t.Fatalf("before wrap-around: want 2 events in order, got %+v", got)
+	}
+
+	events = append(events, publishN(t, b, 3)...)
+	got, _ = b.Since(context.Background(), "0-0", 0)
+	if len(got) != 3 {
+		t.Fatalf("full buffer must keep the last 3 events, got %d", len(got))
+	}
+	for i, ev := range got {
+		if ev.ID != events[2+i].ID {
+			t.Fatalf("after wrap-around: want oldest-first %v, got %+v", events[2:], got)
+		}
+	}
+
+	got, _ = b.Since(context.Background(), events[3].ID, 0)
+	if len(got) != 1 || got[0].ID != events[4].ID {
+		t.Fatalf("Since must return events after lastID, got %+v", got)
+	}
+	got, _ = b.Since(context.Background(), "0-0", 2)
+	if len(got) != 2 || got[1].ID != events[3].ID {
+		t.Fatalf("Since must respect limit, got %+v", got)
+	}
+}
+
+func TestMemoryBroker_Subscribe(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	b := testBroker(3, &now)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	got := make(chan Event, 1)
+	done := make(chan struct{})
+	go func() {
+		_ = b.Subscribe(ctx, func(ev Event) { got <- ev })
+		close(done)
+	}()
+	waitFor(t, func() bool {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		return len(b.subs) == 1
+	})
+
+	ev, _ := b.Publish(context.Background(), Event{OrderID: "o1"})
+	if recv := <-got; recv.ID != ev.ID {
+		t.Fatalf("subscriber must receive the published event with its ID, got %+v", recv)
+	}
+
+	cancel()
+	<-done
+	if len(b.subs) != 0 {
+		t.Fatal("cancelled subscription must be removed")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,104 @@
+package orderstatus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+var errInvalidEvent = errors.New("invalid order status event")
+
+// Consumer читает смены статусов из Kafka и публикует их в брокер. Все реплики gateway
+// состоят в одной consumer group, так что каждое событие публикует ровно одна из них.
+type Consumer struct {
+	reader *kafka.Reader
+	broker Broker
+	log    *zap.Logger
+}
+
+func NewConsumer(brokers []string, groupID, topic string, broker Broker, log *zap.Logger) *Consumer {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           brokers,
+		GroupID:           groupID,
+		Topic:             topic,
+		MaxBytes:          10e6,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+	})
+	return &Consumer{reader: r, broker: broker, log: log}
+}
+
+// Run коммитит сообщение только после публикации в брокер: при недоступном Redis
+// событие повторяется, а не теряется
+func (c *Consumer) Run(ctx context.Context) error {
+	c.log.Info("order status consumer started", zap.String("topic", c.reader.Config().Topic))
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			c.log.Error("fetch order status message", zap.Error(err))
+			continue
+		}
+		for {
+			msgCtx, span := kafkatrace.StartConsume(ctx, m)
+			msgCtx = correlation.WithIDs(msgCtx, idsFromHeaders(m.Headers))
+			err := c.handle(msgCtx, m)
+			kafkatrace.End(span, err)
+			if err == nil || errors.Is(err, errInvalidEvent) {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Second):
+			}
+		}
+		if err := c.reader.CommitMessages(ctx, m); err != nil && !errors.Is(err, context.Canceled) {
+			c.log.Error("commit order status message", zap.Error(err))
+		}
+	}
+}
+
+func (c *Consumer) handle(ctx context.Context, m kafka.Message) error {
+	log := correlation.Logger(ctx, c.log)
+	var ev Event
+	if err := json.Unmarshal(m.Value, &ev); err != nil || ev.OrderID == "" || ev.UserID == "" || ev.Status == "" {
+		log.Warn("invalid order status event", zap.ByteString("value", m.Value), zap.Error(err))
+		eventsConsumed.WithLabelValues("invalid").Inc()
+		return errInvalidEvent
+	}
+	if _, err := c.broker.Publish(ctx, ev); err != nil {
+		log.Error("publish order status event", zap.String("order_id", ev.OrderID), zap.Error(err))
+		eventsConsumed.WithLabelValues("failed").Inc()
+		return err
+	}
+	eventsConsumed.WithLabelValues("published").Inc()
+	return nil
+}
+
+// Lag — отставание consumer'а от конца партиции
+func (c *Consumer) Lag() int64 { return c.reader.Stats().Lag }
+
+func (c *Consumer) Close() error { return c.reader.Close() }
+
+// idsFromHeaders достаёт x-request-id и traceparent, которые order-service кладёт в заголовки сообщения
+func idsFromHeaders(headers []kafka.Header) correlation.IDs {
+	var ids correlation.IDs
+	for _, h := range headers {
+		switch h.Key {
+		case correlation.KeyRequestID:
+			ids.RequestID = string(h.Value)
+		case correlation.KeyTraceparent:
+			ids.Traceparent = string(h.Value)
+		}
+	}
+	return ids
+}
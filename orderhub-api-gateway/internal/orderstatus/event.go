@@ -0,0 +1,64 @@
+package orderstatus
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event — смена статуса заказа, как её публикует order-service в топик статусов.
+// ID присваивает брокер: это идентификатор записи в истории, по которому клиент
+// возобновляет поток (Last-Event-ID).
+type Event struct {
+	ID             string    `json:"id"`
+	OrderID        string    `json:"order_id"`
+	UserID         string    `json:"user_id"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+// Filter — какие события получает подписчик. Пустой UserID — события всех пользователей
+// (администратор с правом order:read:any), пустой OrderID — все заказы.
+type Filter struct {
+	UserID  string
+	OrderID string
+}
+
+func (f Filter) Match(ev Event) bool {
+	return (f.UserID == "" || f.UserID == ev.UserID) && (f.OrderID == "" || f.OrderID == ev.OrderID)
+}
+
+// ValidID проверяет формат идентификатора события: "<мс>-<номер>", как у Redis Streams
+func ValidID(id string) bool {
+	_, _, ok := parseID(id)
+	return ok
+}
+
+// compareID сравнивает идентификаторы событий; некорректный считается равным "0-0"
+func compareID(a, b string) int {
+	am, as, _ := parseID(a)
+	bm, bs, _ := parseID(b)
+	if c := cmp.Compare(am, bm); c != 0 {
+		return c
+	}
+	return cmp.Compare(as, bs)
+}
+
+func parseID(id string) (ms, seq uint64, ok bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
@@ -0,0 +1,69 @@
+package orderstatus
+
+import "testing"
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"1700000000000-0", true},
+		{"0-0", true},
+		{"1700000000000-18446744073709551615", true},
+		{"", false},
+		{"1700000000000", false},
+		{"-1", false},
+		{"abc-1", false},
+		{"1-abc", false},
+		{"1-2-3", false},
+		{"-1-1", false},
+	}
+	for _, tt := range tests {
+		if got := ValidID(tt.id); got != tt.want {
+			t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestCompareID(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"5-0", "5-0", 0},
+		{"5-1", "5-0", 1},
+		{"5-0", "5-1", -1},
+		{"6-0", "5-9", 1},
+		// числа, а не строки: "10" больше "9"
+		{"10-0", "9-0", 1},
+		{"5-10", "5-9", 1},
+		// некорректный ID равен "0-0"
+		{"garbage", "0-0", 0},
+		{"1-0", "garbage", 1},
+	}
+	for _, tt := range tests {
+		if got := compareID(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareID(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	ev := Event{UserID: "u1", OrderID: "o1"}
+	tests := []struct {
+		f    Filter
+		want bool
+	}{
+		{Filter{}, true},
+		{Filter{UserID: "u1"}, true},
+		{Filter{UserID: "u2"}, false},
+		{Filter{UserID: "u1", OrderID: "o1"}, true},
+		{Filter{UserID: "u1", OrderID: "o2"}, false},
+		{Filter{OrderID: "o1"}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.f.Match(ev); got != tt.want {
+			t.Errorf("%+v.Match = %v, want %v", tt.f, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package orderstatus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultBuffer — сколько неотправленных событий может накопиться у одного подписчика
+const DefaultBuffer = 64
+
+// Hub раздаёт события брокера подписчикам этой реплики. Каждому подписчику — свой
+// буферизованный канал; медленный клиент, переполнивший буфер, отключается, а не
+// тормозит остальных: он переподключится с Last-Event-ID и дочитает пропущенное из истории.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	buffer int
+}
+
+func NewHub(buffer int) *Hub {
+	if buffer <= 0 {
+		buffer = DefaultBuffer
+	}
+	return &Hub{subs: map[*Subscription]struct{}{}, buffer: buffer}
+}
+
+// Subscription — подписка одного соединения
+type Subscription struct {
+	hub      *Hub
+	filter   Filter
+	ch       chan Event
+	overflow bool
+}
+
+func (h *Hub) Subscribe(f Filter) *Subscription {
+	s := &Subscription{hub: h, filter: f, ch: make(chan Event, h.buffer)}
+	h.mu.Lock()
+	h.subs[s] = struct{}{}
+	subscribersGauge.Set(float64(len(h.subs)))
+	h.mu.Unlock()
+	return s
+}
+
+// Events закрывается, когда подписку отменили или отключили за переполнение
+func (s *Subscription) Events() <-chan Event { return s.ch }
+
+// Overflowed сообщает, что подписка отключена из-за переполнения буфера.
+// Читать после закрытия Events.
+func (s *Subscription) Overflowed() bool {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	return s.overflow
+}
+
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	s.hub.remove(s)
+	s.hub.mu.Unlock()
+}
+
+// remove вызывается под h.mu; канал закрывается ровно один раз — при удалении из карты
+func (h *Hub) remove(s *Subscription) {
+	if _, ok := h.subs[s]; !ok {
+		return
+	}
+	delete(h.subs, s)
+	close(s.ch)
+	subscribersGauge.Set(float64(len(h.subs)))
+}
+
+// Broadcast не блокируется на медленных подписчиках
+func (h *Hub) Broadcast(ev Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs {
+		if !s.filter.Match(ev) {
+			continue
+		}
+		select {
+		case s.ch <- ev:
+		default:
+			s.overflow = true
+			h.remove(s)
+			slowConsumers.Inc()
+		}
+	}
+}
+
+// Run доставляет события брокера в Hub до отмены ctx; при обрыве подписки переподключается
+func (h *Hub) Run(ctx context.Context, broker Broker, log *zap.Logger) {
+	const retry = 2 * time.Second
+	for {
+		err := broker.Subscribe(ctx, h.Broadcast)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warn("order status broker subscription failed", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package orderstatus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscribersGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "order_status_subscribers",
+		Help: "Clients currently subscribed to order status updates on this replica.",
+	})
+	slowConsumers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "order_status_slow_consumers_total",
+		Help: "Subscribers disconnected because their send buffer overflowed.",
+	})
+	// result: published | invalid | failed
+	eventsConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "order_status_events_total",
+		Help: "Order status events read from Kafka by outcome.",
+	}, []string{"result"})
+)
+
+// RegisterConsumerLag публикует отставание consumer'а топика статусов; lag вызывается при каждом scrape
+func RegisterConsumerLag(topic string, lag func() int64) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "kafka_consumer_lag",
+		Help:        "Messages between the consumer position and the end of the partition.",
+		ConstLabels: prometheus.Labels{"topic": topic},
+	}, func() float64 { return float64(lag()) }))
+}
@@ -0,0 +1,112 @@
+package orderstatus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisStreamKey = "orderhub:order-status"
+	redisChannel   = "orderhub:order-status"
+)
+
+var errBadPayload = errors.New("orderstatus: malformed pub/sub payload")
+
+// publishScript атомарно добавляет событие в stream (история для Last-Event-ID, ID выдаёт Redis)
+// и рассылает его в канал pub/sub вместе с ID: "<id> <json>"
+var publishScript = redis.NewScript(`
+local id = redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[1], '*', 'event', ARGV[2])
+redis.call('PUBLISH', ARGV[3], id .. ' ' .. ARGV[2])
+return id
+`)
+
+// RedisBroker — брокер для нескольких реплик gateway: история в Redis Stream с ограниченной
+// длиной, доставка — через pub/sub
+type RedisBroker struct {
+	client  *redis.Client
+	history int
+}
+
+func NewRedisBroker(client *redis.Client, history int) *RedisBroker {
+	if history <= 0 {
+		history = DefaultHistory
+	}
+	return &RedisBroker{client: client, history: history}
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, ev Event) (Event, error) {
+	ev.ID = ""
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return ev, err
+	}
+	id, err := publishScript.Run(ctx, b.client, []string{redisStreamKey}, b.history, data, redisChannel).Text()
+	if err != nil {
+		return ev, err
+	}
+	ev.ID = id
+	return ev, nil
+}
+
+func (b *RedisBroker) Since(ctx context.Context, lastID string, limit int) ([]Event, error) {
+	if !ValidID(lastID) {
+		return nil, nil
+	}
+	msgs, err := b.client.XRangeN(ctx, redisStreamKey, "("+lastID, "+", int64(limit)).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Event, 0, len(msgs))
+	for _, m := range msgs {
+		raw, _ := m.Values["event"].(string)
+		var ev Event
+		if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+			continue
+		}
+		ev.ID = m.ID
+		out = append(out, ev)
+	}
+	return out, nil
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, fn func(Event)) error {
+	ps := b.client.Subscribe(ctx, redisChannel)
+	defer ps.Close()
+	// ждём подтверждения подписки, чтобы недоступный Redis был виден сразу
+	if _, err := ps.Receive(ctx); err != nil {
+		return err
+	}
+	ch := ps.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			ev, err := decodePayload(msg.Payload)
+			if err != nil {
+				continue
+			}
+			fn(ev)
+		}
+	}
+}
+
+func decodePayload(payload string) (Event, error) {
+	id, data, ok := strings.Cut(payload, " ")
+	if !ok || !ValidID(id) {
+		return Event{}, errBadPayload
+	}
+	var ev Event
+	if err := json.Unmarshal([]byte(data), &ev); err != nil {
+		return Event{}, err
+	}
+	ev.ID = id
+	return ev, nil
+}
@@ -0,0 +1,87 @@
+package orderstatus
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// replayPage — сколько событий истории читается за один запрос к брокеру
+const replayPage = 500
+
+// ErrSlowConsumer — подписка отключена из-за переполнения буфера; клиенту следует
+// переподключиться с последним полученным ID
+var ErrSlowConsumer = errors.New("orderstatus: subscriber is too slow")
+
+// Sink — транспорт одного соединения (SSE или WebSocket)
+type Sink interface {
+	Send(ev Event) error
+	Ping() error
+}
+
+// Replay возвращает события истории после lastID, подходящие под фильтр. Пустой lastID — без истории.
+func Replay(ctx context.Context, broker Broker, f Filter, lastID string) ([]Event, error) {
+	var out []Event
+	for cursor := lastID; ValidID(cursor); {
+		page, err := broker.Since(ctx, cursor, replayPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range page {
+			if f.Match(ev) {
+				out = append(out, ev)
+			}
+		}
+		if len(page) < replayPage {
+			break
+		}
+		cursor = page[len(page)-1].ID
+	}
+	return out, nil
+}
+
+// Stream отправляет в sink историю, затем живые события подписки, и пингует клиента
+// каждые heartbeat. Подписка должна быть открыта до чтения истории: события, пришедшие
+// в промежутке, окажутся и там и там, и повторы отбрасываются по ID.
+// Возвращает nil при отмене ctx, ErrSlowConsumer при переполнении буфера или ошибку транспорта.
+func Stream(ctx context.Context, sub *Subscription, backlog []Event, lastID string, sink Sink, heartbeat time.Duration) error {
+	last := lastID
+	send := func(ev Event) error {
+		if last != "" && compareID(ev.ID, last) <= 0 {
+			return nil
+		}
+		if err := sink.Send(ev); err != nil {
+			return err
+		}
+		last = ev.ID
+		return nil
+	}
+	for _, ev := range backlog {
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-sub.Events():
+			if !ok {
+				if sub.Overflowed() {
+					return ErrSlowConsumer
+				}
+				return nil
+			}
+			if err := send(ev); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := sink.Ping(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package orderstatus
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReplay_PaginatesAcrossRetentionBoundary(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	b := testBroker(2*replayPage+100, &now)
+
+	// первое событие вытесняется из истории, пока клиент был отключён
+	events := publishN(t, b, 2*replayPage+150)
+	lastSeen := events[10].ID
+
+	got, err := Replay(context.Background(), b, Filter{}, lastSeen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// lastSeen старше самого старого события истории: возвращается вся история, больше одной страницы
+	if len(got) != 2*replayPage+100 {
+		t.Fatalf("want the whole retained history, got %d events", len(got))
+	}
+	if got[0].ID != events[50].ID || got[len(got)-1].ID != events[len(events)-1].ID {
+		t.Fatalf("want oldest retained to newest, got %s..%s", got[0].ID, got[len(got)-1].ID)
+	}
+	for i := 1; i < len(got); i++ {
+		if compareID(got[i].ID, got[i-1].ID) <= 0 {
+			t.Fatalf("pages must not overlap or go backwards at %d: %s after %s", i, got[i].ID, got[i-1].ID)
+		}
+	}
+
+	// lastSeen внутри истории: только новее него
+	got, _ = Replay(context.Background(), b, Filter{}, events[len(events)-3].ID)
+	if len(got) != 2 {
+		t.Fatalf("want 2 events after lastID, got %d", len(got))
+	}
+}
+
+func TestReplay_FiltersAndIgnoresInvalidID(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	b := testBroker(10, &now)
+	_, _ = b.Publish(context.Background(), Event{UserID: "u1", OrderID: "o1"})
+	_, _ = b.Publish(context.Background(), Event{UserID: "u2", OrderID: "o2"})
+
+	got, _ := Replay(context.Background(), b, Filter{UserID: "u2"}, "0-0")
+	if len(got) != 1 || got[0].UserID != "u2" {
+		t.Fatalf("replay must apply the filter, got %+v", got)
+	}
+	for _, lastID := range []string{"", "not-an-id"} {
+		if got, _ := Replay(context.Background(), b, Filter{}, lastID); len(got) != 0 {
+			t.Fatalf("lastID %q: want no history, got %+v", lastID, got)
+		}
+	}
+}
+
+func TestHub_DisconnectsSlowSubscriber(t *testing.T) {
+	h := NewHub(2)
+	slow := h.Subscribe(Filter{})
+	other := h.Subscribe(Filter{UserID: "u2"})
+	defer other.Close()
+
+	for i := range 3 {
+		h.Broadcast(Event{ID: "1-" + strconv.Itoa(i), UserID: "u1"})
+	}
+	n := 0
+	for range slow.Events() {
+		n++
+	}
+	if n != 2 || !slow.Overflowed() {
+		t.Fatalf("slow subscriber: want 2 buffered events then disconnect, got %d overflowed=%v", n, slow.Overflowed())
+	}
+	// отфильтрованный подписчик не пострадал
+	if other.Overflowed() || len(h.subs) != 1 {
+		t.Fatal("other subscribers must stay connected")
+	}
+	slow.Close() // повторное закрытие безопасно
+}
+
+// recordSink копит отправленные события
+type recordSink struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (s *recordSink) Send(ev Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, ev.ID)
+	return nil
+}
+
+func (s *recordSink) Ping() error { return nil }
+
+func TestStream_ReturnsErrSlowConsumer(t *testing.T) {
+	h := NewHub(1)
+	sub := h.Subscribe(Filter{})
+	h.Broadcast(Event{ID: "1-0"})
+	h.Broadcast(Event{ID: "1-1"})
+
+	sink := &recordSink{}
+	err := Stream(context.Background(), sub, nil, "", sink, time.Hour)
+	if !errors.Is(err, ErrSlowConsumer) {
+		t.Fatalf("want ErrSlowConsumer, got %v", err)
+	}
+	if len(sink.sent) != 1 || sink.sent[0] != "1-0" {
+		t.Fatalf("buffered event must still be delivered, got %v", sink.sent)
+	}
+}
+
+func TestStream_DeduplicatesBacklogAndLive(t *testing.T) {
+	h := NewHub(8)
+	sub := h.Subscribe(Filter{})
+	// 1-1 пришло и в историю, и в подписку
+	h.Broadcast(Event{ID: "1-1"})
+	h.Broadcast(Event{ID: "1-2"})
+	backlog := []Event{{ID: "1-0"}, {ID: "1-1"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sink := &recordSink{}
+	done := make(chan error, 1)
+	go func() { done <- Stream(ctx, sub, backlog, "0-5", sink, time.Hour) }()
+	waitFor(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return len(sink.sent) == 3
+	})
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("cancelled stream must return nil, got %v", err)
+	}
+	want := []string{"1-0", "1-1", "1-2"}
+	for i, id := range want {
+		if sink.sent[i] != id {
+			t.Fatalf("want %v, got %v", want, sink.sent)
+		}
+	}
+}
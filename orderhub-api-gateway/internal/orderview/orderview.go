@@ -0,0 +1,182 @@
+package orderview
+
+import (
+	"context"
+	"sync"
+
+	"api-gateway/internal/dto"
+
+	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
+	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
+	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z07:00"
+
+// part: products | stock
+var partialViews = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "order_view_partial_total",
+	Help: "Order views served without a part because the backend call failed.",
+}, []string{"part"})
+
+// Composer собирает страницу заказа из order-service и inventory-service: сам заказ,
+// затем параллельно товары (один BatchGetProducts на все позиции) и остатки (BatchGetStock).
+// Без заказа страницы нет, а отказ inventory-service даёт частичный ответ.
+type Composer struct {
+	orders    orderv1.OrderServiceClient
+	inventory inventoryv1.InventoryServiceClient
+	log       *zap.Logger
+}
+
+func NewComposer(orders orderv1.OrderServiceClient, inventory inventoryv1.InventoryServiceClient, log *zap.Logger) *Composer {
+	return &Composer{orders: orders, inventory: inventory, log: log}
+}
+
+// View возвращает ошибку gRPC только если не удалось получить сам заказ. ctx должен нести
+// метаданные authorization пользователя: права на заказ проверяет order-service.
+func (s *Composer) View(ctx context.Context, orderID string) (*dto.OrderViewResponse, error) {
+	resp, err := s.orders.GetOrder(ctx, &orderv1.GetOrderRequest{OrderId: &commonv1.UUID{Value: orderID}})
+	if err != nil {
+		return nil, err
+	}
+	order := resp.GetOrder()
+	out := toView(order)
+	if len(order.GetItems()) == 0 {
+		return out, nil
+	}
+
+	ids := make([]*commonv1.UUID, 0, len(order.GetItems()))
+	seen := make(map[string]bool, len(order.GetItems()))
+	for _, it := range order.GetItems() {
+		if id := it.GetProductId().GetValue(); !seen[id] {
+			seen[id] = true
+			ids = append(ids, it.GetProductId())
+		}
+	}
+
+	var (
+		wg                    sync.WaitGroup
+		products              map[string]*inventoryv1.Product
+		stocks                map[string]*inventoryv1.Stock
+		productsErr, stockErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		r, err := s.inventory.BatchGetProducts(ctx, &inventoryv1.BatchGetProductsRequest{ProductIds: ids})
+		if err != nil {
+			productsErr = err
+			return
+		}
+		products = make(map[string]*inventoryv1.Product, len(r.GetProducts()))
+		for _, p := range r.GetProducts() {
+			products[p.GetId().GetValue()] = p
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		r, err := s.inventory.BatchGetStock(ctx, &inventoryv1.BatchGetStockRequest{ProductIds: ids})
+		if err != nil {
+			stockErr = err
+			return
+		}
+		stocks = make(map[string]*inventoryv1.Stock, len(r.GetStocks()))
+		for _, st := range r.GetStocks() {
+			stocks[st.GetProductId().GetValue()] = st
+		}
+	}()
+	wg.Wait()
+
+	if productsErr != nil {
+		s.markPartial(out, dto.OrderViewPartProducts, productsErr)
+	}
+	if stockErr != nil {
+		s.markPartial(out, dto.OrderViewPartStock, stockErr)
+	}
+	merge(out, products, stocks, productsErr == nil, stockErr == nil)
+	return out, nil
+}
+
+func (s *Composer) markPartial(out *dto.OrderViewResponse, part string, err error) {
+	out.Partial = true
+	out.Unavailable = append(out.Unavailable, part)
+	partialViews.WithLabelValues(part).Inc()
+	s.log.Warn("order view served without "+part, zap.String("order_id", out.ID), zap.Error(err))
+}
+
+func toView(o *orderv1.Order) *dto.OrderViewResponse {
+	out := &dto.OrderViewResponse{
+		ID:              o.GetId().GetValue(),
+		UserID:          o.GetUserId().GetValue(),
+		Status:          o.GetStatus().String(),
+		TotalPriceCents: o.GetTotalPriceCents(),
+		CurrencyCode:    o.GetCurrencyCode(),
+		CancelReason:    o.GetCancelReason(),
+		CreatedAt:       o.GetCreatedAt().AsTime().Format(timeLayout),
+		UpdatedAt:       o.GetUpdatedAt().AsTime().Format(timeLayout),
+		Items:           make([]dto.OrderViewItem, 0, len(o.GetItems())),
+	}
+	for _, it := range o.GetItems() {
+		out.Items = append(out.Items, dto.OrderViewItem{
+			ProductID:      it.GetProductId().GetValue(),
+			Quantity:       it.GetQuantity(),
+			UnitPriceCents: it.GetUnitPriceCents(),
+			LineTotalCents: it.GetLineTotalCents(),
+			CurrencyCode:   it.GetCurrencyCode(),
+		})
+	}
+	return out
+}
+
+// merge дополняет позиции данными каталога и остатков. Повторный заказ оценивается,
+// только когда известны и товары, и остатки.
+func merge(out *dto.OrderViewResponse, products map[string]*inventoryv1.Product, stocks map[string]*inventoryv1.Stock, haveProducts, haveStock bool) {
+	canReorder := true
+	for i := range out.Items {
+		it := &out.Items[i]
+		p := products[it.ProductID]
+		st := stocks[it.ProductID]
+		if p != nil {
+			it.Product = &dto.OrderViewProduct{
+				Name:         p.GetName(),
+				SKU:          p.GetSku(),
+				ImageURL:     p.GetImageUrl(),
+				PriceCents:   p.GetPriceCents(),
+				CurrencyCode: p.GetCurrencyCode(),
+				IsActive:     p.GetIsActive(),
+			}
+		}
+		if st != nil {
+			it.Stock = &dto.OrderViewStock{
+				Available: st.GetAvailable(),
+				InStock:   st.GetAvailable() > 0,
+				UpdatedAt: st.GetUpdatedAt().AsTime().Format(timeLayout),
+			}
+		}
+		if haveProducts && haveStock {
+			it.Reorder = reorder(p, st, it.Quantity)
+			canReorder = canReorder && it.Reorder.Eligible
+		}
+	}
+	if haveProducts && haveStock {
+		out.CanReorder = &canReorder
+	}
+}
+
+func reorder(p *inventoryv1.Product, st *inventoryv1.Stock, qty uint32) *dto.OrderViewReorder {
+	switch {
+	case p == nil:
+		return &dto.OrderViewReorder{Reason: dto.ReorderProductNotFound}
+	case !p.GetIsActive():
+		return &dto.OrderViewReorder{Reason: dto.ReorderProductInactive}
+	case st == nil || st.GetAvailable() <= 0:
+		return &dto.OrderViewReorder{Reason: dto.ReorderOutOfStock}
+	case uint32(st.GetAvailable()) < qty:
+		return &dto.OrderViewReorder{Reason: dto.ReorderInsufficientStock}
+	default:
+		return &dto.OrderViewReorder{Eligible: true}
+	}
+}
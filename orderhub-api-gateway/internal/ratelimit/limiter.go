@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Result — решение по запросу и данные для заголовков RateLimit-*
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	ResetAfter time.Duration // когда бакет полностью восстановится
+	RetryAfter time.Duration // через сколько можно повторить отклонённый запрос
+}
+
+// Store хранит состояние GCRA (theoretical arrival time) по ключу
+type Store interface {
+	Allow(ctx context.Context, key string, p *Policy) (Result, error)
+}
+
+// Limiter проверяет лимиты в общем хранилище (Redis), а при его недоступности —
+// в памяти реплики, чтобы сбой Redis не отключал защиту полностью
+type Limiter struct {
+	primary  Store
+	fallback Store
+	log      *zap.Logger
+	degraded atomic.Bool // primary недоступен; в лог пишется только смена состояния
+}
+
+// NewLimiter: primary может быть nil — тогда лимиты считаются только в памяти
+func NewLimiter(primary Store, log *zap.Logger) *Limiter {
+	return &Limiter{primary: primary, fallback: NewMemoryStore(), log: log}
+}
+
+func (l *Limiter) Allow(ctx context.Context, key string, p *Policy) Result {
+	key = "ratelimit:" + p.Name + ":" + key
+	if l.primary != nil {
+		res, err := l.primary.Allow(ctx, key, p)
+		if err == nil {
+			if l.degraded.Swap(false) {
+				l.log.Info("rate limit store recovered")
+			}
+			return res
+		}
+		if !l.degraded.Swap(true) {
+			l.log.Warn("rate limit store unavailable, using in-memory fallback", zap.Error(err))
+		}
+	}
+	res, _ := l.fallback.Allow(ctx, key, p)
+	return res
+}
+
+// MemoryStore — GCRA в памяти процесса
+type MemoryStore struct {
+	mu    sync.Mutex
+	tat   map[string]time.Time
+	sweep time.Time
+	now   func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{tat: make(map[string]time.Time), now: time.Now}
+}
+
+func (m *MemoryStore) Allow(_ context.Context, key string, p *Policy) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.evictExpired(now)
+
+	interval := p.Interval()
+	tat := m.tat[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	newTat := tat.Add(interval)
+	diff := now.Sub(newTat.Add(-interval * time.Duration(p.Burst)))
+	if diff < 0 {
+		return Result{Allowed: false, ResetAfter: tat.Sub(now), RetryAfter: -diff}, nil
+	}
+	m.tat[key] = newTat
+	return Result{Allowed: true, Remaining: int(diff / interval), ResetAfter: newTat.Sub(now)}, nil
+}
+
+// evictExpired раз в минуту удаляет восстановившиеся бакеты
+func (m *MemoryStore) evictExpired(now time.Time) {
+	if now.Sub(m.sweep) < time.Minute {
+		return
+	}
+	m.sweep = now
+	for k, tat := range m.tat {
+		if tat.Before(now) {
+			delete(m.tat, k)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// testPolicy — запрос в секунду, до трёх подряд
+func testPolicy() *Policy {
+	return &Policy{Name: "test", Key: KeyByIP, Rate: 60, Period: time.Minute, Burst: 3}
+}
+
+func testStore(now *time.Time) *MemoryStore {
+	m := NewMemoryStore()
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func TestMemoryStore_Burst(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := testStore(&now)
+	p := testPolicy()
+
+	for want := 2; want >= 0; want-- {
+		res, _ := m.Allow(context.Background(), "k", p)
+		if !res.Allowed || res.Remaining != want {
+			t.Fatalf("burst request: want allowed with remaining %d, got %+v", want, res)
+		}
+	}
+	res, _ := m.Allow(context.Background(), "k", p)
+	if res.Allowed {
+		t.Fatal("request over burst must be rejected")
+	}
+	if res.RetryAfter != time.Second {
+		t.Errorf("RetryAfter: want 1s (one interval), got %v", res.RetryAfter)
+	}
+	if res.ResetAfter != 3*time.Second {
+		t.Errorf("ResetAfter: want 3s (full burst), got %v", res.ResetAfter)
+	}
+
+	// отклонённый запрос не сдвигает бакет
+	res, _ = m.Allow(context.Background(), "k", p)
+	if res.Allowed || res.RetryAfter != time.Second {
+		t.Fatalf("rejected request must not consume capacity, got %+v", res)
+	}
+}
+
+func TestMemoryStore_Refill(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := testStore(&now)
+	p := testPolicy()
+
+	for range 3 {
+		m.Allow(context.Background(), "k", p)
+	}
+	now = now.Add(500 * time.Millisecond)
+	res, _ := m.Allow(context.Background(), "k", p)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("half an interval later: want rejected with RetryAfter 500ms, got %+v", res)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	res, _ = m.Allow(context.Background(), "k", p)
+	if !res.Allowed || res.Remaining != 0 {
+		t.Fatalf("one interval later: want one request allowed, got %+v", res)
+	}
+
+	now = now.Add(time.Hour)
+	res, _ = m.Allow(context.Background(), "k", p)
+	if !res.Allowed || res.Remaining != 2 {
+		t.Fatalf("after idle: want full burst restored, got %+v", res)
+	}
+}
+
+func TestMemoryStore_KeysAreIndependent(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := testStore(&now)
+	p := testPolicy()
+
+	for range 4 {
+		m.Allow(context.Background(), "a", p)
+	}
+	res, _ := m.Allow(context.Background(), "b", p)
+	if !res.Allowed || res.Remaining != 2 {
+		t.Fatalf("other key must have its own bucket, got %+v", res)
+	}
+}
+
+func TestMemoryStore_EvictsRecoveredBuckets(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := testStore(&now)
+	p := testPolicy()
+
+	m.Allow(context.Background(), "a", p)
+	now = now.Add(2 * time.Minute)
+	m.Allow(context.Background(), "b", p)
+	if _, ok := m.tat["a"]; ok {
+		t.Fatal("recovered bucket must be evicted")
+	}
+	if _, ok := m.tat["b"]; !ok {
+		t.Fatal("live bucket must be kept")
+	}
+}
+
+type failingStore struct{ err error }
+
+func (s *failingStore) Allow(context.Context, string, *Policy) (Result, error) {
+	if s.err != nil {
+		return Result{}, s.err
+	}
+	return Result{Allowed: true, Remaining: 42}, nil
+}
+
+func TestLimiter_FallbackLogsStateChangesOnly(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	primary := &failingStore{err: errors.New("connection refused")}
+	l := NewLimiter(primary, zap.New(core))
+	p := testPolicy()
+
+	for range 3 {
+		if res := l.Allow(context.Background(), "k", p); !res.Allowed {
+			t.Fatalf("fallback must allow within burst, got %+v", res)
+		}
+	}
+	if res := l.Allow(context.Background(), "k", p); res.Allowed {
+		t.Fatal("fallback must enforce the policy")
+	}
+	if n := logs.FilterMessage("rate limit store unavailable, using in-memory fallback").Len(); n != 1 {
+		t.Fatalf("want one warning while the store is down, got %d", n)
+	}
+
+	primary.err = nil
+	for range 2 {
+		if res := l.Allow(context.Background(), "k", p); res.Remaining != 42 {
+			t.Fatalf("recovered store must be used again, got %+v", res)
+		}
+	}
+	if n := logs.FilterMessage("rate limit store recovered").Len(); n != 1 {
+		t.Fatalf("want one recovery message, got %d", n)
+	}
+}
@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KeyBy — по чему считается лимит
+type KeyBy string
+
+const (
+	KeyByIP     KeyBy = "ip"      // адрес клиента
+	KeyByUser   KeyBy = "user"    // пользователь из CtxUserID; без аутентификации — по IP
+	KeyByAPIKey KeyBy = "api_key" // ID персонального API-ключа; без ключа — по IP
+)
+
+// Policy — лимит GCRA: Rate запросов за Period в среднем и до Burst подряд
+type Policy struct {
+	Name   string        `yaml:"-"`
+	Key    KeyBy         `yaml:"key"`
+	Rate   int           `yaml:"rate"`
+	Period time.Duration `yaml:"period"`
+	Burst  int           `yaml:"burst"`
+}
+
+// Interval — интервал между запросами при равномерной нагрузке
+func (p *Policy) Interval() time.Duration {
+	return p.Period / time.Duration(p.Rate)
+}
+
+// Config — содержимое файла политик:
+//
+//	default: global
+//	policies:
+//	  global: {key: ip, rate: 100, period: 1m, burst: 50}
+//	  login:  {key: ip, rate: 5, period: 1m, burst: 5}
+//	  keys:   {key: api_key, rate: 600, period: 1m, burst: 100}
+//	api_key: keys
+//	routes:
+//	  "POST /api/v1/auth/login": login
+//
+// Маршрут задаётся методом и шаблоном пути gin; маршруты без записи получают политику default
+// (пустая — без ограничений), политика "off" отключает лимит для маршрута. Политика api_key
+// действует на любом маршруте, если запрос пришёл с API-ключом, — вдобавок к политике маршрута.
+type Config struct {
+	Default  string             `yaml:"default"`
+	APIKey   string             `yaml:"api_key"`
+	Policies map[string]*Policy `yaml:"policies"`
+	Routes   map[string]string  `yaml:"routes"`
+}
+
+// Off — имя, отключающее ограничение для маршрута
+const Off = "off"
+
+// LoadConfig читает и проверяет файл политик
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	for name, p := range c.Policies {
+		if p == nil {
+			return fmt.Errorf("policy %q is empty", name)
+		}
+		p.Name = name
+		switch p.Key {
+		case KeyByIP, KeyByUser, KeyByAPIKey:
+		case "":
+			p.Key = KeyByIP
+		default:
+			return fmt.Errorf("policy %q: unknown key %q", name, p.Key)
+		}
+		if p.Rate <= 0 || p.Period <= 0 {
+			return fmt.Errorf("policy %q: rate and period must be positive", name)
+		}
+		if p.Burst <= 0 {
+			p.Burst = 1
+		}
+	}
+	if c.Default != "" && c.Default != Off {
+		if _, ok := c.Policies[c.Default]; !ok {
+			return fmt.Errorf("default policy %q is not defined", c.Default)
+		}
+	}
+	if c.APIKey != "" {
+		p, ok := c.Policies[c.APIKey]
+		if !ok {
+			return fmt.Errorf("api_key policy %q is not defined", c.APIKey)
+		}
+		if p.Key != KeyByAPIKey {
+			return fmt.Errorf("api_key policy %q must use key %q", c.APIKey, KeyByAPIKey)
+		}
+	}
+	for route, name := range c.Routes {
+		if _, _, ok := strings.Cut(route, " "); !ok {
+			return fmt.Errorf("route %q must be \"METHOD /path\"", route)
+		}
+		if _, ok := c.Policies[name]; !ok && name != Off {
+			return fmt.Errorf("route %q: policy %q is not defined", route, name)
+		}
+	}
+	return nil
+}
+
+// PolicyFor возвращает политику маршрута; nil — без ограничений
+func (c *Config) PolicyFor(method, path string) *Policy {
+	name, ok := c.Routes[method+" "+path]
+	if !ok {
+		name = c.Default
+	}
+	return c.Policies[name]
+}
+
+// APIKeyPolicy возвращает политику запросов с API-ключом; nil — не задана
+func (c *Config) APIKeyPolicy() *Policy {
+	return c.Policies[c.APIKey]
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func loadYAML(t *testing.T, data string) (*Config, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ratelimit.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return LoadConfig(path)
+}
+
+func TestLoadConfig_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name:    "unknown key",
+			yaml:    "policies:\n  p: {key: session, rate: 1, period: 1m}\n",
+			wantErr: `unknown key "session"`,
+		},
+		{
+			name:    "zero rate",
+			yaml:    "policies:\n  p: {key: ip, rate: 0, period: 1m}\n",
+			wantErr: "rate and period must be positive",
+		},
+		{
+			name:    "missing period",
+			yaml:    "policies:\n  p: {key: ip, rate: 5}\n",
+			wantErr: "rate and period must be positive",
+		},
+		{
+			name:    "empty policy",
+			yaml:    "policies:\n  p:\n",
+			wantErr: `policy "p" is empty`,
+		},
+		{
+			name:    "undefined default",
+			yaml:    "default: global\npolicies:\n  p: {rate: 1, period: 1m}\n",
+			wantErr: `default policy "global" is not defined`,
+		},
+		{
+			name:    "route without method",
+			yaml:    "policies:\n  p: {rate: 1, period: 1m}\nroutes:\n  /api/v1/x: p\n",
+			wantErr: "must be \"METHOD /path\"",
+		},
+		{
+			name:    "route with undefined policy",
+			yaml:    "policies:\n  p: {rate: 1, period: 1m}\nroutes:\n  \"GET /x\": q\n",
+			wantErr: `policy "q" is not defined`,
+		},
+		{
+			name:    "undefined api_key policy",
+			yaml:    "api_key: keys\npolicies:\n  p: {rate: 1, period: 1m}\n",
+			wantErr: `api_key policy "keys" is not defined`,
+		},
+		{
+			name:    "api_key policy keyed by ip",
+			yaml:    "api_key: p\npolicies:\n  p: {key: ip, rate: 1, period: 1m}\n",
+			wantErr: `must use key "api_key"`,
+		},
+		{
+			name: "valid",
+			yaml: "default: p\napi_key: k\npolicies:\n  p: {rate: 1, period: 1m}\n  k: {key: api_key, rate: 1, period: 1m}\nroutes:\n  \"GET /x\": off\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadYAML(t, tt.yaml)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("want error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	cfg, err := loadYAML(t, "default: p\npolicies:\n  p: {rate: 10, period: 1m}\nroutes:\n  \"GET /health\": off\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := cfg.Policies["p"]
+	if p.Name != "p" || p.Key != KeyByIP || p.Burst != 1 {
+		t.Fatalf("want name, key ip and burst 1 filled in, got %+v", p)
+	}
+	if p.Interval() != 6*time.Second {
+		t.Errorf("Interval: want 6s, got %v", p.Interval())
+	}
+	if cfg.PolicyFor("GET", "/health") != nil {
+		t.Error("off route must have no policy")
+	}
+	if cfg.PolicyFor("POST", "/other") != p {
+		t.Error("route without entry must get the default policy")
+	}
+	if cfg.APIKeyPolicy() != nil {
+		t.Error("api_key policy must be nil when not configured")
+	}
+}
+
+// Файл из репозитория должен загружаться: gateway с ним стартует
+func TestLoadConfig_RepositoryFile(t *testing.T) {
+	cfg, err := LoadConfig("../../config/ratelimit.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := cfg.APIKeyPolicy(); p == nil || p.Key != KeyByAPIKey {
+		t.Fatalf("api_key policy must be configured, got %+v", p)
+	}
+}
@@ -1,52 +1,152 @@
 package router
 
 import (
+	"api-gateway/config"
 	"api-gateway/internal/auth"
 	"api-gateway/internal/handlers"
 	"api-gateway/internal/middleware"
+	"api-gateway/internal/ratelimit"
+	"strings"
 
-	"github.com/gin-contrib/cors"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.uber.org/zap"
 
 	"github.com/gin-gonic/gin"
 )
 
-func Router(authClient *auth.Client, log *zap.Logger) *gin.Engine {
-	r := gin.Default()
+func Router(cfg *config.Config, authClient *auth.Client, limiter *ratelimit.Limiter, policies *ratelimit.Config, ready *readiness.Checker, orderStatus *handlers.OrderStatusHandler, orderView *handlers.OrderViewHandler, graphQL *handlers.GraphQLHandler, log *zap.Logger) *gin.Engine {
+	r := gin.New()
+	// access_token снимается с URL раньше, чем адрес попадёт в access-лог и спан
+	r.Use(middleware.StripAccessToken(), middleware.AccessLog(gin.DefaultWriter), gin.Recovery())
+	r.Use(middleware.Metrics())
+	// серверный спан на каждый маршрут; Correlation берёт traceparent из него
+	r.Use(otelgin.Middleware("api-gateway", otelgin.WithGinFilter(func(c *gin.Context) bool {
+		switch c.FullPath() {
+		case "/health", "/readyz", "/metrics":
+			return false
+		}
+		return !strings.HasPrefix(c.FullPath(), "/swagger/")
+	})))
+	r.Use(middleware.Correlation())
 
-	r.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Authorization", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-	}))
+	r.Use(middleware.CORS(cfg.CORSOrigins))
+
+	if policies != nil {
+		r.Use(middleware.RateLimit(limiter, policies))
+	}
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// liveness: процесс жив; readiness с детализацией по зависимостям — /readyz
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
 			"status": "ok",
 		})
 	})
+	r.GET("/readyz", gin.WrapH(ready.Handler()))
 
-	authHandler := handlers.NewAuthHandler(authClient, log)
+	authHandler := handlers.NewAuthHandler(authClient, cfg.Cookies, log)
 	auth := r.Group("/api/v1/auth")
 
 	auth.POST("/register", authHandler.Register)
 	auth.POST("/login", authHandler.Login)
-	auth.POST("/refresh", authHandler.Refresh)
-	auth.POST("/request-password-reset", authHandler.RequestPasswordReset)
-	auth.POST("/confirm-password-reset", authHandler.ConfirmPasswordReset)
+	auth.POST("/refresh", middleware.CSRF(cfg.Cookies), authHandler.Refresh)
+	auth.POST("/request-password-reset", middleware.DenyImpersonation(authClient, log), authHandler.RequestPasswordReset)
+	auth.POST("/confirm-password-reset", middleware.DenyImpersonation(authClient, log), authHandler.ConfirmPasswordReset)
 	auth.GET("/jwks", authHandler.GetJwks)
 	// защищаем logout валидным access-токеном
-	auth.POST("/logout", middleware.AuthRequired(authClient, log), authHandler.Logout)
+	auth.POST("/logout", middleware.CSRF(cfg.Cookies), middleware.AuthRequired(authClient, log), authHandler.Logout)
 
 	// email verification
 	r.POST("/api/v1/auth/email/verification/confirm", authHandler.ConfirmEmailVerification)
 	auth.POST("/email/verification/request", middleware.AuthRequired(authClient, log), authHandler.RequestEmailVerification)
 
+	// персональные данные
+	auth.DELETE("/account", middleware.AuthRequired(authClient, log), middleware.DenyImpersonation(authClient, log), authHandler.DeleteAccount)
+	auth.GET("/me/export", middleware.AuthRequired(authClient, log), authHandler.ExportMyData)
+
+	// профиль
+	auth.GET("/me", middleware.AuthRequired(authClient, log), authHandler.GetMe)
+	auth.PATCH("/me", middleware.AuthRequired(authClient, log), authHandler.UpdateMe)
+	auth.POST("/phone/verification/request", middleware.AuthRequired(authClient, log), authHandler.RequestPhoneVerification)
+	auth.POST("/phone/verification/confirm", middleware.AuthRequired(authClient, log), authHandler.ConfirmPhoneVerification)
+
+	// гостевые аккаунты
+	auth.POST("/guest", authHandler.CreateGuest)
+	auth.POST("/guest/upgrade", middleware.AuthRequired(authClient, log), middleware.DenyImpersonation(authClient, log), authHandler.UpgradeGuest)
+
+	// персональные API-ключи
+	apiKeyHandler := handlers.NewAPIKeyHandler(authClient, log)
+	apiKeys := auth.Group("/api-keys", middleware.AuthRequired(authClient, log), middleware.DenyImpersonation(authClient, log))
+	apiKeys.POST("", apiKeyHandler.CreateAPIKey)
+	apiKeys.GET("", apiKeyHandler.ListAPIKeys)
+	apiKeys.DELETE("/:id", apiKeyHandler.RevokeAPIKey)
+
+	// доверенные устройства и письма о новом входе
+	deviceHandler := handlers.NewDeviceHandler(authClient, log)
+	auth.GET("/devices", middleware.AuthRequired(authClient, log), deviceHandler.ListTrustedDevices)
+	auth.DELETE("/devices/:id", middleware.AuthRequired(authClient, log), deviceHandler.ForgetTrustedDevice)
+	auth.POST("/sign-in-alerts/report", deviceHandler.ReportSignIn)
+
+	// управление правами ролей
+	rbacHandler := handlers.NewRBACHandler(authClient, log)
+	rbac := r.Group("/api/v1/admin/rbac", middleware.AuthRequired(authClient, log), middleware.RequirePermission(authz.PermRBACManage))
+	rbac.GET("/permissions", rbacHandler.ListPermissions)
+	rbac.GET("/roles/:role/permissions", rbacHandler.ListRolePermissions)
+	rbac.POST("/roles/:role/permissions", rbacHandler.GrantRolePermission)
+	rbac.DELETE("/roles/:role/permissions/:permission", rbacHandler.RevokeRolePermission)
+
+	// управление пользователями
+	users := r.Group("/api/v1/admin/users", middleware.AuthRequired(authClient, log), middleware.RequirePermission(authz.PermUserManage))
+	users.PUT("/:id/role", rbacHandler.SetUserRole)
+	users.POST("/:id/disable", rbacHandler.DisableUser)
+	r.POST("/api/v1/admin/users/:id/impersonate",
+		middleware.AuthRequired(authClient, log),
+		middleware.RequirePermission(authz.PermUserImpersonate),
+		middleware.DenyImpersonation(authClient, log),
+		rbacHandler.Impersonate)
+
+	// подключение продавцов
+	vendorHandler := handlers.NewVendorHandler(authClient, log)
+	r.POST("/api/v1/vendor/applications", middleware.AuthRequired(authClient, log), vendorHandler.SubmitApplication)
+	vendorAdmin := r.Group("/api/v1/admin/vendor-applications", middleware.AuthRequired(authClient, log), middleware.RequirePermission(authz.PermVendorReview))
+	vendorAdmin.GET("", vendorHandler.ListApplications)
+	vendorAdmin.POST("/:id/approve", vendorHandler.ApproveApplication)
+	vendorAdmin.POST("/:id/reject", vendorHandler.RejectApplication)
+
+	// интроспекция и отзыв токенов для сторонних resource server'ов (RFC 7662 / RFC 7009)
+	oauthHandler := handlers.NewOAuthHandler(authClient, log)
+	r.POST("/oauth/introspect", oauthHandler.Introspect)
+	r.POST("/oauth/revoke", oauthHandler.Revoke)
+	oauthClients := r.Group("/api/v1/admin/oauth-clients", middleware.AuthRequired(authClient, log), middleware.RequirePermission(authz.PermOAuthClientManage))
+	oauthClients.POST("", oauthHandler.CreateClient)
+	oauthClients.GET("", oauthHandler.ListClients)
+	oauthClients.DELETE("/:client_id", oauthHandler.DeleteClient)
+
+	// стриминг статусов заказов; без KAFKA_BROKERS маршрутов нет
+	if orderStatus != nil {
+		orderStream := r.Group("/api/v1/orders/status", middleware.TokenFromQuery(), middleware.AuthRequired(authClient, log))
+		orderStream.GET("/stream", orderStatus.StreamSSE)
+		orderStream.GET("/ws", orderStatus.StreamWS)
+	}
+
+	// BFF: страница заказа с данными товаров; без адресов order- и inventory-service маршрута нет
+	if orderView != nil {
+		r.GET("/api/v1/orders/:id/view", middleware.AuthRequired(authClient, log), orderView.GetOrderView)
+	}
+
+	// GraphQL: запросы по POST, подписки по WebSocket (токен можно передать в access_token)
+	if graphQL != nil {
+		r.POST("/graphql", middleware.AuthRequired(authClient, log), graphQL.Query)
+		r.GET("/graphql", middleware.TokenFromQuery(), middleware.AuthRequired(authClient, log), graphQL.Subscribe)
+	}
+
 	return r
 }
@@ -4,17 +4,28 @@ import (
 	"auth-service/config"
 	"auth-service/internal/cleanup"
 	"context"
+	"flag"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 )
 
 func main() {
+	dryRun := flag.Bool("dry-run", false, "report what would be deleted without deleting anything")
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(1)
+	}
+
 	_ = godotenv.Load()
 
 	isDev := os.Getenv("ENV") == "development"
@@ -24,51 +35,90 @@ func main() {
 	defer logger.Sync()
 
 	log := logger.L()
+
+	shutdownTracing, err := telemetry.Setup(context.Background(), "auth-cleanup", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
 	cfg := config.Load(log)
 
 	db := database.ConnectDB(&cfg.DB.Config, log)
 	defer database.CloseDB(db, log)
 
 	cleanupSvc := cleanup.NewCleanupService(db, log)
+	cleanupSvc.SetGuestTTL(cfg.GuestTTL)
 
 	ctx := context.Background()
 
-	if len(os.Args) > 1 {
-		switch os.Args[1] {
-		case "expired":
-			log.Info("running expired tokens cleanup")
-			if err := cleanupSvc.CleanupExpiredTokens(ctx); err != nil {
-				log.Fatal("failed to cleanup expired tokens", zap.Error(err))
-			}
-		case "sessions":
-			log.Info("running sessions cleanup")
-			if err := cleanupSvc.CleanupOrphanedSessions(ctx); err != nil {
-				log.Fatal("failed to cleanup orphaned sessions", zap.Error(err))
-			}
-			if err := cleanupSvc.CleanupOldSessions(ctx); err != nil {
-				log.Fatal("failed to cleanup old sessions", zap.Error(err))
-			}
-		case "consumed":
-			log.Info("running consumed tokens cleanup")
-			if err := cleanupSvc.CleanupConsumedTokens(ctx); err != nil {
-				log.Fatal("failed to cleanup consumed tokens", zap.Error(err))
+	jobs := cleanup.Jobs
+	switch arg := flag.Arg(0); arg {
+	case cleanup.JobExpired, cleanup.JobSessions, cleanup.JobConsumed, cleanup.JobGuests:
+		jobs = []string{arg}
+	case "all":
+	default:
+		// прежнее поведение: неизвестная подкоманда — полная очистка
+		log.Warn("unknown cleanup job, running full cleanup", zap.String("job", arg))
+	}
+
+	total := cleanup.Counts{}
+	for _, job := range jobs {
+		if *dryRun {
+			counts, err := cleanupSvc.Run(ctx, job, true)
+			if err != nil {
+				log.Fatal("dry run failed", zap.String("job", job), zap.Error(err))
 			}
-		case "all":
-			fallthrough
-		default:
-			log.Info("running full cleanup")
-			if err := cleanupSvc.RunFullCleanup(ctx); err != nil {
-				log.Fatal("failed to run full cleanup", zap.Error(err))
+			for k, v := range counts {
+				total[k] += v
 			}
+			continue
+		}
+
+		log.Info("running cleanup", zap.String("job", job))
+		counts, ran, err := cleanupSvc.RunExclusive(ctx, job, 0)
+		if err != nil {
+			log.Fatal("cleanup failed", zap.String("job", job), zap.Error(err))
+		}
+		if !ran {
+			log.Warn("cleanup job is running on another replica, skipped", zap.String("job", job))
+		}
+		for k, v := range counts {
+			total[k] += v
 		}
-	} else {
-		fmt.Println("Usage: go run cmd/cleanup/main.go [expired|sessions|consumed|all]")
-		fmt.Println("  expired  - cleanup expired tokens only")
-		fmt.Println("  sessions - cleanup orphaned and old sessions")
-		fmt.Println("  consumed - cleanup consumed tokens")
-		fmt.Println("  all      - run full cleanup (default)")
-		os.Exit(1)
 	}
 
+	printCounts(total, *dryRun)
+	if *dryRun {
+		return
+	}
 	log.Info("cleanup completed successfully")
 }
+
+func usage() {
+	fmt.Println("Usage: go run cmd/cleanup/main.go [--dry-run] [expired|sessions|consumed|guests|all]")
+	fmt.Println("  expired  - cleanup expired tokens only")
+	fmt.Println("  sessions - cleanup orphaned and old sessions")
+	fmt.Println("  consumed - cleanup consumed tokens")
+	fmt.Println("  guests   - delete guest accounts that were never upgraded")
+	fmt.Println("  all      - run full cleanup (default)")
+	fmt.Println("  --dry-run - only count rows that would be deleted")
+}
+
+// printCounts выводит число удалённых (в dry-run — подлежащих удалению) строк по видам записей
+func printCounts(counts cleanup.Counts, dryRun bool) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	header := "deleted"
+	if dryRun {
+		header = "would delete"
+	}
+	fmt.Printf("%-40s %s\n", "records", header)
+	for _, k := range keys {
+		fmt.Printf("%-40s %d\n", k, counts[k])
+	}
+	fmt.Printf("%-40s %d\n", "total", counts.Total())
+}
@@ -5,6 +5,8 @@ import (
 	"auth-service/internal/cache"
 	"auth-service/internal/cleanup"
 	"auth-service/internal/hashing"
+	"auth-service/internal/outbox"
+	"auth-service/internal/password"
 	"auth-service/internal/producer"
 	"auth-service/internal/repository"
 	"auth-service/internal/service"
@@ -19,8 +21,13 @@ import (
 
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation/interceptor"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/metrics"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness/kafkaready"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
@@ -41,6 +48,12 @@ func main() {
 
 	log := logger.L()
 
+	shutdownTracing, err := telemetry.Setup(context.Background(), "auth-service", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg := config.Load(log)
 
 	db := database.ConnectDB(&cfg.DB.Config, log)
@@ -51,6 +64,12 @@ func main() {
 	emailProducer := producer.NewEmailProducer(cfg.KafkaBrokers, cfg.KafkaTopic)
 	defer emailProducer.Close()
 
+	userEventProducer := producer.NewUserEventProducer(cfg.KafkaBrokers, cfg.KafkaUserEventsTopic)
+	defer userEventProducer.Close()
+
+	smsProducer := producer.NewSMSProducer(cfg.KafkaBrokers, cfg.KafkaSMSTopic)
+	defer smsProducer.Close()
+
 	var redisClient *cache.RedisClient
 	if cfg.Redis.Enabled {
 		var err error
@@ -69,12 +88,15 @@ func main() {
 	jwkStore := repos.JWKs
 	tokens := token.NewRSAProvider(jwkStore, cfg.JWT.Issuer, cfg.JWT.Audience)
 
+	watermarks := token.NewWatermarks(repos.Watermarks, time.Duration(cfg.Redis.TTLSeconds)*time.Second)
+	tokens.SetWatermarks(watermarks)
+	tokens.SetPermissions(repos.Permissions)
+
 	if redisClient != nil {
 		tokens.SetCache(redisClient)
+		watermarks.SetCache(redisClient)
 	}
 
-	authInterceptor := gtransport.NewAuthUnaryServerInterceptor(tokens)
-
 	authSvc := service.NewAuthService(
 		repos.Users, repos.RefreshTokens, repos.JWKs,
 		hasher, tokens, repos.Session, repos.PasswordReset, repos.EmailVerification,
@@ -84,28 +106,80 @@ func main() {
 		time.Duration(cfg.JWT.RefreshExp),
 		log,
 	)
+	authSvc.SetTokenRevoker(watermarks)
+	authSvc.SetPermissionRepo(repos.Permissions)
+	authSvc.SetAccountRepo(repos.Accounts)
+	authSvc.SetVendorApplicationRepo(repos.VendorApps)
+	authSvc.SetAPIKeyRepo(repos.APIKeys)
+	authSvc.SetImpersonationRepo(repos.Impersonations)
+	authSvc.SetDeviceRepos(repos.TrustedDevices, repos.SignInAlerts)
+	authSvc.SetOAuthClients(repos.OAuthClients, cfg.JWT.Audience)
+	authSvc.SetPhoneVerification(repos.PhoneVerification, smsProducer)
+	// nonce и jti должны быть видны всем репликам; без Redis — только в пределах одной
+	if redisClient != nil {
+		authSvc.SetDPoP(redisClient, cfg.DPoPRequireNonce)
+	} else {
+		authSvc.SetDPoP(cache.NewMemoryStore(), cfg.DPoPRequireNonce)
+	}
+	authSvc.SetAppURL(cfg.AppURL)
+
+	breached, err := password.LoadBreachedList(cfg.Password.BreachedFile)
+	if err != nil {
+		log.Fatal("failed to load breached password list", zap.Error(err))
+	}
+	classes, err := password.ParseClasses(cfg.Password.RequiredClasses)
+	if err != nil {
+		log.Fatal("invalid PASSWORD_REQUIRED_CLASSES", zap.Error(err))
+	}
+	log.Info("password policy loaded", zap.Int("breached_entries", breached.Len()))
+	authSvc.SetPasswordPolicy(&password.Policy{
+		MinLength:       cfg.Password.MinLength,
+		RequiredClasses: classes,
+		ForbidEmail:     true,
+		History:         cfg.Password.History,
+		Breached:        breached,
+	}, repos.PasswordHistory)
+
+	authInterceptor := gtransport.NewAuthUnaryServerInterceptor(tokens, authSvc)
 
 	cleanupSvc := cleanup.NewCleanupService(db, log)
+	cleanupSvc.SetGuestTTL(cfg.GuestTTL)
 	scheduler := cleanup.NewScheduler(cleanupSvc, log)
+	schedule, err := cleanup.ParseSchedule(cfg.CleanupSchedule)
+	if err != nil {
+		log.Fatal("invalid CLEANUP_SCHEDULE", zap.Error(err))
+	}
+	scheduler.SetSchedule(schedule)
 
 	cleanupCtx, cleanupCancel := context.WithCancel(context.Background())
 	defer cleanupCancel()
 	scheduler.Start(cleanupCtx)
 
+	eventRelay := outbox.NewRelay(repos.UserEventOutbox, userEventProducer, log)
+	eventRelay.Start(cleanupCtx)
+
 	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
 		log.Fatal("failed to listen", zap.Error(err))
 	}
 
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(authInterceptor),
+		telemetry.ServerOption(),
+		grpc.ChainUnaryInterceptor(interceptor.UnaryServer(log), metrics.UnaryServerInterceptor(), telemetry.InterceptorSpan("authenticate", authInterceptor), gtransport.NewImpersonationAuditInterceptor(log)),
 	)
 
-	// Health server
+	// Health server: "" — готовность по зависимостям, "liveness" — процесс жив
 	healthSrv := health.NewServer()
-	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthSrv)
 
+	ready := readiness.New(log)
+	ready.Add("postgres", database.Ping(db))
+	ready.Add("kafka", kafkaready.Check(cfg.KafkaBrokers))
+	if redisClient != nil {
+		ready.Add("redis", redisClient.Ping)
+	}
+	go ready.Watch(cleanupCtx, healthSrv, readiness.DefaultInterval)
+
 	reflection.Register(grpcServer)
 
 	// Регистрируем gRPC handler
@@ -115,6 +189,11 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	metricsSrv := metrics.Serve(cfg.MetricsAddr, log,
+		metrics.Route{Pattern: "/readyz", Handler: ready.Handler()},
+		metrics.Route{Pattern: "/livez", Handler: readiness.LiveHandler()},
+	)
+
 	go func() {
 		log.Info("Starting gRPC server", zap.String("addr", cfg.Port))
 		if err := grpcServer.Serve(lis); err != nil {
@@ -124,9 +203,13 @@ func main() {
 
 	<-quit
 	log.Info("Shutting down gRPC server...")
+	// NOT_SERVING до остановки, чтобы балансировщик успел снять трафик
+	healthSrv.Shutdown()
+	_ = metrics.Shutdown(context.Background(), metricsSrv)
 
 	// Останавливаем планировщик
 	scheduler.Stop()
+	eventRelay.Stop()
 	cleanupCancel()
 
 	grpcServer.GracefulStop()
@@ -18,8 +18,22 @@ type Config struct {
 	DB    DB
 	Redis Redis
 
-	KafkaBrokers []string
-	KafkaTopic   string
+	Password Password
+
+	KafkaBrokers         []string
+	KafkaTopic           string
+	KafkaUserEventsTopic string
+	KafkaSMSTopic        string
+
+	AppURL string // адрес веб-приложения для ссылок в письмах
+
+	GuestTTL time.Duration // срок хранения неактивных гостевых аккаунтов; 0 — по умолчанию
+
+	CleanupSchedule string // переопределения расписания очистки: "expired=15m,guests=off"
+
+	DPoPRequireNonce bool // требовать nonce сервера в DPoP-доказательствах
+
+	MetricsAddr string // адрес HTTP-листенера /metrics; "off" — отключить
 }
 
 type JWT struct {
@@ -33,6 +47,14 @@ type DB struct {
 	database.Config
 }
 
+// Password — политика паролей; все переменные необязательные
+type Password struct {
+	MinLength       int
+	RequiredClasses string // через запятую: lower, upper, letter, digit, symbol
+	History         int
+	BreachedFile    string // список оператора в дополнение к встроенному
+}
+
 type Redis struct {
 	Enabled    bool
 	Addr       string
@@ -67,8 +89,21 @@ func Load(log *zap.Logger) *Config {
 			DB:         atoiDefault(getEnv("REDIS_DB", log), 0),
 			TTLSeconds: atoiDefault(getEnv("CACHE_TTL_SECONDS", log), 60),
 		},
-		KafkaBrokers: splitAndTrim(os.Getenv("KAFKA_BROKERS")),
-		KafkaTopic:   getEnv("KAFKA_TOPIC_EMAIL", log),
+		Password: Password{
+			MinLength:       atoiDefault(os.Getenv("PASSWORD_MIN_LENGTH"), 8),
+			RequiredClasses: envDefault("PASSWORD_REQUIRED_CLASSES", "letter,digit"),
+			History:         atoiDefault(os.Getenv("PASSWORD_HISTORY"), 5),
+			BreachedFile:    os.Getenv("PASSWORD_BREACHED_FILE"),
+		},
+		KafkaBrokers:         splitAndTrim(os.Getenv("KAFKA_BROKERS")),
+		KafkaTopic:           getEnv("KAFKA_TOPIC_EMAIL", log),
+		KafkaUserEventsTopic: getEnv("KAFKA_TOPIC_USER_EVENTS", log),
+		KafkaSMSTopic:        envDefault("KAFKA_TOPIC_SMS", "sms.send"),
+		AppURL:               os.Getenv("APP_URL"),
+		GuestTTL:             parseDurationWithDays(os.Getenv("GUEST_TTL")),
+		CleanupSchedule:      os.Getenv("CLEANUP_SCHEDULE"),
+		DPoPRequireNonce:     envDefault("DPOP_REQUIRE_NONCE", "true") == "true",
+		MetricsAddr:          envDefault("METRICS_ADDR", ":9101"),
 	}
 }
 
@@ -80,6 +115,13 @@ func getEnv(key string, log *zap.Logger) string {
 	panic("missing required environment variable: " + key)
 }
 
+func envDefault(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return def
+}
+
 func parseDurationWithDays(s string) time.Duration {
 	if strings.HasSuffix(s, "d") {
 		daysStr := strings.TrimSuffix(s, "d")
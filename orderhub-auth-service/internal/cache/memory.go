@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrMiss — ключа нет или он истёк
+var ErrMiss = errors.New("cache miss")
+
+// MemoryStore — SetNX/Get в памяти процесса для запуска без Redis. Данные не общие
+// между репликами, поэтому в проде с несколькими экземплярами нужен Redis.
+type MemoryStore struct {
+	mu    sync.Mutex
+	items map[string]memoryItem
+	now   func() time.Time
+}
+
+type memoryItem struct {
+	value    string
+	deadline time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{items: map[string]memoryItem{}, now: time.Now}
+}
+
+func (m *MemoryStore) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	if it, ok := m.items[key]; ok && now.Before(it.deadline) {
+		return false, nil
+	}
+	m.evictExpired(now)
+	m.items[key] = memoryItem{value: toString(value), deadline: now.Add(ttl)}
+	return true, nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	it, ok := m.items[key]
+	if !ok || !m.now().Before(it.deadline) {
+		return "", ErrMiss
+	}
+	return it.value, nil
+}
+
+// evictExpired чистит карту, когда она разрастается, чтобы не держать старые jti
+func (m *MemoryStore) evictExpired(now time.Time) {
+	if len(m.items) < 1024 {
+		return
+	}
+	for k, it := range m.items {
+		if !now.Before(it.deadline) {
+			delete(m.items, k)
+		}
+	}
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return "1"
+}
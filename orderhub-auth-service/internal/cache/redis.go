@@ -40,6 +40,11 @@ func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
 
+// Ping — проверка доступности Redis для readiness
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 func (r *RedisClient) SetRateLimit(ctx context.Context, key string, ttl time.Duration) error {
 	return r.client.Set(ctx, key, "1", ttl).Err()
 }
@@ -83,6 +88,11 @@ func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, tt
 	return r.client.Set(ctx, key, value, ttl).Err()
 }
 
+// SetNX записывает ключ, только если его ещё нет; false — ключ уже существовал
+func (r *RedisClient) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, ttl).Result()
+}
+
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	return r.client.Get(ctx, key).Result()
 }
@@ -1,161 +1,229 @@
 package cleanup
 
 import (
+	"auth-service/internal/producer"
 	"context"
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+// DefaultGuestTTL — через сколько после последней активности удаляется гость без регистрации
+const DefaultGuestTTL = 30 * 24 * time.Hour
+
+// Задачи очистки; имя задачи — это и подкоманда cmd/cleanup, и ключ в CLEANUP_SCHEDULE
+const (
+	JobExpired  = "expired"
+	JobSessions = "sessions"
+	JobConsumed = "consumed"
+	JobGuests   = "guests"
+)
+
+// Jobs — все задачи в порядке полной очистки
+var Jobs = []string{JobExpired, JobConsumed, JobSessions, JobGuests}
+
+// cleanupRunsRetention — сколько хранится история запусков
+const cleanupRunsRetention = 90 * 24 * time.Hour
+
+// Counts — число удалённых строк по видам записей; в dry-run — сколько было бы удалено
+type Counts map[string]int64
+
+// Total — сумма по всем видам записей
+func (c Counts) Total() int64 {
+	var n int64
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
 type CleanupService struct {
-	db  *gorm.DB
-	log *zap.Logger
+	db       *gorm.DB
+	log      *zap.Logger
+	guestTTL time.Duration
 }
 
 func NewCleanupService(db *gorm.DB, log *zap.Logger) *CleanupService {
 	return &CleanupService{
-		db:  db,
-		log: log,
+		db:       db,
+		log:      log,
+		guestTTL: DefaultGuestTTL,
 	}
 }
 
-// CleanupExpiredTokens удаляет истёкшие refresh токены, password reset и email verification токены
-func (c *CleanupService) CleanupExpiredTokens(ctx context.Context) error {
-	now := time.Now()
-
-	// Удаляем истёкшие refresh токены
-	result := c.db.WithContext(ctx).
-		Exec("DELETE FROM refresh_tokens WHERE expires_at < ?", now)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup expired refresh tokens", zap.Error(result.Error))
-		return result.Error
-	}
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up expired refresh tokens", zap.Int64("count", result.RowsAffected))
+// SetGuestTTL задаёт срок хранения неактивных гостевых аккаунтов
+func (c *CleanupService) SetGuestTTL(ttl time.Duration) {
+	if ttl > 0 {
+		c.guestTTL = ttl
 	}
+}
 
-	// Удаляем истёкшие password reset токены
-	result = c.db.WithContext(ctx).
-		Exec("DELETE FROM password_reset_tokens WHERE expires_at < ?", now)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup expired password reset tokens", zap.Error(result.Error))
-		return result.Error
-	}
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up expired password reset tokens", zap.Int64("count", result.RowsAffected))
+// Run выполняет одну задачу по имени
+func (c *CleanupService) Run(ctx context.Context, job string, dryRun bool) (Counts, error) {
+	switch job {
+	case JobExpired:
+		return c.CleanupExpiredTokens(ctx, dryRun)
+	case JobSessions:
+		return c.CleanupSessions(ctx, dryRun)
+	case JobConsumed:
+		return c.CleanupConsumedTokens(ctx, dryRun)
+	case JobGuests:
+		return c.CleanupGuests(ctx, dryRun)
+	default:
+		return nil, fmt.Errorf("unknown cleanup job %q", job)
 	}
+}
 
-	// Удаляем истёкшие email verification токены
-	result = c.db.WithContext(ctx).
-		Exec("DELETE FROM email_verifications WHERE expires_at < ?", now)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup expired email verification tokens", zap.Error(result.Error))
-		return result.Error
+// remove удаляет строки table, подходящие под where; в dry-run только считает их
+func (c *CleanupService) remove(ctx context.Context, dryRun bool, counts Counts, key, table, where string, args ...any) error {
+	db := c.db.WithContext(ctx)
+	var n int64
+	if dryRun {
+		if err := db.Raw("SELECT count(*) FROM "+table+" WHERE "+where, args...).Scan(&n).Error; err != nil {
+			c.log.Error("failed to count "+key, zap.Error(err))
+			return err
+		}
+	} else {
+		result := db.Exec("DELETE FROM "+table+" WHERE "+where, args...)
+		if result.Error != nil {
+			c.log.Error("failed to cleanup "+key, zap.Error(result.Error))
+			return result.Error
+		}
+		n = result.RowsAffected
+	}
+	counts[key] += n
+	if n > 0 {
+		msg := "cleaned up " + key
+		if dryRun {
+			msg = "would clean up " + key
+		}
+		c.log.Info(msg, zap.Int64("count", n))
 	}
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up expired email verification tokens", zap.Int64("count", result.RowsAffected))
-	}
-
 	return nil
 }
 
-// CleanupOrphanedSessions удаляет сессии, у которых нет активных refresh токенов
-func (c *CleanupService) CleanupOrphanedSessions(ctx context.Context) error {
+// CleanupExpiredTokens удаляет истёкшие refresh токены, password reset, email и phone verification коды,
+// ссылки из писем о новом входе и старую историю запусков очистки
+func (c *CleanupService) CleanupExpiredTokens(ctx context.Context, dryRun bool) (Counts, error) {
 	now := time.Now()
+	counts := Counts{}
 
-	// Находим сессии без активных refresh токенов
-	query := `
-		DELETE FROM user_sessions 
-		WHERE id NOT IN (
-			SELECT DISTINCT session_id 
-			FROM refresh_tokens 
-			WHERE session_id IS NOT NULL 
-			AND expires_at > ? 
-			AND revoked = false
-		)
-	`
-
-	result := c.db.WithContext(ctx).Exec(query, now)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup orphaned sessions", zap.Error(result.Error))
-		return result.Error
+	for _, table := range []string{"refresh_tokens", "password_reset_tokens", "email_verifications", "phone_verifications", "sign_in_alerts"} {
+		if err := c.remove(ctx, dryRun, counts, "expired "+table, table, "expires_at < ?", now); err != nil {
+			return counts, err
+		}
 	}
-
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up orphaned sessions", zap.Int64("count", result.RowsAffected))
+	if err := c.remove(ctx, dryRun, counts, "old cleanup_runs", "cleanup_runs", "started_at < ?", now.Add(-cleanupRunsRetention)); err != nil {
+		return counts, err
 	}
 
-	return nil
+	return counts, nil
 }
 
-// CleanupOldSessions удаляет старые неактивные сессии (старше 30 дней)
-func (c *CleanupService) CleanupOldSessions(ctx context.Context) error {
-	cutoff := time.Now().AddDate(0, 0, -30) // 30 дней назад
-
-	result := c.db.WithContext(ctx).
-		Exec("DELETE FROM user_sessions WHERE last_seen_at < ?", cutoff)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup old sessions", zap.Error(result.Error))
-		return result.Error
+// CleanupSessions удаляет сессии без активных refresh токенов и неактивные дольше 30 дней
+func (c *CleanupService) CleanupSessions(ctx context.Context, dryRun bool) (Counts, error) {
+	now := time.Now()
+	counts := Counts{}
+
+	orphaned := `
+		id NOT IN (
+			SELECT DISTINCT session_id
+			FROM refresh_tokens
+			WHERE session_id IS NOT NULL
+			AND expires_at > ?
+			AND revoked = false
+		)
+	`
+	if err := c.remove(ctx, dryRun, counts, "orphaned sessions", "user_sessions", orphaned, now); err != nil {
+		return counts, err
 	}
 
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up old sessions", zap.Int64("count", result.RowsAffected))
+	cutoff := now.AddDate(0, 0, -30) // 30 дней назад
+	if err := c.remove(ctx, dryRun, counts, "old sessions", "user_sessions", "last_seen_at < ?", cutoff); err != nil {
+		return counts, err
 	}
 
-	return nil
+	return counts, nil
 }
 
 // CleanupConsumedTokens удаляет уже использованные (consumed) токены старше 24 часов
-func (c *CleanupService) CleanupConsumedTokens(ctx context.Context) error {
+func (c *CleanupService) CleanupConsumedTokens(ctx context.Context, dryRun bool) (Counts, error) {
 	cutoff := time.Now().Add(-24 * time.Hour)
+	counts := Counts{}
 
-	// Удаляем использованные password reset токены
-	result := c.db.WithContext(ctx).
-		Exec("DELETE FROM password_reset_tokens WHERE consumed = true AND created_at < ?", cutoff)
-	if result.Error != nil {
-		c.log.Error("failed to cleanup consumed password reset tokens", zap.Error(result.Error))
-		return result.Error
+	for _, table := range []string{"password_reset_tokens", "email_verifications", "phone_verifications"} {
+		if err := c.remove(ctx, dryRun, counts, "consumed "+table, table, "consumed = true AND created_at < ?", cutoff); err != nil {
+			return counts, err
+		}
 	}
-	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up consumed password reset tokens", zap.Int64("count", result.RowsAffected))
+
+	return counts, nil
+}
+
+// CleanupGuests удаляет гостей, которые так и не зарегистрировались: аккаунт старше guestTTL
+// и за этот срок у него не появилось действующих refresh-токенов. Для каждого удалённого гостя в outbox
+// пишется account_deleted, чтобы order и inventory обезличили ссылки на него.
+func (c *CleanupService) CleanupGuests(ctx context.Context, dryRun bool) (Counts, error) {
+	now := time.Now()
+	cutoff := now.Add(-c.guestTTL)
+	counts := Counts{}
+
+	where := `
+		u.is_guest
+		AND u.created_at < ?
+		AND NOT EXISTS (
+			SELECT 1 FROM refresh_tokens r
+			WHERE r.user_id = u.id
+			AND r.revoked = false
+			AND r.expires_at > ?
+			AND r.created_at > ?
+		)
+	`
+	if dryRun {
+		err := c.remove(ctx, true, counts, "guest accounts", "users u", where, cutoff, now, cutoff)
+		return counts, err
 	}
 
-	// Удаляем использованные email verification токены
-	result = c.db.WithContext(ctx).
-		Exec("DELETE FROM email_verifications WHERE consumed = true AND created_at < ?", cutoff)
+	query := `
+		WITH pruned AS (
+			DELETE FROM users u
+			WHERE ` + where + `
+			RETURNING u.id
+		)
+		INSERT INTO user_event_outbox (event_type, user_id, occurred_at)
+		SELECT ?, id, ? FROM pruned
+	`
+
+	result := c.db.WithContext(ctx).Exec(query, cutoff, now, cutoff, producer.UserEventAccountDeleted, now)
 	if result.Error != nil {
-		c.log.Error("failed to cleanup consumed email verification tokens", zap.Error(result.Error))
-		return result.Error
+		c.log.Error("failed to cleanup guest accounts", zap.Error(result.Error))
+		return counts, result.Error
 	}
+	counts["guest accounts"] = result.RowsAffected
 	if result.RowsAffected > 0 {
-		c.log.Info("cleaned up consumed email verification tokens", zap.Int64("count", result.RowsAffected))
+		c.log.Info("cleaned up guest accounts", zap.Int64("count", result.RowsAffected))
 	}
 
-	return nil
+	return counts, nil
 }
 
 // RunFullCleanup выполняет все задачи очистки
-func (c *CleanupService) RunFullCleanup(ctx context.Context) error {
-	c.log.Info("starting full cleanup")
-
-	if err := c.CleanupExpiredTokens(ctx); err != nil {
-		return err
-	}
-
-	if err := c.CleanupConsumedTokens(ctx); err != nil {
-		return err
-	}
-
-	if err := c.CleanupOrphanedSessions(ctx); err != nil {
-		return err
-	}
-
-	if err := c.CleanupOldSessions(ctx); err != nil {
-		return err
-	}
-
-	c.log.Info("full cleanup completed")
-	return nil
+func (c *CleanupService) RunFullCleanup(ctx context.Context, dryRun bool) (Counts, error) {
+	c.log.Info("starting full cleanup", zap.Bool("dry_run", dryRun))
+
+	total := Counts{}
+	for _, job := range Jobs {
+		counts, err := c.Run(ctx, job, dryRun)
+		for k, v := range counts {
+			total[k] += v
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+
+	c.log.Info("full cleanup completed", zap.Int64("rows", total.Total()))
+	return total, nil
 }
@@ -0,0 +1,78 @@
+package cleanup
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// lockPrefix — пространство имён advisory lock'ов задач очистки
+const lockPrefix = "auth-cleanup:"
+
+// RunExclusive выполняет задачу под advisory lock'ом PostgreSQL, поэтому при нескольких
+// репликах задачу в каждый момент выполняет только одна. Запуск записывается в cleanup_runs.
+// Если lock занят или последний успешный запуск был меньше minGap назад (его сделала
+// другая реплика), задача пропускается и ran == false.
+func (c *CleanupService) RunExclusive(ctx context.Context, job string, minGap time.Duration) (counts Counts, ran bool, err error) {
+	err = c.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw(`SELECT pg_try_advisory_lock(hashtext(?))`, lockPrefix+job).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("acquire cleanup lock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+		defer func() {
+			if err := conn.Exec(`SELECT pg_advisory_unlock(hashtext(?))`, lockPrefix+job).Error; err != nil {
+				c.log.Warn("failed to release cleanup lock", zap.String("job", job), zap.Error(err))
+			}
+		}()
+
+		if minGap > 0 {
+			var last sql.NullTime
+			if err := conn.Raw(`SELECT max(started_at) FROM cleanup_runs WHERE job = ? AND error IS NULL`, job).Row().Scan(&last); err != nil {
+				return fmt.Errorf("read last cleanup run: %w", err)
+			}
+			if last.Valid && time.Since(last.Time) < minGap {
+				return nil
+			}
+		}
+
+		ran = true
+		started := time.Now()
+		var runErr error
+		counts, runErr = c.Run(ctx, job, false)
+		c.recordRun(ctx, job, started, counts, runErr)
+		return runErr
+	})
+	return counts, ran, err
+}
+
+// recordRun пишет запуск в историю; ошибка записи только логируется
+func (c *CleanupService) recordRun(ctx context.Context, job string, started time.Time, counts Counts, runErr error) {
+	host, _ := os.Hostname()
+	run := &models.CleanupRun{
+		Job:         job,
+		Host:        host,
+		StartedAt:   started,
+		DurationMs:  time.Since(started).Milliseconds(),
+		RowsDeleted: counts.Total(),
+		Counts:      counts,
+	}
+	if run.Counts == nil {
+		run.Counts = Counts{}
+	}
+	if runErr != nil {
+		msg := runErr.Error()
+		run.Error = &msg
+	}
+	if err := c.db.WithContext(ctx).Create(run).Error; err != nil {
+		c.log.Warn("failed to record cleanup run", zap.String("job", job), zap.Error(err))
+	}
+}
@@ -2,33 +2,99 @@ package cleanup
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 )
 
+// Schedule — интервал запуска каждой задачи; задача без интервала не запускается
+type Schedule map[string]time.Duration
+
+// DefaultSchedule — расписание, если CLEANUP_SCHEDULE не задан
+func DefaultSchedule() Schedule {
+	return Schedule{
+		JobExpired:  30 * time.Minute,
+		JobSessions: time.Hour,
+		JobConsumed: 6 * time.Hour,
+		JobGuests:   6 * time.Hour,
+	}
+}
+
+// ParseSchedule накладывает на расписание по умолчанию переопределения вида
+// "expired=15m,guests=1d"; значение "off" отключает задачу
+func ParseSchedule(s string) (Schedule, error) {
+	out := DefaultSchedule()
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		job, value, ok := strings.Cut(item, "=")
+		job, value = strings.TrimSpace(job), strings.TrimSpace(value)
+		if !ok {
+			return nil, fmt.Errorf("cleanup schedule %q: expected job=interval", item)
+		}
+		if _, known := out[job]; !known {
+			return nil, fmt.Errorf("cleanup schedule: unknown job %q", job)
+		}
+		if value == "off" {
+			delete(out, job)
+			continue
+		}
+		every, err := parseInterval(value)
+		if err != nil || every <= 0 {
+			return nil, fmt.Errorf("cleanup schedule %q: invalid interval %q", job, value)
+		}
+		out[job] = every
+	}
+	return out, nil
+}
+
+// parseInterval — time.ParseDuration с поддержкой суффикса d (дни)
+func parseInterval(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		d, err := time.ParseDuration(days + "h")
+		return 24 * d, err
+	}
+	return time.ParseDuration(s)
+}
+
 type Scheduler struct {
-	cleanup *CleanupService
-	log     *zap.Logger
-	stopCh  chan struct{}
+	cleanup  *CleanupService
+	schedule Schedule
+	log      *zap.Logger
+	stopCh   chan struct{}
 }
 
 func NewScheduler(cleanup *CleanupService, log *zap.Logger) *Scheduler {
 	return &Scheduler{
-		cleanup: cleanup,
-		log:     log,
-		stopCh:  make(chan struct{}),
+		cleanup:  cleanup,
+		schedule: DefaultSchedule(),
+		log:      log,
+		stopCh:   make(chan struct{}),
 	}
 }
 
+// SetSchedule задаёт расписание; вызывать до Start
+func (s *Scheduler) SetSchedule(schedule Schedule) {
+	s.schedule = schedule
+}
+
 // Start запускает планировщик задач
 func (s *Scheduler) Start(ctx context.Context) {
 	s.log.Info("starting cleanup scheduler")
 
 	// Запускаем горутины для разных задач
-	go s.runExpiredTokensCleanup(ctx)
-	go s.runSessionsCleanup(ctx)
-	go s.runConsumedTokensCleanup(ctx)
+	for _, job := range Jobs {
+		every, ok := s.schedule[job]
+		if !ok {
+			s.log.Info("cleanup job disabled", zap.String("job", job))
+			continue
+		}
+		go s.run(ctx, job, every)
+	}
 }
 
 // Stop останавливает планировщик
@@ -37,72 +103,34 @@ func (s *Scheduler) Stop() {
 	close(s.stopCh)
 }
 
-// runExpiredTokensCleanup очищает истёкшие токены каждые 30 минут
-func (s *Scheduler) runExpiredTokensCleanup(ctx context.Context) {
-	ticker := time.NewTicker(30 * time.Minute)
-	defer ticker.Stop()
-
-	// Выполняем сразу при старте
-	if err := s.cleanup.CleanupExpiredTokens(ctx); err != nil {
-		s.log.Error("initial expired tokens cleanup failed", zap.Error(err))
-	}
-
-	for {
-		select {
-		case <-ticker.C:
-			if err := s.cleanup.CleanupExpiredTokens(ctx); err != nil {
-				s.log.Error("expired tokens cleanup failed", zap.Error(err))
-			}
-		case <-s.stopCh:
-			s.log.Info("expired tokens cleanup stopped")
-			return
-		case <-ctx.Done():
-			s.log.Info("expired tokens cleanup cancelled")
-			return
-		}
-	}
-}
-
-// runSessionsCleanup очищает сессии каждый час
-func (s *Scheduler) runSessionsCleanup(ctx context.Context) {
-	ticker := time.NewTicker(1 * time.Hour)
+// run выполняет задачу сразу при старте и затем раз в every. Реплики проверяют задачу
+// независимо, но выполняет её та, что первой взяла lock после истечения интервала;
+// небольшой запас (1/10 интервала) гасит разброс тикеров между репликами.
+func (s *Scheduler) run(ctx context.Context, job string, every time.Duration) {
+	ticker := time.NewTicker(every)
 	defer ticker.Stop()
 
-	for {
-		select {
-		case <-ticker.C:
-			if err := s.cleanup.CleanupOrphanedSessions(ctx); err != nil {
-				s.log.Error("orphaned sessions cleanup failed", zap.Error(err))
-			}
-			if err := s.cleanup.CleanupOldSessions(ctx); err != nil {
-				s.log.Error("old sessions cleanup failed", zap.Error(err))
-			}
-		case <-s.stopCh:
-			s.log.Info("sessions cleanup stopped")
-			return
-		case <-ctx.Done():
-			s.log.Info("sessions cleanup cancelled")
-			return
+	minGap := every - every/10
+	tick := func() {
+		counts, ran, err := s.cleanup.RunExclusive(ctx, job, minGap)
+		switch {
+		case err != nil:
+			s.log.Error("cleanup job failed", zap.String("job", job), zap.Error(err))
+		case ran:
+			s.log.Debug("cleanup job finished", zap.String("job", job), zap.Int64("rows", counts.Total()))
 		}
 	}
-}
-
-// runConsumedTokensCleanup очищает использованные токены каждые 6 часов
-func (s *Scheduler) runConsumedTokensCleanup(ctx context.Context) {
-	ticker := time.NewTicker(6 * time.Hour)
-	defer ticker.Stop()
 
+	tick()
 	for {
 		select {
 		case <-ticker.C:
-			if err := s.cleanup.CleanupConsumedTokens(ctx); err != nil {
-				s.log.Error("consumed tokens cleanup failed", zap.Error(err))
-			}
+			tick()
 		case <-s.stopCh:
-			s.log.Info("consumed tokens cleanup stopped")
+			s.log.Info("cleanup job stopped", zap.String("job", job))
 			return
 		case <-ctx.Done():
-			s.log.Info("consumed tokens cleanup cancelled")
+			s.log.Info("cleanup job cancelled", zap.String("job", job))
 			return
 		}
 	}
@@ -110,5 +138,6 @@ func (s *Scheduler) runConsumedTokensCleanup(ctx context.Context) {
 
 // RunOnceNow выполняет полную очистку немедленно (для тестирования)
 func (s *Scheduler) RunOnceNow(ctx context.Context) error {
-	return s.cleanup.RunFullCleanup(ctx)
+	_, err := s.cleanup.RunFullCleanup(ctx, false)
+	return err
 }
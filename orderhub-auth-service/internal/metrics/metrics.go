@@ -0,0 +1,23 @@
+// Package metrics — доменные метрики auth-service; /metrics отдаёт листенер из pkg/metrics
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// Logins — попытки входа по исходу: success, invalid_credentials, disabled,
+	// reset_required, dpop_rejected, error
+	Logins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_logins_total",
+		Help: "Login attempts by outcome.",
+	}, []string{"outcome"})
+
+	// RefreshRotations — обмены refresh-токена по исходу: rotated, invalid, disabled,
+	// dpop_rejected, error
+	RefreshRotations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_refresh_rotations_total",
+		Help: "Refresh token rotations by outcome.",
+	}, []string{"outcome"})
+)
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS user_sessions;
+DROP TABLE IF EXISTS password_reset_tokens;
+DROP TABLE IF EXISTS email_verifications;
+DROP TABLE IF EXISTS jwk_keys;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,117 @@
+-- Базовая схема auth-service. Написана идемпотентно (IF NOT EXISTS), чтобы
+-- применяться и к базам, созданным прежним AutoMigrate.
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS users (
+  id                uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  email             text NOT NULL,
+  password          text NOT NULL,
+  role              text NOT NULL DEFAULT 'ROLE_CUSTOMER',
+  is_email_verified boolean NOT NULL DEFAULT false,
+  created_at        timestamptz NOT NULL DEFAULT now(),
+  updated_at        timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+CREATE INDEX IF NOT EXISTS idx_users_is_email_verified ON users (is_email_verified);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_users_email ON users (lower(email));
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+  id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id      uuid NOT NULL,
+  session_id   uuid,
+  token_hash   text NOT NULL,
+  client_id    text,
+  ip           inet,
+  user_agent   text,
+  expires_at   timestamptz NOT NULL,
+  revoked      boolean NOT NULL DEFAULT false,
+  created_at   timestamptz NOT NULL DEFAULT now(),
+  last_used_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_revoked ON refresh_tokens (revoked);
+
+CREATE TABLE IF NOT EXISTS jwk_keys (
+  kid        varchar(128) PRIMARY KEY,
+  alg        text NOT NULL DEFAULT 'RS256',
+  kty        text NOT NULL DEFAULT 'RSA',
+  use        text NOT NULL DEFAULT 'sig',
+  n          text NOT NULL,
+  e          text NOT NULL,
+  priv_pem   bytea NOT NULL,
+  active     boolean NOT NULL DEFAULT false,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  rotates_at timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_jwk_keys_active ON jwk_keys (active);
+CREATE INDEX IF NOT EXISTS idx_jwk_keys_rotates_at ON jwk_keys (rotates_at);
+
+CREATE TABLE IF NOT EXISTS email_verifications (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id    uuid NOT NULL,
+  email      text NOT NULL,
+  code_hash  text NOT NULL,
+  expires_at timestamptz NOT NULL,
+  consumed   boolean NOT NULL DEFAULT false,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_email_verifications_user_id ON email_verifications (user_id);
+CREATE INDEX IF NOT EXISTS idx_email_verifications_code_hash ON email_verifications (code_hash);
+CREATE INDEX IF NOT EXISTS idx_email_verifications_expires_at ON email_verifications (expires_at);
+CREATE INDEX IF NOT EXISTS idx_email_verifications_consumed ON email_verifications (consumed);
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id    uuid NOT NULL,
+  email      text NOT NULL,
+  code_hash  text NOT NULL,
+  expires_at timestamptz NOT NULL,
+  consumed   boolean NOT NULL DEFAULT false,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_code_hash ON password_reset_tokens (code_hash);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_expires_at ON password_reset_tokens (expires_at);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_consumed ON password_reset_tokens (consumed);
+
+CREATE TABLE IF NOT EXISTS user_sessions (
+  id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id      uuid NOT NULL,
+  client_id    text,
+  ip           inet,
+  user_agent   text,
+  created_at   timestamptz NOT NULL DEFAULT now(),
+  last_seen_at timestamptz NOT NULL DEFAULT now(),
+  revoked      boolean NOT NULL DEFAULT false
+);
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id ON user_sessions (user_id);
+CREATE INDEX IF NOT EXISTS idx_user_sessions_client_id ON user_sessions (client_id);
+CREATE INDEX IF NOT EXISTS idx_user_sessions_last_seen_at ON user_sessions (last_seen_at);
+CREATE INDEX IF NOT EXISTS idx_user_sessions_revoked ON user_sessions (revoked);
+
+-- updated_at
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN NEW.updated_at = now(); RETURN NEW; END; $$ LANGUAGE plpgsql;
+DROP TRIGGER IF EXISTS trg_users_updated ON users;
+CREATE TRIGGER trg_users_updated BEFORE UPDATE ON users
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- внешние ключи
+ALTER TABLE refresh_tokens
+  DROP CONSTRAINT IF EXISTS fk_refresh_user,
+  ADD CONSTRAINT fk_refresh_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE email_verifications
+  DROP CONSTRAINT IF EXISTS fk_emailv_user,
+  ADD CONSTRAINT fk_emailv_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE password_reset_tokens
+  DROP CONSTRAINT IF EXISTS fk_pr_user,
+  ADD CONSTRAINT fk_pr_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE user_sessions
+  DROP CONSTRAINT IF EXISTS fk_sessions_user,
+  ADD CONSTRAINT fk_sessions_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
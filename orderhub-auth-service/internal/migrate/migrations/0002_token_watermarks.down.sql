@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS token_watermarks;
+DROP INDEX IF EXISTS idx_users_is_disabled;
+ALTER TABLE users DROP COLUMN IF EXISTS is_disabled;
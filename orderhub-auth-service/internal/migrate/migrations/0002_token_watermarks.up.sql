@@ -0,0 +1,14 @@
+-- Блокировка аккаунтов и водяной знак отзыва access-токенов
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_disabled boolean NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_users_is_disabled ON users (is_disabled);
+
+CREATE TABLE IF NOT EXISTS token_watermarks (
+  user_id        uuid PRIMARY KEY,
+  revoked_before timestamptz NOT NULL,
+  reason         text NOT NULL,
+  updated_at     timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE token_watermarks
+  DROP CONSTRAINT IF EXISTS fk_watermark_user,
+  ADD CONSTRAINT fk_watermark_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
@@ -0,0 +1,49 @@
+-- Права ролей (RBAC). Справочник прав и начальные права ролей;
+-- дальнейшие изменения прав делаются через RPC или новыми миграциями.
+CREATE TABLE IF NOT EXISTS permissions (
+  code        text PRIMARY KEY,
+  description text NOT NULL DEFAULT '',
+  created_at  timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+  role       text NOT NULL,
+  permission text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (role, permission)
+);
+
+ALTER TABLE role_permissions
+  DROP CONSTRAINT IF EXISTS fk_role_perm_permission,
+  ADD CONSTRAINT fk_role_perm_permission FOREIGN KEY (permission) REFERENCES permissions(code) ON DELETE CASCADE;
+
+INSERT INTO permissions (code, description) VALUES
+  ('product:write',     'Создание и изменение своих товаров'),
+  ('product:write:any', 'Изменение любых товаров'),
+  ('stock:adjust',      'Управление остатками своих товаров'),
+  ('stock:adjust:any',  'Управление остатками любых товаров'),
+  ('order:create',      'Оформление заказов'),
+  ('order:read:any',    'Просмотр любых заказов'),
+  ('order:cancel:any',  'Отмена любых заказов'),
+  ('rbac:manage',       'Управление правами ролей')
+ON CONFLICT (code) DO NOTHING;
+
+-- начальные права заливаются только в пустую таблицу, чтобы не перетирать правки администраторов
+INSERT INTO role_permissions (role, permission)
+SELECT v.role, v.permission
+FROM (VALUES
+  ('ROLE_CUSTOMER', 'order:create'),
+  ('ROLE_VENDOR',   'order:create'),
+  ('ROLE_VENDOR',   'product:write'),
+  ('ROLE_VENDOR',   'stock:adjust'),
+  ('ROLE_ADMIN',    'order:create'),
+  ('ROLE_ADMIN',    'order:read:any'),
+  ('ROLE_ADMIN',    'order:cancel:any'),
+  ('ROLE_ADMIN',    'product:write'),
+  ('ROLE_ADMIN',    'product:write:any'),
+  ('ROLE_ADMIN',    'stock:adjust'),
+  ('ROLE_ADMIN',    'stock:adjust:any'),
+  ('ROLE_ADMIN',    'rbac:manage')
+) AS v(role, permission)
+WHERE NOT EXISTS (SELECT 1 FROM role_permissions)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DELETE FROM permissions WHERE code = 'vendor:review';
+DROP TABLE IF EXISTS vendor_applications;
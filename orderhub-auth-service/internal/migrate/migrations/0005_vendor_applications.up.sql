@@ -0,0 +1,34 @@
+-- Заявки на статус продавца
+CREATE TABLE IF NOT EXISTS vendor_applications (
+  id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id       uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  company_name  text NOT NULL,
+  tax_id        text NOT NULL,
+  website       text NOT NULL DEFAULT '',
+  phone         text NOT NULL DEFAULT '',
+  description   text NOT NULL DEFAULT '',
+  status        text NOT NULL DEFAULT 'PENDING'
+                CHECK (status IN ('PENDING','APPROVED','REJECTED')),
+  reject_reason text,
+  reviewed_by   uuid,
+  reviewed_at   timestamptz,
+  created_at    timestamptz NOT NULL DEFAULT now(),
+  updated_at    timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_vendor_applications_user_id ON vendor_applications (user_id);
+CREATE INDEX IF NOT EXISTS ix_vendor_applications_status_created ON vendor_applications (status, created_at);
+-- у пользователя не больше одной заявки на рассмотрении
+CREATE UNIQUE INDEX IF NOT EXISTS ux_vendor_applications_user_pending
+  ON vendor_applications (user_id) WHERE status = 'PENDING';
+
+DROP TRIGGER IF EXISTS trg_vendor_applications_updated ON vendor_applications;
+CREATE TRIGGER trg_vendor_applications_updated BEFORE UPDATE ON vendor_applications
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+INSERT INTO permissions (code, description) VALUES
+  ('vendor:review', 'Рассмотрение заявок продавцов')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ROLE_ADMIN', 'vendor:review')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,7 @@
+INSERT INTO permissions (code, description) VALUES
+  ('user:manage', 'Смена роли и блокировка пользователей')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ROLE_ADMIN', 'user:manage')
+ON CONFLICT DO NOTHING;
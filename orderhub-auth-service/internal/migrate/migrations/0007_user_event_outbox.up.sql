@@ -0,0 +1,15 @@
+-- События пользователя для Kafka: пишутся в транзакции вместе с изменением данных,
+-- публикуются фоновым relay с повторами
+CREATE TABLE IF NOT EXISTS user_event_outbox (
+  id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  event_type      text NOT NULL,
+  user_id         uuid NOT NULL,
+  occurred_at     timestamptz NOT NULL,
+  attempts        integer NOT NULL DEFAULT 0,
+  last_error      text,
+  next_attempt_at timestamptz NOT NULL DEFAULT now(),
+  sent_at         timestamptz,
+  created_at      timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS ix_user_event_outbox_pending
+  ON user_event_outbox (next_attempt_at) WHERE sent_at IS NULL;
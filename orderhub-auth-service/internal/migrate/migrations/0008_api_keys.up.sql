@@ -0,0 +1,16 @@
+-- Персональные API-ключи: хранится только хэш ключа
+CREATE TABLE IF NOT EXISTS api_keys (
+  id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id      uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name         text NOT NULL,
+  prefix       text NOT NULL,
+  key_hash     text NOT NULL,
+  scopes       jsonb NOT NULL DEFAULT '[]'::jsonb,
+  expires_at   timestamptz,
+  last_used_at timestamptz,
+  last_used_ip text,
+  revoked_at   timestamptz,
+  created_at   timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_api_keys_key_hash ON api_keys (key_hash);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS impersonation_events;
+DELETE FROM permissions WHERE code = 'user:impersonate';
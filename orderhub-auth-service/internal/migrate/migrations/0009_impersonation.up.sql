@@ -0,0 +1,21 @@
+-- Вход администратора от имени пользователя и журнал таких входов
+INSERT INTO permissions (code, description) VALUES
+  ('user:impersonate', 'Вход от имени пользователя (поддержка)')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ROLE_ADMIN', 'user:impersonate')
+ON CONFLICT DO NOTHING;
+
+-- без внешних ключей: журнал аудита переживает удаление учётных записей
+CREATE TABLE IF NOT EXISTS impersonation_events (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  actor_id   uuid NOT NULL,
+  target_id  uuid NOT NULL,
+  reason     text NOT NULL,
+  ip         text,
+  expires_at timestamptz NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_impersonation_events_actor_id ON impersonation_events (actor_id);
+CREATE INDEX IF NOT EXISTS idx_impersonation_events_target_id ON impersonation_events (target_id);
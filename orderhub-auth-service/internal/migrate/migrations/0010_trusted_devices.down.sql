@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS sign_in_alerts;
+DROP TABLE IF EXISTS trusted_devices;
+ALTER TABLE users DROP COLUMN IF EXISTS must_reset_password;
@@ -0,0 +1,28 @@
+-- Доверенные устройства и письма о входе с нового устройства
+ALTER TABLE users ADD COLUMN IF NOT EXISTS must_reset_password boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS trusted_devices (
+  id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id       uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  client_id     text NOT NULL,
+  ip_network    text NOT NULL,
+  user_agent    text,
+  first_seen_at timestamptz NOT NULL DEFAULT now(),
+  last_seen_at  timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_trusted_devices_user_client_net ON trusted_devices (user_id, client_id, ip_network);
+
+CREATE TABLE IF NOT EXISTS sign_in_alerts (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id    uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  session_id uuid NOT NULL,
+  client_id  text NOT NULL,
+  ip_network text NOT NULL,
+  token_hash text NOT NULL,
+  expires_at timestamptz NOT NULL,
+  used_at    timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_sign_in_alerts_token_hash ON sign_in_alerts (token_hash);
+CREATE INDEX IF NOT EXISTS idx_sign_in_alerts_user_id ON sign_in_alerts (user_id);
+CREATE INDEX IF NOT EXISTS idx_sign_in_alerts_expires_at ON sign_in_alerts (expires_at);
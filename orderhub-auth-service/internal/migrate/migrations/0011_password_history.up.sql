@@ -0,0 +1,12 @@
+-- История паролей для политики "не повторять последние N"
+CREATE TABLE IF NOT EXISTS password_history (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id    uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  hash       text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_password_history_user_created ON password_history (user_id, created_at DESC);
+
+-- текущий пароль существующих пользователей становится первой записью истории
+INSERT INTO password_history (user_id, hash, created_at)
+SELECT id, password, updated_at FROM users WHERE deleted_at IS NULL AND password <> '';
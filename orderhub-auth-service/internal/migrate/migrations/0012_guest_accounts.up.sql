@@ -0,0 +1,3 @@
+-- Гостевые аккаунты: оформление заказа без регистрации
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_guest boolean NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_users_guest_created_at ON users (created_at) WHERE is_guest;
@@ -0,0 +1,12 @@
+-- История запусков задач очистки: сколько строк удалено и сколько длился запуск
+CREATE TABLE IF NOT EXISTS cleanup_runs (
+  id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  job          text NOT NULL,
+  host         text NOT NULL DEFAULT '',
+  started_at   timestamptz NOT NULL,
+  duration_ms  bigint NOT NULL,
+  rows_deleted bigint NOT NULL DEFAULT 0,
+  counts       jsonb NOT NULL DEFAULT '{}'::jsonb,
+  error        text
+);
+CREATE INDEX IF NOT EXISTS idx_cleanup_runs_job_started ON cleanup_runs (job, started_at DESC);
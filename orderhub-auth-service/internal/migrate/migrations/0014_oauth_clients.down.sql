@@ -0,0 +1,2 @@
+DELETE FROM permissions WHERE code = 'oauth_client:manage';
+DROP TABLE IF EXISTS oauth_clients;
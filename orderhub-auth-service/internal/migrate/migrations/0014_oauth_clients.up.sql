@@ -0,0 +1,19 @@
+-- Клиенты OAuth 2.0 (сторонние resource server'ы) для интроспекции и отзыва токенов;
+-- хранится только хэш client_secret
+CREATE TABLE IF NOT EXISTS oauth_clients (
+  id           uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  client_id    text NOT NULL,
+  name         text NOT NULL,
+  secret_hash  text NOT NULL,
+  last_used_at timestamptz,
+  created_at   timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS ux_oauth_clients_client_id ON oauth_clients (client_id);
+
+INSERT INTO permissions (code, description) VALUES
+  ('oauth_client:manage', 'Управление OAuth-клиентами')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('ROLE_ADMIN', 'oauth_client:manage')
+ON CONFLICT DO NOTHING;
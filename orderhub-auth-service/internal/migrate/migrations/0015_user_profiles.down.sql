@@ -0,0 +1,6 @@
+ALTER TABLE users DROP COLUMN IF EXISTS marketing_consent_at;
+ALTER TABLE users DROP COLUMN IF EXISTS marketing_consent;
+ALTER TABLE users DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,15 @@
+-- Подтверждение телефона кодом из SMS
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_phone_verified boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS phone_verifications (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id    uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  phone      text NOT NULL,
+  code_hash  text NOT NULL,
+  attempts   integer NOT NULL DEFAULT 0,
+  expires_at timestamptz NOT NULL,
+  consumed   boolean NOT NULL DEFAULT false,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_phone_verifications_user_created ON phone_verifications (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_phone_verifications_expires_at ON phone_verifications (expires_at);
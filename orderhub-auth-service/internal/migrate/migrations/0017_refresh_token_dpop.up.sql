@@ -0,0 +1,2 @@
+-- Привязка refresh-токенов к DPoP-ключу клиента (RFC 9449)
+ALTER TABLE refresh_tokens ADD COLUMN IF NOT EXISTS jkt text;
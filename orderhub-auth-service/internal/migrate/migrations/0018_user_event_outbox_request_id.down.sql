@@ -0,0 +1 @@
+ALTER TABLE user_event_outbox DROP COLUMN IF EXISTS request_id;
@@ -0,0 +1,2 @@
+-- request_id запроса, породившего событие: relay передаёт его в заголовке Kafka
+ALTER TABLE user_event_outbox ADD COLUMN IF NOT EXISTS request_id text;
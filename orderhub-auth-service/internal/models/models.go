@@ -15,13 +15,27 @@ const (
 )
 
 type User struct {
-	ID              uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	Email           string    `gorm:"not null"` // уникальность обеспечим функциональным индексом lower(email)
-	Password        string    `gorm:"not null"` // hash (argon2id/bcrypt)
-	Role            Role      `gorm:"type:text;not null;default:'ROLE_CUSTOMER';index"`
-	IsEmailVerified bool      `gorm:"not null;default:false;index"`
-	CreatedAt       time.Time `gorm:"not null;default:now()"`
-	UpdatedAt       time.Time `gorm:"not null;default:now()"`
+	ID                uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Email             string     `gorm:"not null"` // уникальность обеспечим функциональным индексом lower(email)
+	Password          string     `gorm:"not null"` // hash (argon2id/bcrypt)
+	Role              Role       `gorm:"type:text;not null;default:'ROLE_CUSTOMER';index"`
+	IsEmailVerified   bool       `gorm:"not null;default:false;index"`
+	IsDisabled        bool       `gorm:"not null;default:false;index"`
+	MustResetPassword bool       `gorm:"not null;default:false"` // вход запрещён до сброса пароля («это был не я»)
+	IsGuest           bool       `gorm:"not null;default:false"` // анонимный покупатель без email и пароля
+	DeletedAt         *time.Time `gorm:"index"`                  // учётная запись удалена владельцем, персональные данные обезличены
+
+	// Профиль
+	DisplayName        *string
+	Phone              *string    // E.164
+	IsPhoneVerified    bool       `gorm:"not null;default:false"`           // сбрасывается при смене телефона
+	Locale             string     `gorm:"type:text;not null;default:'ru'"`  // BCP 47, язык писем
+	TimeZone           string     `gorm:"type:text;not null;default:'UTC'"` // IANA
+	MarketingConsent   bool       `gorm:"not null;default:false"`
+	MarketingConsentAt *time.Time // когда согласие на рассылки последний раз менялось
+
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+	UpdatedAt time.Time `gorm:"not null;default:now()"`
 }
 
 func (User) TableName() string { return "users" }
@@ -34,6 +48,7 @@ type RefreshToken struct {
 	ClientID   *string    `gorm:"type:text"`
 	IP         *string    `gorm:"type:inet"`
 	UserAgent  *string    `gorm:"type:text"`
+	JKT        *string    `gorm:"column:jkt;type:text"` // отпечаток DPoP-ключа клиента; nil — токен не привязан
 	ExpiresAt  time.Time  `gorm:"not null;index"`
 	Revoked    bool       `gorm:"not null;default:false;index"`
 	CreatedAt  time.Time  `gorm:"not null;default:now()"`
@@ -71,6 +86,21 @@ type EmailVerification struct {
 
 func (EmailVerification) TableName() string { return "email_verifications" }
 
+// PhoneVerification — код подтверждения телефона из SMS. Код короткий, поэтому проверяется
+// только для своего пользователя и с ограниченным числом попыток.
+type PhoneVerification struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Phone     string    `gorm:"not null"`
+	CodeHash  string    `gorm:"not null"`
+	Attempts  int       `gorm:"not null;default:0"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	Consumed  bool      `gorm:"not null;default:false"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (PhoneVerification) TableName() string { return "phone_verifications" }
+
 type PasswordResetToken struct {
 	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
 	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
@@ -95,3 +125,172 @@ type UserSession struct {
 }
 
 func (UserSession) TableName() string { return "user_sessions" }
+
+// TokenWatermark — "водяной знак" отзыва access-токенов пользователя:
+// все токены с iat раньше RevokedBefore считаются недействительными.
+type TokenWatermark struct {
+	UserID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RevokedBefore time.Time `gorm:"not null"`
+	Reason        string    `gorm:"type:text;not null"`
+	UpdatedAt     time.Time `gorm:"not null;default:now()"`
+}
+
+func (TokenWatermark) TableName() string { return "token_watermarks" }
+
+// Permission — элемент справочника прав (например, product:write).
+type Permission struct {
+	Code        string    `gorm:"type:text;primaryKey"`
+	Description string    `gorm:"type:text;not null;default:''"`
+	CreatedAt   time.Time `gorm:"not null;default:now()"`
+}
+
+func (Permission) TableName() string { return "permissions" }
+
+// RolePermission — связь роли с правом; редактируется администраторами.
+type RolePermission struct {
+	Role       Role      `gorm:"type:text;primaryKey"`
+	Permission string    `gorm:"type:text;primaryKey"`
+	CreatedAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (RolePermission) TableName() string { return "role_permissions" }
+
+type VendorApplicationStatus string
+
+const (
+	VendorApplicationPending  VendorApplicationStatus = "PENDING"
+	VendorApplicationApproved VendorApplicationStatus = "APPROVED"
+	VendorApplicationRejected VendorApplicationStatus = "REJECTED"
+)
+
+// VendorApplication — заявка покупателя на статус продавца; рассматривается администратором.
+type VendorApplication struct {
+	ID           uuid.UUID               `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID       uuid.UUID               `gorm:"type:uuid;not null;index"`
+	CompanyName  string                  `gorm:"type:text;not null"`
+	TaxID        string                  `gorm:"type:text;not null"`
+	Website      string                  `gorm:"type:text;not null;default:''"`
+	Phone        string                  `gorm:"type:text;not null;default:''"`
+	Description  string                  `gorm:"type:text;not null;default:''"`
+	Status       VendorApplicationStatus `gorm:"type:text;not null;default:'PENDING'"`
+	RejectReason *string                 `gorm:"type:text"`
+	ReviewedBy   *uuid.UUID              `gorm:"type:uuid"`
+	ReviewedAt   *time.Time
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+	UpdatedAt    time.Time `gorm:"not null;default:now()"`
+}
+
+func (VendorApplication) TableName() string { return "vendor_applications" }
+
+// UserEventOutbox — событие пользователя, записанное в одной транзакции с изменением
+// данных; публикуется в Kafka фоновым relay, пока не будет отправлено.
+type UserEventOutbox struct {
+	ID            uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	EventType     string    `gorm:"type:text;not null"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null"`
+	OccurredAt    time.Time `gorm:"not null"`
+	Attempts      int       `gorm:"not null;default:0"`
+	LastError     *string   `gorm:"type:text"`
+	NextAttemptAt time.Time `gorm:"not null;default:now()"`
+	SentAt        *time.Time
+	RequestID     *string   `gorm:"type:text"` // X-Request-ID запроса, породившего событие
+	CreatedAt     time.Time `gorm:"not null;default:now()"`
+}
+
+func (UserEventOutbox) TableName() string { return "user_event_outbox" }
+
+// APIKey — персональный ключ для программного доступа. Хранится только хэш
+// (как у RefreshToken); права ключа — подмножество прав роли владельца.
+type APIKey struct {
+	ID         uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Name       string     `gorm:"type:text;not null"`
+	Prefix     string     `gorm:"type:text;not null"`
+	KeyHash    string     `gorm:"type:text;not null;uniqueIndex"`
+	Scopes     []string   `gorm:"type:jsonb;serializer:json;not null"`
+	ExpiresAt  *time.Time // nil — бессрочный
+	LastUsedAt *time.Time
+	LastUsedIP *string `gorm:"type:text"`
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (APIKey) TableName() string { return "api_keys" }
+
+// OAuthClient — сторонний resource server, которому разрешены интроспекция и отзыв токенов
+type OAuthClient struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ClientID   string    `gorm:"type:text;not null;uniqueIndex:ux_oauth_clients_client_id"`
+	Name       string    `gorm:"type:text;not null"`
+	SecretHash string    `gorm:"type:text;not null"`
+	LastUsedAt *time.Time
+	CreatedAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (OAuthClient) TableName() string { return "oauth_clients" }
+
+// ImpersonationEvent — журнал входов администраторов от имени пользователей
+type ImpersonationEvent struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ActorID   uuid.UUID `gorm:"type:uuid;not null;index"` // администратор
+	TargetID  uuid.UUID `gorm:"type:uuid;not null;index"` // пользователь, от имени которого выдан токен
+	Reason    string    `gorm:"type:text;not null"`
+	IP        *string   `gorm:"type:text"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (ImpersonationEvent) TableName() string { return "impersonation_events" }
+
+// TrustedDevice — устройство (cid) и сеть, с которых пользователь уже входил.
+// Вход с незнакомого cid или из незнакомой сети вызывает письмо о новом входе.
+type TrustedDevice struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:ux_trusted_devices_user_client_net,priority:1"`
+	ClientID    string    `gorm:"type:text;not null;uniqueIndex:ux_trusted_devices_user_client_net,priority:2"`
+	IPNetwork   string    `gorm:"type:text;not null;uniqueIndex:ux_trusted_devices_user_client_net,priority:3"` // "" — адрес неизвестен
+	UserAgent   *string   `gorm:"type:text"`
+	FirstSeenAt time.Time `gorm:"not null;default:now()"`
+	LastSeenAt  time.Time `gorm:"not null;default:now()"`
+}
+
+func (TrustedDevice) TableName() string { return "trusted_devices" }
+
+// SignInAlert — одноразовая ссылка «это был не я» из письма о новом входе
+type SignInAlert struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	SessionID uuid.UUID `gorm:"type:uuid;not null"`
+	ClientID  string    `gorm:"type:text;not null"`
+	IPNetwork string    `gorm:"type:text;not null"`
+	TokenHash string    `gorm:"type:text;not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (SignInAlert) TableName() string { return "sign_in_alerts" }
+
+// PasswordHistory — хэши прошлых паролей для запрета повторного использования
+type PasswordHistory struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index:idx_password_history_user_created,priority:1"`
+	Hash      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"not null;default:now();index:idx_password_history_user_created,priority:2,sort:desc"`
+}
+
+func (PasswordHistory) TableName() string { return "password_history" }
+
+// CleanupRun — один запуск задачи очистки: сколько строк удалено и сколько он длился
+type CleanupRun struct {
+	ID          uuid.UUID        `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Job         string           `gorm:"type:text;not null;index:idx_cleanup_runs_job_started,priority:1"`
+	Host        string           `gorm:"type:text;not null;default:''"`
+	StartedAt   time.Time        `gorm:"not null;index:idx_cleanup_runs_job_started,priority:2,sort:desc"`
+	DurationMs  int64            `gorm:"not null"`
+	RowsDeleted int64            `gorm:"not null;default:0"`
+	Counts      map[string]int64 `gorm:"type:jsonb;serializer:json;not null"`
+	Error       *string          `gorm:"type:text"`
+}
+
+func (CleanupRun) TableName() string { return "cleanup_runs" }
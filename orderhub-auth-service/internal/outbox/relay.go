@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"context"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInterval  = 5 * time.Second
+	defaultBatchSize = 100
+	baseRetryDelay   = 5 * time.Second
+	maxRetryDelay    = 10 * time.Minute
+)
+
+type Store interface {
+	ListPending(ctx context.Context, now time.Time, limit int) ([]models.UserEventOutbox, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+}
+
+type Publisher interface {
+	PublishUserEvent(ctx context.Context, ev producer.UserEvent) error
+}
+
+// Relay периодически публикует события из outbox. Доставка «хотя бы один раз»:
+// если событие ушло в Kafka, а отметка не записалась, оно будет отправлено повторно,
+// поэтому потребители должны обрабатывать его идемпотентно.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	log       *zap.Logger
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+	stopCh    chan struct{}
+}
+
+func NewRelay(store Store, publisher Publisher, log *zap.Logger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		log:       log,
+		interval:  defaultInterval,
+		batchSize: defaultBatchSize,
+		now:       time.Now,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// Start запускает публикацию в фоне
+func (r *Relay) Start(ctx context.Context) {
+	r.log.Info("starting user event outbox relay")
+	go r.run(ctx)
+}
+
+// Stop останавливает relay
+func (r *Relay) Stop() {
+	r.log.Info("stopping user event outbox relay")
+	close(r.stopCh)
+}
+
+func (r *Relay) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RunOnce(ctx); err != nil {
+			r.log.Error("user event outbox relay failed", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-r.stopCh:
+			r.log.Info("user event outbox relay stopped")
+			return
+		case <-ctx.Done():
+			r.log.Info("user event outbox relay cancelled")
+			return
+		}
+	}
+}
+
+// RunOnce публикует одну пачку готовых событий и возвращает число отправленных.
+// Ошибка публикации не прерывает пачку: событие откладывается с экспоненциальной паузой.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	events, err := r.store.ListPending(ctx, r.now(), r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, e := range events {
+		ev := producer.UserEvent{
+			Type:       e.EventType,
+			UserID:     e.UserID.String(),
+			OccurredAt: e.OccurredAt,
+		}
+		pubCtx := ctx
+		if e.RequestID != nil {
+			pubCtx = correlation.WithIDs(ctx, correlation.IDs{RequestID: *e.RequestID})
+		}
+		if err := r.publisher.PublishUserEvent(pubCtx, ev); err != nil {
+			retryAt := r.now().Add(RetryDelay(e.Attempts + 1))
+			r.log.Warn("failed to publish user event, will retry",
+				zap.String("event_id", e.ID.String()),
+				zap.String("type", e.EventType),
+				zap.Int("attempt", e.Attempts+1),
+				zap.Time("retry_at", retryAt),
+				zap.Error(err))
+			if err := r.store.MarkFailed(ctx, e.ID, err.Error(), retryAt); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := r.store.MarkSent(ctx, e.ID, r.now()); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// RetryDelay — пауза перед попыткой номер attempt: 5s, 10s, 20s… но не больше 10 минут.
+func RetryDelay(attempt int) time.Duration {
+	d := baseRetryDelay
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if d >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return d
+}
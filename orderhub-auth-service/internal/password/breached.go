@@ -0,0 +1,97 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed breached_sha1.txt
+var bundledBreached string
+
+// minPrefixLen — более короткие префиксы отсекали бы заметную долю любых паролей
+const minPrefixLen = 5
+
+// BreachedList — SHA-1 (полные или префиксы) паролей из известных утечек.
+// Проверка локальная: сеть не нужна, список загружается один раз при старте.
+type BreachedList struct {
+	byLen map[int]map[string]struct{}
+	size  int
+}
+
+// LoadBreachedList загружает встроенный список и, если path не пуст, список оператора
+// в формате HIBP ("HASH" или "HASH:COUNT" в строке, # — комментарий).
+func LoadBreachedList(path string) (*BreachedList, error) {
+	l := &BreachedList{byLen: map[int]map[string]struct{}{}}
+	if err := l.read(strings.NewReader(bundledBreached)); err != nil {
+		return nil, fmt.Errorf("bundled breached list: %w", err)
+	}
+	if path == "" {
+		return l, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if err := l.read(f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return l, nil
+}
+
+func (l *BreachedList) read(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	line := 0
+	for sc.Scan() {
+		line++
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+		s, _, _ = strings.Cut(s, ":")
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if len(s) < minPrefixLen || len(s) > sha1.Size*2 || !isHex(s) {
+			return fmt.Errorf("line %d: invalid sha-1 prefix %q", line, s)
+		}
+		set, ok := l.byLen[len(s)]
+		if !ok {
+			set = map[string]struct{}{}
+			l.byLen[len(s)] = set
+		}
+		if _, dup := set[s]; !dup {
+			set[s] = struct{}{}
+			l.size++
+		}
+	}
+	return sc.Err()
+}
+
+// Len — число записей в списке
+func (l *BreachedList) Len() int { return l.size }
+
+// Contains сообщает, совпадает ли SHA-1 пароля с какой-либо записью списка
+func (l *BreachedList) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	h := strings.ToUpper(hex.EncodeToString(sum[:]))
+	for n, set := range l.byLen {
+		if _, ok := set[h[:n]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isHex(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'F') {
+			return false
+		}
+	}
+	return true
+}
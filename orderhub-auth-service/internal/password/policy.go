@@ -0,0 +1,141 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Классы символов, которые может требовать политика
+const (
+	ClassLower  = "lower"
+	ClassUpper  = "upper"
+	ClassLetter = "letter"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+// Коды нарушений — уходят клиенту как тег ошибки поля
+const (
+	CodeMinLength     = "min_length"
+	CodeCharClass     = "char_class"
+	CodeContainsEmail = "contains_email"
+	CodeReused        = "reused"
+	CodeBreached      = "breached"
+)
+
+// Violation — одно нарушение политики паролей
+type Violation struct {
+	Code    string
+	Message string
+}
+
+// Policy — требования к новому паролю. Проверка повторов (History) выполняется
+// сервисом: для неё нужны хэши прошлых паролей.
+type Policy struct {
+	MinLength       int
+	RequiredClasses []string
+	ForbidEmail     bool
+	History         int // сколько последних паролей нельзя использовать повторно; 0 — не проверять
+	Breached        *BreachedList
+}
+
+// ParseClasses разбирает список классов через запятую; неизвестные имена — ошибка
+func ParseClasses(s string) ([]string, error) {
+	var out []string
+	for _, c := range strings.Split(s, ",") {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == "" {
+			continue
+		}
+		switch c {
+		case ClassLower, ClassUpper, ClassLetter, ClassDigit, ClassSymbol:
+			out = append(out, c)
+		default:
+			return nil, fmt.Errorf("unknown password character class %q", c)
+		}
+	}
+	return out, nil
+}
+
+// Check проверяет пароль без учёта истории. Возвращает все нарушения сразу,
+// чтобы клиент показал их одним списком.
+func (p *Policy) Check(password, email string) []Violation {
+	var out []Violation
+	if n := len([]rune(password)); n < p.MinLength {
+		out = append(out, Violation{
+			Code:    CodeMinLength,
+			Message: fmt.Sprintf("must be at least %d characters long", p.MinLength),
+		})
+	}
+	for _, class := range p.RequiredClasses {
+		if !hasClass(password, class) {
+			out = append(out, Violation{Code: CodeCharClass, Message: "must contain " + classTitle(class)})
+		}
+	}
+	if p.ForbidEmail && containsEmail(password, email) {
+		out = append(out, Violation{Code: CodeContainsEmail, Message: "must not contain your email"})
+	}
+	if p.Breached != nil && p.Breached.Contains(password) {
+		out = append(out, Violation{Code: CodeBreached, Message: "appears in a known data breach, choose another one"})
+	}
+	return out
+}
+
+func hasClass(password, class string) bool {
+	for _, r := range password {
+		switch class {
+		case ClassLower:
+			if unicode.IsLower(r) {
+				return true
+			}
+		case ClassUpper:
+			if unicode.IsUpper(r) {
+				return true
+			}
+		case ClassLetter:
+			if unicode.IsLetter(r) {
+				return true
+			}
+		case ClassDigit:
+			if unicode.IsDigit(r) {
+				return true
+			}
+		case ClassSymbol:
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func classTitle(class string) string {
+	switch class {
+	case ClassLower:
+		return "a lowercase letter"
+	case ClassUpper:
+		return "an uppercase letter"
+	case ClassLetter:
+		return "a letter"
+	case ClassDigit:
+		return "a digit"
+	default:
+		return "a symbol"
+	}
+}
+
+// containsEmail — пароль содержит адрес целиком или его локальную часть
+// (короче трёх символов не проверяем: слишком много ложных срабатываний)
+func containsEmail(password, email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false
+	}
+	pw := strings.ToLower(password)
+	if strings.Contains(pw, email) {
+		return true
+	}
+	local, _, _ := strings.Cut(email, "@")
+	return len(local) >= 3 && strings.Contains(pw, local)
+}
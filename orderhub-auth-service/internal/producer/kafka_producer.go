@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -28,6 +30,7 @@ type EmailMessage struct {
 	Subject  string         `json:"subject"`
 	Template string         `json:"template"`
 	Data     map[string]any `json:"data"`
+	Locale   string         `json:"locale,omitempty"` // язык получателя (BCP 47); пусто — язык по умолчанию
 }
 
 func (p *EmailProducer) SendEmail(ctx context.Context, key string, msg EmailMessage) error {
@@ -37,10 +40,28 @@ func (p *EmailProducer) SendEmail(ctx context.Context, key string, msg EmailMess
 	if err != nil {
 		return err
 	}
-	return p.writer.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(key),
-		Value: value,
-	})
+	return write(ctx, p.writer, key, value)
+}
+
+// write публикует сообщение в producer-спане: traceparent спана и x-request-id запроса
+// уходят потребителю в заголовках
+func write(ctx context.Context, w *kafka.Writer, key string, value []byte) error {
+	msg := kafka.Message{Key: []byte(key), Value: value, Headers: correlationHeaders(ctx)}
+	ctx, span := kafkatrace.StartProduce(ctx, w.Topic, &msg)
+	err := w.WriteMessages(ctx, msg)
+	kafkatrace.End(span, err)
+	return err
+}
+
+// correlationHeaders передаёт x-request-id (и traceparent, если трассы в контексте нет)
+// потребителям
+func correlationHeaders(ctx context.Context) []kafka.Header {
+	kv := correlation.Outgoing(ctx)
+	headers := make([]kafka.Header, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		headers = append(headers, kafka.Header{Key: kv[i], Value: []byte(kv[i+1])})
+	}
+	return headers
 }
 
 func (p *EmailProducer) Close() error {
@@ -0,0 +1,46 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// SMSProducer публикует команды на отправку SMS; доставку выполняет notification-service
+type SMSProducer struct {
+	writer *kafka.Writer
+}
+
+func NewSMSProducer(brokers []string, topic string) *SMSProducer {
+	return &SMSProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.LeastBytes{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+type SMSMessage struct {
+	To       string         `json:"to"` // номер в формате E.164
+	Template string         `json:"template"`
+	Data     map[string]any `json:"data"`
+	Locale   string         `json:"locale,omitempty"`
+}
+
+func (p *SMSProducer) SendSMS(ctx context.Context, key string, msg SMSMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return write(ctx, p.writer, key, value)
+}
+
+func (p *SMSProducer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,51 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Типы событий жизненного цикла пользователя
+const (
+	UserEventAccountDeleted = "account_deleted"
+)
+
+type UserEvent struct {
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// UserEventProducer публикует события пользователя для других сервисов
+// (order, inventory обезличивают свои ссылки на удалённые аккаунты).
+type UserEventProducer struct {
+	writer *kafka.Writer
+}
+
+func NewUserEventProducer(brokers []string, topic string) *UserEventProducer {
+	return &UserEventProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{}, // события одного пользователя — в одну партицию
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+func (p *UserEventProducer) PublishUserEvent(ctx context.Context, ev UserEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	value, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return write(ctx, p.writer, ev.UserID, value)
+}
+
+func (p *UserEventProducer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AccountSnapshot — всё, что auth-service хранит о пользователе.
+type AccountSnapshot struct {
+	User               models.User
+	RefreshTokens      []models.RefreshToken
+	Sessions           []models.UserSession
+	EmailVerifications []models.EmailVerification
+	PhoneVerifications []models.PhoneVerification
+	PasswordResets     []models.PasswordResetToken
+	APIKeys            []models.APIKey
+	Impersonations     []models.ImpersonationEvent // входы поддержки от имени пользователя
+	TrustedDevices     []models.TrustedDevice
+	Watermark          *models.TokenWatermark
+}
+
+type AccountRepo interface {
+	Snapshot(ctx context.Context, userID uuid.UUID) (*AccountSnapshot, error)
+	Anonymize(ctx context.Context, userID uuid.UUID, at time.Time, event *models.UserEventOutbox) error
+}
+
+type accountRepo struct{ db *gorm.DB }
+
+func NewAccountRepo(db *gorm.DB) AccountRepo { return &accountRepo{db: db} }
+
+// Snapshot возвращает nil, nil, если пользователя нет.
+func (r *accountRepo) Snapshot(ctx context.Context, userID uuid.UUID) (*AccountSnapshot, error) {
+	db := r.db.WithContext(ctx)
+
+	var snap AccountSnapshot
+	if err := db.Where("id = ?", userID).First(&snap.User).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.RefreshTokens).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.Sessions).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.EmailVerifications).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.PhoneVerifications).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.PasswordResets).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("created_at").Find(&snap.APIKeys).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("target_id = ?", userID).Order("created_at").Find(&snap.Impersonations).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("first_seen_at").Find(&snap.TrustedDevices).Error; err != nil {
+		return nil, err
+	}
+
+	var wm models.TokenWatermark
+	err := db.Where("user_id = ?", userID).First(&wm).Error
+	switch {
+	case err == nil:
+		snap.Watermark = &wm
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+	return &snap, nil
+}
+
+// Anonymize удаляет токены, сессии и коды пользователя и обезличивает строку users.
+// Сама строка остаётся: на её id ссылаются водяной знак отзыва и другие сервисы.
+// Если event не nil, он пишется в outbox в той же транзакции.
+func (r *accountRepo) Anonymize(ctx context.Context, userID uuid.UUID, at time.Time, event *models.UserEventOutbox) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range []any{
+			&models.RefreshToken{},
+			&models.UserSession{},
+			&models.EmailVerification{},
+			&models.PhoneVerification{},
+			&models.PasswordResetToken{},
+			&models.APIKey{},
+			&models.TrustedDevice{},
+			&models.SignInAlert{},
+			&models.PasswordHistory{},
+		} {
+			if err := tx.Where("user_id = ?", userID).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+
+		res := tx.Model(&models.User{}).
+			Where("id = ?", userID).
+			Updates(map[string]any{
+				"email":                fmt.Sprintf("deleted-%s@deleted.invalid", userID),
+				"password":             "",
+				"is_email_verified":    false,
+				"is_disabled":          true,
+				"display_name":         nil,
+				"phone":                nil,
+				"is_phone_verified":    false,
+				"marketing_consent":    false,
+				"marketing_consent_at": nil,
+				"deleted_at":           at,
+				"updated_at":           at,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if event != nil {
+			return tx.Create(event).Error
+		}
+		return nil
+	})
+}
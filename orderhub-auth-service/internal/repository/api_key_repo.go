@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepo interface {
+	Create(ctx context.Context, k *models.APIKey) error
+	// GetByHash возвращает nil, nil, если ключа нет.
+	GetByHash(ctx context.Context, hash string) (*models.APIKey, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error)
+	// Revoke отзывает ключ владельца; false — ключа нет или он уже отозван.
+	Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) (bool, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time, ip *string) error
+}
+
+type apiKeyRepo struct{ db *gorm.DB }
+
+func NewAPIKeyRepo(db *gorm.DB) APIKeyRepo { return &apiKeyRepo{db: db} }
+
+func (r *apiKeyRepo) Create(ctx context.Context, k *models.APIKey) error {
+	return r.db.WithContext(ctx).Create(k).Error
+}
+
+func (r *apiKeyRepo) GetByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	var k models.APIKey
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", hash).First(&k).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *apiKeyRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	var keys []models.APIKey
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+func (r *apiKeyRepo) Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.APIKey{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", at)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *apiKeyRepo) Touch(ctx context.Context, id uuid.UUID, at time.Time, ip *string) error {
+	return r.db.WithContext(ctx).Model(&models.APIKey{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"last_used_at": at, "last_used_ip": ip}).Error
+}
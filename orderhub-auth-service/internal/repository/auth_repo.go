@@ -4,6 +4,7 @@ import (
 	"auth-service/internal/models"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -16,6 +17,16 @@ type UserRepo interface {
 	UpdatePassword(ctx context.Context, user *models.User) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	UpdateIsEmailVerified(ctx context.Context, user *models.User) error
+	UpdateRole(ctx context.Context, id uuid.UUID, role models.Role) error
+	SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	// RequirePasswordReset запрещает вход до смены пароля
+	RequirePasswordReset(ctx context.Context, id uuid.UUID) error
+	// UpgradeGuest превращает гостя в обычного пользователя; false — пользователь не гость.
+	UpgradeGuest(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error)
+	// UpdateProfile сохраняет поля профиля пользователя
+	UpdateProfile(ctx context.Context, user *models.User) error
+	// SetPhoneVerified подтверждает телефон, только если он не менялся с момента отправки кода
+	SetPhoneVerified(ctx context.Context, id uuid.UUID, phone string) (bool, error)
 }
 
 type userRepo struct{ db *gorm.DB }
@@ -52,10 +63,34 @@ func (r *userRepo) UpdatePassword(ctx context.Context, user *models.User) error
 	return r.db.WithContext(ctx).
 		Model(&models.User{}).
 		Where("id = ?", user.ID).
-		Updates(map[string]any{"password": user.Password}).
+		Updates(map[string]any{"password": user.Password, "must_reset_password": false}).
 		Error
 }
 
+func (r *userRepo) UpdateProfile(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND deleted_at IS NULL", user.ID).
+		Updates(map[string]any{
+			"display_name":         user.DisplayName,
+			"phone":                user.Phone,
+			"is_phone_verified":    user.IsPhoneVerified,
+			"locale":               user.Locale,
+			"time_zone":            user.TimeZone,
+			"marketing_consent":    user.MarketingConsent,
+			"marketing_consent_at": user.MarketingConsentAt,
+			"updated_at":           time.Now(),
+		}).Error
+}
+
+func (r *userRepo) SetPhoneVerified(ctx context.Context, id uuid.UUID, phone string) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND phone = ? AND deleted_at IS NULL", id, phone).
+		Updates(map[string]any{"is_phone_verified": true, "updated_at": time.Now()})
+	return res.RowsAffected > 0, res.Error
+}
+
 func (r *userRepo) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
 	err := r.db.WithContext(ctx).Model(&models.User{}).
@@ -71,3 +106,40 @@ func (r *userRepo) UpdateIsEmailVerified(ctx context.Context, user *models.User)
 		Updates(map[string]any{"is_email_verified": user.IsEmailVerified}).
 		Error
 }
+
+func (r *userRepo) UpdateRole(ctx context.Context, id uuid.UUID, role models.Role) error {
+	return r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"role": role}).
+		Error
+}
+
+func (r *userRepo) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	return r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"is_disabled": disabled}).
+		Error
+}
+
+func (r *userRepo) RequirePasswordReset(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"must_reset_password": true}).
+		Error
+}
+
+func (r *userRepo) UpgradeGuest(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ? AND is_guest AND deleted_at IS NULL", id).
+		Updates(map[string]any{
+			"email":      email,
+			"password":   passwordHash,
+			"is_guest":   false,
+			"updated_at": time.Now(),
+		})
+	return res.RowsAffected > 0, res.Error
+}
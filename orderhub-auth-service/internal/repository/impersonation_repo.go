@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type ImpersonationRepo interface {
+	Create(ctx context.Context, e *models.ImpersonationEvent) error
+}
+
+type impersonationRepo struct{ db *gorm.DB }
+
+func NewImpersonationRepo(db *gorm.DB) ImpersonationRepo { return &impersonationRepo{db: db} }
+
+func (r *impersonationRepo) Create(ctx context.Context, e *models.ImpersonationEvent) error {
+	return r.db.WithContext(ctx).Create(e).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OAuthClientRepo interface {
+	Create(ctx context.Context, c *models.OAuthClient) error
+	// GetByClientID возвращает nil, nil, если клиента нет.
+	GetByClientID(ctx context.Context, clientID string) (*models.OAuthClient, error)
+	List(ctx context.Context) ([]models.OAuthClient, error)
+	// Delete удаляет клиента; false — клиента нет.
+	Delete(ctx context.Context, clientID string) (bool, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type oauthClientRepo struct{ db *gorm.DB }
+
+func NewOAuthClientRepo(db *gorm.DB) OAuthClientRepo { return &oauthClientRepo{db: db} }
+
+func (r *oauthClientRepo) Create(ctx context.Context, c *models.OAuthClient) error {
+	return r.db.WithContext(ctx).Create(c).Error
+}
+
+func (r *oauthClientRepo) GetByClientID(ctx context.Context, clientID string) (*models.OAuthClient, error) {
+	var c models.OAuthClient
+	if err := r.db.WithContext(ctx).Where("client_id = ?", clientID).First(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *oauthClientRepo) List(ctx context.Context) ([]models.OAuthClient, error) {
+	var clients []models.OAuthClient
+	err := r.db.WithContext(ctx).Order("created_at DESC").Find(&clients).Error
+	return clients, err
+}
+
+func (r *oauthClientRepo) Delete(ctx context.Context, clientID string) (bool, error) {
+	res := r.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&models.OAuthClient{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *oauthClientRepo) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.OAuthClient{}).
+		Where("id = ?", id).
+		Update("last_used_at", at).Error
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PasswordHistoryRepo interface {
+	// ListRecent — хэши последних n паролей пользователя, новые первыми.
+	ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error)
+	// Add сохраняет хэш и оставляет в истории только keep последних записей.
+	Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error
+}
+
+type passwordHistoryRepo struct{ db *gorm.DB }
+
+func NewPasswordHistoryRepo(db *gorm.DB) PasswordHistoryRepo { return &passwordHistoryRepo{db: db} }
+
+func (r *passwordHistoryRepo) ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error) {
+	var out []string
+	err := r.db.WithContext(ctx).Model(&models.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(n).
+		Pluck("hash", &out).Error
+	return out, err
+}
+
+func (r *passwordHistoryRepo) Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&models.PasswordHistory{UserID: userID, Hash: hash}).Error; err != nil {
+			return err
+		}
+		if keep <= 0 {
+			return nil
+		}
+		return tx.Exec(`
+			DELETE FROM password_history
+			WHERE user_id = ? AND id NOT IN (
+				SELECT id FROM password_history WHERE user_id = ? ORDER BY created_at DESC LIMIT ?
+			)`, userID, userID, keep).Error
+	})
+}
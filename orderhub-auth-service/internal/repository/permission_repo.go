@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PermissionRepo interface {
+	ListAll(ctx context.Context) ([]models.Permission, error)
+	Exists(ctx context.Context, code string) (bool, error)
+	ListByRole(ctx context.Context, role models.Role) ([]string, error)
+	Grant(ctx context.Context, role models.Role, code string) error
+	Revoke(ctx context.Context, role models.Role, code string) (bool, error)
+}
+
+type permissionRepo struct{ db *gorm.DB }
+
+func NewPermissionRepo(db *gorm.DB) PermissionRepo { return &permissionRepo{db: db} }
+
+func (r *permissionRepo) ListAll(ctx context.Context) ([]models.Permission, error) {
+	var list []models.Permission
+	err := r.db.WithContext(ctx).Order("code").Find(&list).Error
+	return list, err
+}
+
+func (r *permissionRepo) Exists(ctx context.Context, code string) (bool, error) {
+	var cnt int64
+	err := r.db.WithContext(ctx).Model(&models.Permission{}).
+		Where("code = ?", code).
+		Count(&cnt).Error
+	return cnt > 0, err
+}
+
+func (r *permissionRepo) ListByRole(ctx context.Context, role models.Role) ([]string, error) {
+	perms := []string{}
+	err := r.db.WithContext(ctx).Model(&models.RolePermission{}).
+		Where("role = ?", role).
+		Order("permission").
+		Pluck("permission", &perms).Error
+	return perms, err
+}
+
+func (r *permissionRepo) Grant(ctx context.Context, role models.Role, code string) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.RolePermission{Role: role, Permission: code}).Error
+}
+
+func (r *permissionRepo) Revoke(ctx context.Context, role models.Role, code string) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Where("role = ? AND permission = ?", role, code).
+		Delete(&models.RolePermission{})
+	return res.RowsAffected > 0, res.Error
+}
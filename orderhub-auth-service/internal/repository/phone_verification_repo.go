@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PhoneVerificationRepo interface {
+	Create(ctx context.Context, v *models.PhoneVerification) error
+	// FindLatestByUser возвращает nil, nil, если кодов не запрашивали.
+	FindLatestByUser(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error)
+	// RegisterAttempt засчитывает попытку ввода кода; false — попытки исчерпаны или код уже использован.
+	RegisterAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error)
+	Consume(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type phoneVerificationRepo struct{ db *gorm.DB }
+
+func NewPhoneVerificationRepo(db *gorm.DB) PhoneVerificationRepo {
+	return &phoneVerificationRepo{db: db}
+}
+
+func (r *phoneVerificationRepo) Create(ctx context.Context, v *models.PhoneVerification) error {
+	return r.db.WithContext(ctx).Create(v).Error
+}
+
+func (r *phoneVerificationRepo) FindLatestByUser(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error) {
+	var v models.PhoneVerification
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at desc").First(&v).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *phoneVerificationRepo) RegisterAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&models.PhoneVerification{}).
+		Where("id = ? AND consumed = false AND attempts < ?", id, maxAttempts).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *phoneVerificationRepo) Consume(ctx context.Context, id uuid.UUID) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Model(&models.PhoneVerification{}).
+		Where("id = ? AND consumed = false", id).
+		Update("consumed", true)
+	return res.RowsAffected > 0, res.Error
+}
@@ -10,6 +10,18 @@ type Repository struct {
 	EmailVerification EmailVerificationRepo
 	JWKs              JWKRepo
 	Session           SessionRepo
+	Watermarks        WatermarkRepo
+	Permissions       PermissionRepo
+	Accounts          AccountRepo
+	VendorApps        VendorApplicationRepo
+	UserEventOutbox   UserEventOutboxRepo
+	APIKeys           APIKeyRepo
+	Impersonations    ImpersonationRepo
+	TrustedDevices    TrustedDeviceRepo
+	SignInAlerts      SignInAlertRepo
+	PasswordHistory   PasswordHistoryRepo
+	OAuthClients      OAuthClientRepo
+	PhoneVerification PhoneVerificationRepo
 }
 
 func buildRepository(db *gorm.DB) *Repository {
@@ -21,6 +33,18 @@ func buildRepository(db *gorm.DB) *Repository {
 		EmailVerification: NewEmailVerificationRepo(db),
 		JWKs:              NewJWKRepo(db),
 		Session:           NewSessionRepo(db),
+		Watermarks:        NewWatermarkRepo(db),
+		Permissions:       NewPermissionRepo(db),
+		Accounts:          NewAccountRepo(db),
+		VendorApps:        NewVendorApplicationRepo(db),
+		UserEventOutbox:   NewUserEventOutboxRepo(db),
+		APIKeys:           NewAPIKeyRepo(db),
+		Impersonations:    NewImpersonationRepo(db),
+		TrustedDevices:    NewTrustedDeviceRepo(db),
+		SignInAlerts:      NewSignInAlertRepo(db),
+		PasswordHistory:   NewPasswordHistoryRepo(db),
+		OAuthClients:      NewOAuthClientRepo(db),
+		PhoneVerification: NewPhoneVerificationRepo(db),
 	}
 }
 
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DeviceMatch — что из параметров входа уже встречалось у пользователя
+type DeviceMatch struct {
+	Any     bool // у пользователя есть хотя бы одно устройство
+	Client  bool // cid уже встречался
+	Network bool // сеть уже встречалась
+}
+
+type TrustedDeviceRepo interface {
+	Match(ctx context.Context, userID uuid.UUID, clientID, network string) (DeviceMatch, error)
+	// Upsert добавляет пару (cid, сеть) или обновляет last_seen_at и user agent.
+	Upsert(ctx context.Context, d *models.TrustedDevice) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.TrustedDevice, error)
+	// Delete удаляет устройство владельца; false — устройства нет.
+	Delete(ctx context.Context, id, userID uuid.UUID) (bool, error)
+	DeleteByClient(ctx context.Context, userID uuid.UUID, clientID string) (int64, error)
+}
+
+type trustedDeviceRepo struct{ db *gorm.DB }
+
+func NewTrustedDeviceRepo(db *gorm.DB) TrustedDeviceRepo { return &trustedDeviceRepo{db: db} }
+
+func (r *trustedDeviceRepo) Match(ctx context.Context, userID uuid.UUID, clientID, network string) (DeviceMatch, error) {
+	var row struct {
+		Total   int64
+		Client  int64
+		Network int64
+	}
+	err := r.db.WithContext(ctx).Model(&models.TrustedDevice{}).
+		Select("count(*) AS total, "+
+			"count(*) FILTER (WHERE client_id = ?) AS client, "+
+			"count(*) FILTER (WHERE ip_network = ?) AS network", clientID, network).
+		Where("user_id = ?", userID).
+		Scan(&row).Error
+	if err != nil {
+		return DeviceMatch{}, err
+	}
+	return DeviceMatch{Any: row.Total > 0, Client: row.Client > 0, Network: row.Network > 0}, nil
+}
+
+func (r *trustedDeviceRepo) Upsert(ctx context.Context, d *models.TrustedDevice) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "client_id"}, {Name: "ip_network"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_seen_at", "user_agent"}),
+	}).Create(d).Error
+}
+
+func (r *trustedDeviceRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.TrustedDevice, error) {
+	var out []models.TrustedDevice
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("last_seen_at DESC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *trustedDeviceRepo) Delete(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	res := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).Delete(&models.TrustedDevice{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *trustedDeviceRepo) DeleteByClient(ctx context.Context, userID uuid.UUID, clientID string) (int64, error) {
+	res := r.db.WithContext(ctx).Where("user_id = ? AND client_id = ?", userID, clientID).Delete(&models.TrustedDevice{})
+	return res.RowsAffected, res.Error
+}
+
+type SignInAlertRepo interface {
+	Create(ctx context.Context, a *models.SignInAlert) error
+	// GetValidByHash возвращает nil, nil, если ссылки нет, она использована или истекла.
+	GetValidByHash(ctx context.Context, hash string, now time.Time) (*models.SignInAlert, error)
+	// MarkUsed помечает ссылку использованной; false — её уже использовали.
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+}
+
+type signInAlertRepo struct{ db *gorm.DB }
+
+func NewSignInAlertRepo(db *gorm.DB) SignInAlertRepo { return &signInAlertRepo{db: db} }
+
+func (r *signInAlertRepo) Create(ctx context.Context, a *models.SignInAlert) error {
+	return r.db.WithContext(ctx).Create(a).Error
+}
+
+func (r *signInAlertRepo) GetValidByHash(ctx context.Context, hash string, now time.Time) (*models.SignInAlert, error) {
+	var a models.SignInAlert
+	err := r.db.WithContext(ctx).
+		Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", hash, now).
+		First(&a).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *signInAlertRepo) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.SignInAlert{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", at)
+	return res.RowsAffected > 0, res.Error
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type UserEventOutboxRepo interface {
+	// ListPending возвращает неотправленные события, срок повтора которых наступил.
+	ListPending(ctx context.Context, now time.Time, limit int) ([]models.UserEventOutbox, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error
+}
+
+type userEventOutboxRepo struct{ db *gorm.DB }
+
+func NewUserEventOutboxRepo(db *gorm.DB) UserEventOutboxRepo {
+	return &userEventOutboxRepo{db: db}
+}
+
+func (r *userEventOutboxRepo) ListPending(ctx context.Context, now time.Time, limit int) ([]models.UserEventOutbox, error) {
+	var events []models.UserEventOutbox
+	err := r.db.WithContext(ctx).
+		Where("sent_at IS NULL AND next_attempt_at <= ?", now).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *userEventOutboxRepo) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.UserEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"sent_at": at, "last_error": nil}).Error
+}
+
+func (r *userEventOutboxRepo) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.UserEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      reason,
+			"next_attempt_at": retryAt,
+		}).Error
+}
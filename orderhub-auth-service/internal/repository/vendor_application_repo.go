@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VendorApplicationRepo interface {
+	Create(ctx context.Context, a *models.VendorApplication) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error)
+	HasPendingByUser(ctx context.Context, userID uuid.UUID) (bool, error)
+	List(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error)
+	// Review переводит заявку из PENDING в итоговый статус; false — заявка уже рассмотрена.
+	Review(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error)
+}
+
+type vendorApplicationRepo struct{ db *gorm.DB }
+
+func NewVendorApplicationRepo(db *gorm.DB) VendorApplicationRepo {
+	return &vendorApplicationRepo{db: db}
+}
+
+func (r *vendorApplicationRepo) Create(ctx context.Context, a *models.VendorApplication) error {
+	return r.db.WithContext(ctx).Create(a).Error
+}
+
+func (r *vendorApplicationRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+	var a models.VendorApplication
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&a).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *vendorApplicationRepo) HasPendingByUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.VendorApplication{}).
+		Where("user_id = ? AND status = ?", userID, models.VendorApplicationPending).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// List — пустой status означает «все заявки». Сортировка: сначала старые.
+func (r *vendorApplicationRepo) List(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error) {
+	q := r.db.WithContext(ctx).Model(&models.VendorApplication{})
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var out []models.VendorApplication
+	if err := q.Order("created_at ASC").Limit(limit).Offset(offset).Find(&out).Error; err != nil {
+		return nil, 0, err
+	}
+	return out, total, nil
+}
+
+func (r *vendorApplicationRepo) Review(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&models.VendorApplication{}).
+		Where("id = ? AND status = ?", id, models.VendorApplicationPending).
+		Updates(map[string]any{
+			"status":        status,
+			"reject_reason": reason,
+			"reviewed_by":   reviewer,
+			"reviewed_at":   at,
+		})
+	return res.RowsAffected > 0, res.Error
+}
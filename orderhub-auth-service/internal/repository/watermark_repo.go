@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WatermarkRepo interface {
+	Bump(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error
+	Get(ctx context.Context, userID uuid.UUID) (*models.TokenWatermark, error)
+}
+
+type watermarkRepo struct{ db *gorm.DB }
+
+func NewWatermarkRepo(db *gorm.DB) WatermarkRepo { return &watermarkRepo{db: db} }
+
+// Bump сдвигает водяной знак вперёд; более ранняя отметка не перетирает более позднюю.
+func (r *watermarkRepo) Bump(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error {
+	wm := models.TokenWatermark{
+		UserID:        userID,
+		RevokedBefore: at,
+		Reason:        reason,
+		UpdatedAt:     at,
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "revoked_before"}, Value: gorm.Expr("GREATEST(token_watermarks.revoked_before, EXCLUDED.revoked_before)")},
+			{Column: clause.Column{Name: "reason"}, Value: gorm.Expr("EXCLUDED.reason")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("now()")},
+		},
+	}).Create(&wm).Error
+}
+
+func (r *watermarkRepo) Get(ctx context.Context, userID uuid.UUID) (*models.TokenWatermark, error) {
+	var wm models.TokenWatermark
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&wm).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &wm, nil
+}
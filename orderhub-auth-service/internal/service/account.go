@@ -0,0 +1,308 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/google/uuid"
+)
+
+// SetAccountRepo подключает хранилище для удаления и выгрузки данных аккаунта
+func (s *AuthService) SetAccountRepo(accounts AccountRepo) {
+	s.accounts = accounts
+}
+
+func (s *AuthService) currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok || userID == uuid.Nil {
+		return uuid.Nil, ErrUnauthenticated
+	}
+	if s.accounts == nil {
+		return uuid.Nil, errors.New("account repo is not configured")
+	}
+	return userID, nil
+}
+
+// DeleteAccount удаляет учётную запись текущего пользователя после проверки пароля:
+// отзывает все токены и сессии, удаляет связанные записи и обезличивает users.
+// Событие account_deleted пишется в outbox в той же транзакции и публикуется relay.
+func (s *AuthService) DeleteAccount(ctx context.Context, password string) error {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := denyImpersonated(ctx); err != nil {
+		return err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.DeletedAt != nil {
+		return ErrNotFound
+	}
+	if !s.hasher.Compare(user.Password, password) {
+		return ErrInvalidCredentials
+	}
+
+	now := s.now()
+	// водяной знак сдвигаем до обезличивания: строка users остаётся, и уже выданные
+	// access-токены перестают приниматься сразу
+	if err := s.revokeAccessTokens(ctx, userID, RevokeReasonDeleted); err != nil {
+		return err
+	}
+	event := &models.UserEventOutbox{
+		EventType:  producer.UserEventAccountDeleted,
+		UserID:     userID,
+		OccurredAt: now,
+		RequestID:  ptrNonEmpty(correlation.FromContext(ctx).RequestID),
+	}
+	return s.accounts.Anonymize(ctx, userID, now, event)
+}
+
+// Формат выгрузки персональных данных. Хэши паролей, токенов, API-ключей и кодов не выгружаются.
+type dataExport struct {
+	GeneratedAt        time.Time                 `json:"generated_at"`
+	User               exportUser                `json:"user"`
+	Sessions           []exportSession           `json:"sessions"`
+	RefreshTokens      []exportRefreshToken      `json:"refresh_tokens"`
+	EmailVerifications []exportEmailVerification `json:"email_verifications"`
+	PhoneVerifications []exportPhoneVerification `json:"phone_verifications"`
+	PasswordResets     []exportPasswordReset     `json:"password_resets"`
+	APIKeys            []exportAPIKey            `json:"api_keys"`
+	Impersonations     []exportImpersonation     `json:"impersonations"`
+	TrustedDevices     []exportTrustedDevice     `json:"trusted_devices"`
+	TokensRevokedAt    *time.Time                `json:"tokens_revoked_before,omitempty"`
+}
+
+type exportUser struct {
+	ID                 string     `json:"id"`
+	Email              string     `json:"email"`
+	Role               string     `json:"role"`
+	IsEmailVerified    bool       `json:"is_email_verified"`
+	IsDisabled         bool       `json:"is_disabled"`
+	DisplayName        *string    `json:"display_name,omitempty"`
+	Phone              *string    `json:"phone,omitempty"`
+	IsPhoneVerified    bool       `json:"is_phone_verified"`
+	Locale             string     `json:"locale"`
+	TimeZone           string     `json:"time_zone"`
+	MarketingConsent   bool       `json:"marketing_consent"`
+	MarketingConsentAt *time.Time `json:"marketing_consent_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type exportSession struct {
+	ID         string    `json:"id"`
+	ClientID   string    `json:"client_id,omitempty"`
+	IP         *string   `json:"ip,omitempty"`
+	UserAgent  *string   `json:"user_agent,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Revoked    bool      `json:"revoked"`
+}
+
+type exportRefreshToken struct {
+	ID         string     `json:"id"`
+	SessionID  *string    `json:"session_id,omitempty"`
+	ClientID   *string    `json:"client_id,omitempty"`
+	IP         *string    `json:"ip,omitempty"`
+	UserAgent  *string    `json:"user_agent,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Revoked    bool       `json:"revoked"`
+}
+
+type exportEmailVerification struct {
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Consumed  bool      `json:"consumed"`
+}
+
+type exportPhoneVerification struct {
+	Phone     string    `json:"phone"`
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Consumed  bool      `json:"consumed"`
+}
+
+type exportPasswordReset struct {
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Consumed  bool      `json:"consumed"`
+}
+
+type exportAPIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP *string    `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// exportImpersonation — вход поддержки от имени пользователя (без ID сотрудника)
+type exportImpersonation struct {
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type exportTrustedDevice struct {
+	ClientID    string    `json:"client_id"`
+	IPNetwork   string    `json:"ip_network,omitempty"`
+	UserAgent   *string   `json:"user_agent,omitempty"`
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
+// ExportMyData собирает всё, что auth-service хранит о текущем пользователе, в JSON.
+func (s *AuthService) ExportMyData(ctx context.Context) ([]byte, time.Time, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	snap, err := s.accounts.Snapshot(ctx, userID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if snap == nil || snap.User.DeletedAt != nil {
+		return nil, time.Time{}, ErrNotFound
+	}
+
+	now := s.now()
+	out := dataExport{
+		GeneratedAt: now,
+		User: exportUser{
+			ID:                 snap.User.ID.String(),
+			Email:              snap.User.Email,
+			Role:               string(snap.User.Role),
+			IsEmailVerified:    snap.User.IsEmailVerified,
+			IsDisabled:         snap.User.IsDisabled,
+			DisplayName:        snap.User.DisplayName,
+			Phone:              snap.User.Phone,
+			IsPhoneVerified:    snap.User.IsPhoneVerified,
+			Locale:             snap.User.Locale,
+			TimeZone:           snap.User.TimeZone,
+			MarketingConsent:   snap.User.MarketingConsent,
+			MarketingConsentAt: snap.User.MarketingConsentAt,
+			CreatedAt:          snap.User.CreatedAt,
+			UpdatedAt:          snap.User.UpdatedAt,
+		},
+		Sessions:           make([]exportSession, 0, len(snap.Sessions)),
+		RefreshTokens:      make([]exportRefreshToken, 0, len(snap.RefreshTokens)),
+		EmailVerifications: make([]exportEmailVerification, 0, len(snap.EmailVerifications)),
+		PhoneVerifications: make([]exportPhoneVerification, 0, len(snap.PhoneVerifications)),
+		PasswordResets:     make([]exportPasswordReset, 0, len(snap.PasswordResets)),
+		APIKeys:            make([]exportAPIKey, 0, len(snap.APIKeys)),
+		Impersonations:     make([]exportImpersonation, 0, len(snap.Impersonations)),
+		TrustedDevices:     make([]exportTrustedDevice, 0, len(snap.TrustedDevices)),
+	}
+	for _, ss := range snap.Sessions {
+		out.Sessions = append(out.Sessions, exportSession{
+			ID:         ss.ID.String(),
+			ClientID:   ss.ClientID,
+			IP:         ss.IP,
+			UserAgent:  ss.UserAgent,
+			CreatedAt:  ss.CreatedAt,
+			LastSeenAt: ss.LastSeenAt,
+			Revoked:    ss.Revoked,
+		})
+	}
+	for _, rt := range snap.RefreshTokens {
+		var sessionID *string
+		if rt.SessionID != nil {
+			v := rt.SessionID.String()
+			sessionID = &v
+		}
+		out.RefreshTokens = append(out.RefreshTokens, exportRefreshToken{
+			ID:         rt.ID.String(),
+			SessionID:  sessionID,
+			ClientID:   rt.ClientID,
+			IP:         rt.IP,
+			UserAgent:  rt.UserAgent,
+			CreatedAt:  rt.CreatedAt,
+			ExpiresAt:  rt.ExpiresAt,
+			LastUsedAt: rt.LastUsedAt,
+			Revoked:    rt.Revoked,
+		})
+	}
+	for _, ev := range snap.EmailVerifications {
+		out.EmailVerifications = append(out.EmailVerifications, exportEmailVerification{
+			Email:     ev.Email,
+			CreatedAt: ev.CreatedAt,
+			ExpiresAt: ev.ExpiresAt,
+			Consumed:  ev.Consumed,
+		})
+	}
+	for _, pv := range snap.PhoneVerifications {
+		out.PhoneVerifications = append(out.PhoneVerifications, exportPhoneVerification{
+			Phone:     pv.Phone,
+			Attempts:  pv.Attempts,
+			CreatedAt: pv.CreatedAt,
+			ExpiresAt: pv.ExpiresAt,
+			Consumed:  pv.Consumed,
+		})
+	}
+	for _, pr := range snap.PasswordResets {
+		out.PasswordResets = append(out.PasswordResets, exportPasswordReset{
+			Email:     pr.Email,
+			CreatedAt: pr.CreatedAt,
+			ExpiresAt: pr.ExpiresAt,
+			Consumed:  pr.Consumed,
+		})
+	}
+	for _, k := range snap.APIKeys {
+		out.APIKeys = append(out.APIKeys, exportAPIKey{
+			ID:         k.ID.String(),
+			Name:       k.Name,
+			Prefix:     k.Prefix,
+			Scopes:     k.Scopes,
+			CreatedAt:  k.CreatedAt,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			LastUsedIP: k.LastUsedIP,
+			RevokedAt:  k.RevokedAt,
+		})
+	}
+	for _, ie := range snap.Impersonations {
+		out.Impersonations = append(out.Impersonations, exportImpersonation{
+			Reason:    ie.Reason,
+			CreatedAt: ie.CreatedAt,
+			ExpiresAt: ie.ExpiresAt,
+		})
+	}
+	for _, d := range snap.TrustedDevices {
+		out.TrustedDevices = append(out.TrustedDevices, exportTrustedDevice{
+			ClientID:    d.ClientID,
+			IPNetwork:   d.IPNetwork,
+			UserAgent:   d.UserAgent,
+			FirstSeenAt: d.FirstSeenAt,
+			LastSeenAt:  d.LastSeenAt,
+		})
+	}
+	if snap.Watermark != nil {
+		at := snap.Watermark.RevokedBefore
+		out.TokensRevokedAt = &at
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return data, now, nil
+}
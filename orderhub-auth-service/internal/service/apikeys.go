@@ -0,0 +1,175 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/util"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// apiKeyDisplayLen — сколько первых символов ключа хранится открыто для списка ключей
+const apiKeyDisplayLen = 12
+
+// APIKeyIdentity — результат проверки API-ключа
+type APIKeyIdentity struct {
+	KeyID     uuid.UUID
+	UserID    uuid.UUID
+	Role      models.Role
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// SetAPIKeyRepo подключает хранилище персональных API-ключей
+func (s *AuthService) SetAPIKeyRepo(keys APIKeyRepo) {
+	s.apiKeys = keys
+}
+
+// sessionUserID — пользователь, вошедший по паролю. Управлять ключами по самому
+// API-ключу нельзя: утёкший ключ не должен позволять выпустить себе новый.
+func (s *AuthService) sessionUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, ErrUnauthenticated
+	}
+	if _, viaKey := APIKeyIDFromContext(ctx); viaKey {
+		return uuid.Nil, ErrForbidden
+	}
+	// ключ, выпущенный поддержкой, пережил бы короткий токен имперсонации
+	if err := denyImpersonated(ctx); err != nil {
+		return uuid.Nil, err
+	}
+	if s.apiKeys == nil {
+		return uuid.Nil, errors.New("api keys are not configured")
+	}
+	return userID, nil
+}
+
+// CreateAPIKey выпускает ключ текущему пользователю. Права ключа не могут быть
+// шире прав, с которыми пользователь пришёл. Открытое значение возвращается
+// только здесь; в БД остаётся хэш.
+func (s *AuthService) CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*models.APIKey, string, error) {
+	userID, err := s.sessionUserID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := s.now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrInvalidExpiry
+	}
+	granted := authz.PermissionsFromContext(ctx)
+	for _, sc := range scopes {
+		if !slices.Contains(granted, sc) {
+			return nil, "", ErrScopeNotGranted
+		}
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+	secret := apikey.Prefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	key := &models.APIKey{
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		Prefix:    secret[:apiKeyDisplayLen],
+		KeyHash:   util.Sha256Base64URL(secret),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := s.apiKeys.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+func (s *AuthService) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	userID, err := s.sessionUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.apiKeys.ListByUser(ctx, userID)
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	userID, err := s.sessionUserID(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := s.apiKeys.Revoke(ctx, id, userID, s.now())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// ResolveAPIKey проверяет ключ и возвращает владельца. Права ключа урезаются до
+// текущих прав роли, так что понижение роли сразу сужает и ключи. Отметка
+// last_used обновляется на каждой проверке; вызывающие кэшируют результат ненадолго.
+func (s *AuthService) ResolveAPIKey(ctx context.Context, secret, ip string) (*APIKeyIdentity, error) {
+	if s.apiKeys == nil {
+		return nil, errors.New("api keys are not configured")
+	}
+	if !strings.HasPrefix(secret, apikey.Prefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.apiKeys.GetByHash(ctx, util.Sha256Base64URL(secret))
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	if key == nil || key.RevokedAt != nil || (key.ExpiresAt != nil && !key.ExpiresAt.After(now)) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	user, err := s.users.GetByID(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.IsDisabled || user.DeletedAt != nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	scopes := key.Scopes
+	if s.permissions != nil {
+		rolePerms, err := s.permissions.ListByRole(ctx, user.Role)
+		if err != nil {
+			return nil, err
+		}
+		scopes = slices.DeleteFunc(slices.Clone(key.Scopes), func(sc string) bool {
+			return !slices.Contains(rolePerms, sc)
+		})
+	}
+
+	var ipPtr *string
+	if ip != "" {
+		ipPtr = &ip
+	}
+	if err := s.apiKeys.Touch(ctx, key.ID, now, ipPtr); err != nil {
+		s.logger(ctx).Warn("failed to update api key last_used_at", zap.String("key_id", key.ID.String()), zap.Error(err))
+	}
+
+	return &APIKeyIdentity{
+		KeyID:     key.ID,
+		UserID:    user.ID,
+		Role:      user.Role,
+		Scopes:    scopes,
+		ExpiresAt: key.ExpiresAt,
+	}, nil
+}
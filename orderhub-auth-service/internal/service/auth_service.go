@@ -1,7 +1,9 @@
 package service
 
 import (
+	"auth-service/internal/metrics"
 	"auth-service/internal/models"
+	"auth-service/internal/password"
 	"auth-service/internal/producer"
 	"auth-service/internal/util"
 	"context"
@@ -9,22 +11,40 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
 	"github.com/google/uuid"
 	"github.com/nanorand/nanorand"
 	"go.uber.org/zap"
 )
 
 type AuthService struct {
-	users             UserRepo
-	refresh           RefreshRepo
-	jwks              JWKRepo // может быть nil при HS256
-	hasher            PasswordHasher
-	tokens            TokenProvider
-	sessions          SessionRepo
-	passwordReset     PasswordResetRepo
-	emailVerification EmailVerificationRepo
-	cache             CacheClient
-	emailProducer     EmailProducer
+	users              UserRepo
+	refresh            RefreshRepo
+	jwks               JWKRepo // может быть nil при HS256
+	hasher             PasswordHasher
+	tokens             TokenProvider
+	sessions           SessionRepo
+	passwordReset      PasswordResetRepo
+	emailVerification  EmailVerificationRepo
+	cache              CacheClient
+	emailProducer      EmailProducer
+	revoker            TokenRevoker          // может быть nil
+	permissions        PermissionRepo        // может быть nil
+	accounts           AccountRepo           // может быть nil
+	vendorApps         VendorApplicationRepo // может быть nil
+	apiKeys            APIKeyRepo            // может быть nil
+	impersonations     ImpersonationRepo     // может быть nil
+	devices            TrustedDeviceRepo     // может быть nil
+	signInAlerts       SignInAlertRepo       // может быть nil
+	passwordPolicy     *password.Policy      // может быть nil — проверяет только proto-валидация
+	passwordHistory    PasswordHistoryRepo   // может быть nil
+	appURL             string                // адрес веб-приложения для ссылок в письмах
+	oauthClients       OAuthClientRepo       // может быть nil — интроспекция и отзыв выключены
+	firstPartyClientID string
+	phoneVerification  PhoneVerificationRepo // может быть nil — подтверждение телефона выключено
+	smsProducer        SMSProducer
+	dpopStore          DPoPStore // nil — DPoP-доказательства не принимаются
+	dpopRequireNonce   bool
 
 	accessTTL  time.Duration
 	refreshTTL time.Duration
@@ -37,6 +57,7 @@ type ClientMeta struct {
 	ClientID  *string
 	IP        *string
 	UserAgent *string
+	DPoP      *DPoPProof // nil — клиент не использует DPoP
 }
 
 func NewAuthService(
@@ -64,6 +85,7 @@ func NewAuthService(
 		emailVerification: emailVerification,
 		cache:             cache,
 		emailProducer:     emailProducer,
+		appURL:            defaultAppURL,
 
 		accessTTL:  accessTTL,
 		refreshTTL: refreshTTL,
@@ -72,7 +94,31 @@ func NewAuthService(
 	}
 }
 
+// logger добавляет к строкам лога request_id и trace_id запроса
+func (s *AuthService) logger(ctx context.Context) *zap.Logger {
+	return correlation.Logger(ctx, s.log)
+}
+
+// Причины сдвига водяного знака отзыва access-токенов
+const (
+	RevokeReasonPasswordChange = "password_change"
+	RevokeReasonLogoutAll      = "logout_all"
+	RevokeReasonRoleChange     = "role_change"
+	RevokeReasonDisabled       = "account_disabled"
+	RevokeReasonDeleted        = "account_deleted"
+	RevokeReasonCompromised    = "sign_in_reported" // пользователь отметил вход как чужой
+)
+
+// SetTokenRevoker устанавливает хранилище водяных знаков отзыва (опционально)
+func (s *AuthService) SetTokenRevoker(revoker TokenRevoker) {
+	s.revoker = revoker
+}
+
 func (s *AuthService) Register(ctx context.Context, email, password, role string) (*models.User, error) {
+	if err := s.checkPassword(ctx, nil, email, password); err != nil {
+		return nil, err
+	}
+
 	exists, err := s.users.ExistsByEmail(ctx, email)
 	if err != nil {
 		return nil, err
@@ -97,10 +143,21 @@ func (s *AuthService) Register(ctx context.Context, email, password, role string
 	if err := s.users.Create(ctx, u); err != nil {
 		return nil, err
 	}
+	s.rememberPassword(ctx, u.ID, hash)
+
+	if err := s.sendVerificationEmail(ctx, u); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
 
+// sendVerificationEmail создаёт код подтверждения email и отправляет письмо.
+// Ошибка Kafka только логируется: письмо можно запросить повторно.
+func (s *AuthService) sendVerificationEmail(ctx context.Context, u *models.User) error {
 	rng, err := nanorand.Gen(10)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	codeHash := util.Sha256Base64URL(rng)
@@ -113,24 +170,29 @@ func (s *AuthService) Register(ctx context.Context, email, password, role string
 	}
 
 	if err := s.emailVerification.Create(ctx, &emailVer); err != nil {
-		return nil, err
+		return err
 	}
 
-	if err := s.emailProducer.SendEmail(ctx, email, producer.EmailMessage{
-		To:       email,
+	if err := s.emailProducer.SendEmail(ctx, u.Email, personalize(u, producer.EmailMessage{
+		To:       u.Email,
 		Subject:  "Подтвердите email",
 		Template: "verify_email",
 		Data: map[string]any{
-			"ConfirmURL": "https://app/confirm?token=" + rng,
+			"ConfirmURL": s.appURL + "/confirm?token=" + rng,
 		},
-	}); err != nil {
-		s.log.Warn("Couldn't send email via Kafka", zap.Error(err))
+	})); err != nil {
+		s.logger(ctx).Warn("Couldn't send email via Kafka", zap.Error(err))
 	}
-
-	return u, nil
+	return nil
 }
 
 func (s *AuthService) Login(ctx context.Context, email, password string, meta ClientMeta) (uuid.UUID, string, TokenPair, error) {
+	userID, role, pair, err := s.login(ctx, email, password, meta)
+	metrics.Logins.WithLabelValues(loginOutcome(err)).Inc()
+	return userID, role, pair, err
+}
+
+func (s *AuthService) login(ctx context.Context, email, password string, meta ClientMeta) (uuid.UUID, string, TokenPair, error) {
 	user, err := s.users.GetByEmail(ctx, email)
 	if err != nil {
 		return uuid.Nil, "", TokenPair{}, ErrNotFound
@@ -139,15 +201,39 @@ func (s *AuthService) Login(ctx context.Context, email, password string, meta Cl
 	if user == nil || !s.hasher.Compare(user.Password, password) {
 		return uuid.Nil, "", TokenPair{}, ErrInvalidCredentials
 	}
+	if user.IsDisabled {
+		return uuid.Nil, "", TokenPair{}, ErrAccountDisabled
+	}
+	if user.MustResetPassword {
+		return uuid.Nil, "", TokenPair{}, ErrPasswordResetRequired
+	}
 
-	access, aexp, err := s.tokens.SignAccess(ctx, user.ID, string(user.Role), s.accessTTL)
+	session, pair, err := s.startSession(ctx, user, meta)
 	if err != nil {
 		return uuid.Nil, "", TokenPair{}, err
 	}
+	s.noteSignIn(ctx, user, session, meta)
+
+	return user.ID, string(user.Role), pair, nil
+}
+
+// startSession выпускает пару токенов и заводит сессию с refresh-токеном
+func (s *AuthService) startSession(ctx context.Context, user *models.User, meta ClientMeta) (*models.UserSession, TokenPair, error) {
+	var jkt string
+	if meta.DPoP != nil {
+		var err error
+		if jkt, err = s.verifyDPoP(ctx, meta.DPoP, ""); err != nil {
+			return nil, TokenPair{}, err
+		}
+	}
+	access, aexp, tokenType, err := s.signAccess(ctx, user.ID, string(user.Role), jkt)
+	if err != nil {
+		return nil, TokenPair{}, err
+	}
 
 	opaque, hash, rexp, err := s.tokens.NewRefresh(ctx, user.ID, s.refreshTTL)
 	if err != nil {
-		return uuid.Nil, "", TokenPair{}, err
+		return nil, TokenPair{}, err
 	}
 	var clientID string
 	if meta.ClientID != nil {
@@ -167,7 +253,7 @@ func (s *AuthService) Login(ctx context.Context, email, password string, meta Cl
 	}
 
 	if err := s.sessions.Create(ctx, session); err != nil {
-		return uuid.Nil, "", TokenPair{}, err
+		return nil, TokenPair{}, err
 	}
 
 	rt := &models.RefreshToken{
@@ -177,11 +263,12 @@ func (s *AuthService) Login(ctx context.Context, email, password string, meta Cl
 		SessionID: &session.ID,
 		IP:        meta.IP,
 		UserAgent: meta.UserAgent,
+		JKT:       ptrNonEmpty(jkt),
 		ExpiresAt: rexp,
 	}
 
 	if err := s.refresh.Create(ctx, rt); err != nil {
-		return uuid.Nil, "", TokenPair{}, err
+		return nil, TokenPair{}, err
 	}
 
 	pair := TokenPair{
@@ -190,11 +277,18 @@ func (s *AuthService) Login(ctx context.Context, email, password string, meta Cl
 		RefreshOpaque:    opaque,
 		RefreshExpiresAt: rexp,
 		RefreshHash:      hash,
+		TokenType:        tokenType,
 	}
-	return user.ID, string(user.Role), pair, nil
+	return session, pair, nil
 }
 
 func (s *AuthService) Refresh(ctx context.Context, refreshOpaqueHash string, meta ClientMeta) (TokenPair, error) {
+	pair, err := s.refreshTokens(ctx, refreshOpaqueHash, meta)
+	metrics.RefreshRotations.WithLabelValues(refreshOutcome(err)).Inc()
+	return pair, err
+}
+
+func (s *AuthService) refreshTokens(ctx context.Context, refreshOpaqueHash string, meta ClientMeta) (TokenPair, error) {
 	hash := util.Sha256Base64URL(refreshOpaqueHash)
 	now := s.now()
 	active, err := s.refresh.IsActiveByHash(ctx, hash, now)
@@ -219,16 +313,31 @@ func (s *AuthService) Refresh(ctx context.Context, refreshOpaqueHash string, met
 		}
 		return TokenPair{}, err
 	}
+	if user.IsDisabled {
+		return TokenPair{}, ErrAccountDisabled
+	}
+
+	// Привязанный токен обновляется только с доказательством того же ключа; непривязанный
+	// привязывается, если клиент прислал доказательство
+	var jkt string
+	if rt.JKT != nil || meta.DPoP != nil {
+		if jkt, err = s.verifyDPoP(ctx, meta.DPoP, ""); err != nil {
+			return TokenPair{}, err
+		}
+		if rt.JKT != nil && *rt.JKT != jkt {
+			return TokenPair{}, fmt.Errorf("%w: refresh token is bound to another key", ErrInvalidDPoPProof)
+		}
+	}
 
 	if err := s.refresh.Touch(ctx, rt.UserID, hash, now); err != nil {
-		s.log.Warn("failed to update token last_used_at", zap.Error(err))
+		s.logger(ctx).Warn("failed to update token last_used_at", zap.Error(err))
 	}
 
 	if _, err := s.refresh.RevokeByHashOnly(ctx, hash); err != nil {
 		return TokenPair{}, err
 	}
 
-	access, aexp, err := s.tokens.SignAccess(ctx, user.ID, string(user.Role), s.accessTTL)
+	access, aexp, tokenType, err := s.signAccess(ctx, user.ID, string(user.Role), jkt)
 	if err != nil {
 		return TokenPair{}, err
 	}
@@ -245,6 +354,7 @@ func (s *AuthService) Refresh(ctx context.Context, refreshOpaqueHash string, met
 		SessionID: rt.SessionID,
 		IP:        meta.IP,
 		UserAgent: meta.UserAgent,
+		JKT:       ptrNonEmpty(jkt),
 		ExpiresAt: rexp,
 		Revoked:   false,
 		CreatedAt: now,
@@ -262,6 +372,7 @@ func (s *AuthService) Refresh(ctx context.Context, refreshOpaqueHash string, met
 		RefreshOpaque:    opaqueNew,
 		RefreshExpiresAt: rexp,
 		RefreshHash:      hashNew,
+		TokenType:        tokenType,
 	}, nil
 }
 
@@ -284,7 +395,7 @@ func (s *AuthService) LogoutWithAccessToken(ctx context.Context, opaque, accessT
 	}
 
 	if err := s.refresh.Touch(ctx, rt.UserID, hash, s.now()); err != nil {
-		s.log.Warn("failed to update token last_used_at before logout", zap.Error(err))
+		s.logger(ctx).Warn("failed to update token last_used_at before logout", zap.Error(err))
 	}
 
 	if accessToken != "" {
@@ -292,7 +403,7 @@ func (s *AuthService) LogoutWithAccessToken(ctx context.Context, opaque, accessT
 			BlacklistToken(ctx context.Context, token string) error
 		}); ok {
 			if err := blacklister.BlacklistToken(ctx, accessToken); err != nil {
-				s.log.Warn("failed to blacklist access token", zap.Error(err))
+				s.logger(ctx).Warn("failed to blacklist access token", zap.Error(err))
 			}
 		}
 	}
@@ -321,6 +432,9 @@ func (s *AuthService) LogoutAll(ctx context.Context) (int64, error) {
 	if !ok || userID == uuid.Nil {
 		return 0, errors.New("unauthenticated: user id not found in context")
 	}
+	if err := denyImpersonated(ctx); err != nil {
+		return 0, err
+	}
 	affected, err := s.refresh.RevokeAll(ctx, userID)
 	if err != nil {
 		return 0, err
@@ -328,21 +442,74 @@ func (s *AuthService) LogoutAll(ctx context.Context) (int64, error) {
 	if s.sessions != nil {
 		_, _ = s.sessions.RevokeAllByUser(ctx, userID)
 	}
+	if err := s.revokeAccessTokens(ctx, userID, RevokeReasonLogoutAll); err != nil {
+		return 0, err
+	}
 	return affected, nil
 }
 
+// revokeAccessTokens сдвигает водяной знак: все ранее выпущенные access-токены
+// пользователя перестают приниматься ParseAndValidateAccess (и, как следствие, Introspect).
+func (s *AuthService) revokeAccessTokens(ctx context.Context, userID uuid.UUID, reason string) error {
+	if s.revoker == nil {
+		return nil
+	}
+	return s.revoker.RevokeIssuedBefore(ctx, userID, s.now(), reason)
+}
+
+// ChangeUserRole меняет роль пользователя и отзывает токены со старой ролью.
+func (s *AuthService) ChangeUserRole(ctx context.Context, userID uuid.UUID, role models.Role) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrNotFound
+	}
+	if user.Role == role {
+		return nil
+	}
+	if err := s.users.UpdateRole(ctx, userID, role); err != nil {
+		return err
+	}
+	return s.revokeAccessTokens(ctx, userID, RevokeReasonRoleChange)
+}
+
+// DisableUser блокирует учётную запись: отзывает refresh-токены, сессии и все access-токены.
+func (s *AuthService) DisableUser(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrNotFound
+	}
+	if err := s.users.SetDisabled(ctx, userID, true); err != nil {
+		return err
+	}
+	if _, err := s.refresh.RevokeAll(ctx, userID); err != nil {
+		return err
+	}
+	if s.sessions != nil {
+		if _, err := s.sessions.RevokeAllByUser(ctx, userID); err != nil {
+			s.logger(ctx).Warn("failed to revoke sessions of disabled user", zap.Error(err))
+		}
+	}
+	return s.revokeAccessTokens(ctx, userID, RevokeReasonDisabled)
+}
+
 func (s *AuthService) GetJwks(ctx context.Context) ([]PublicJWK, error) {
 	// если используешь RSAProvider — просто читай из repo
 	return s.jwks.ListPublic(ctx)
 }
 
-func (s *AuthService) Introspect(ctx context.Context, access string) (bool, uuid.UUID, string, time.Time, error) {
+func (s *AuthService) Introspect(ctx context.Context, access string) (bool, *Claims, error) {
 	claims, err := s.tokens.ParseAndValidateAccess(ctx, access)
 	if err != nil {
 		// недействителен: active=false
-		return false, uuid.Nil, "", time.Time{}, nil
+		return false, nil, nil
 	}
-	return true, claims.UserID, claims.Role, claims.Exp, nil
+	return true, claims, nil
 }
 
 func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
@@ -350,7 +517,7 @@ func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) er
 		rateLimitKey := fmt.Sprintf("pwd_reset:%s", email)
 		limited, err := s.cache.CheckRateLimit(ctx, rateLimitKey)
 		if err != nil {
-			s.log.Warn("failed to check rate limit", zap.Error(err))
+			s.logger(ctx).Warn("failed to check rate limit", zap.Error(err))
 			// продолжаем без rate limiting при ошибке Redis
 		} else if limited {
 			return ErrTooManyRequests
@@ -396,11 +563,11 @@ func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) er
 	if s.cache != nil {
 		rateLimitKey := fmt.Sprintf("pwd_reset:%s", email)
 		if err := s.cache.SetRateLimit(ctx, rateLimitKey, time.Minute); err != nil {
-			s.log.Warn("failed to set rate limit", zap.Error(err))
+			s.logger(ctx).Warn("failed to set rate limit", zap.Error(err))
 		}
 	}
 
-	if err := s.emailProducer.SendEmail(ctx, u.Email, producer.EmailMessage{
+	if err := s.emailProducer.SendEmail(ctx, u.Email, personalize(u, producer.EmailMessage{
 		To:       u.Email,
 		Subject:  "Сброс пароля",
 		Template: "reset_password",
@@ -408,7 +575,7 @@ func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) er
 			"ResetCode":     rng,
 			"ExpireMinutes": "60",
 		},
-	}); err != nil {
+	})); err != nil {
 		return err
 	}
 
@@ -427,6 +594,9 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, code, newPasswor
 	if err != nil || user == nil {
 		return ErrNotFound
 	}
+	if err := s.checkPassword(ctx, user, user.Email, newPassword); err != nil {
+		return err
+	}
 
 	newPasswordHash, err := s.hasher.Hash(newPassword)
 	if err != nil {
@@ -437,21 +607,26 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, code, newPasswor
 	if err := s.users.UpdatePassword(ctx, user); err != nil {
 		return err
 	}
+	s.rememberPassword(ctx, user.ID, newPasswordHash)
 
 	if _, err := s.passwordReset.Consume(ctx, passwordReset.ID.String()); err != nil {
-		s.log.Info("Failed to consume password reset token: ", zap.Error(err))
+		s.logger(ctx).Info("Failed to consume password reset token: ", zap.Error(err))
 	}
 
 	if _, err := s.refresh.RevokeAll(ctx, user.ID); err != nil {
-		s.log.Info("Failed to revoke refresh tokens: ", zap.Error(err))
+		s.logger(ctx).Info("Failed to revoke refresh tokens: ", zap.Error(err))
 	}
 
 	if _, err := s.sessions.RevokeAllByUser(ctx, user.ID); err != nil {
-		s.log.Info("Failed to revoke session tokens: ", zap.Error(err))
+		s.logger(ctx).Info("Failed to revoke session tokens: ", zap.Error(err))
+	}
+
+	if err := s.revokeAccessTokens(ctx, user.ID, RevokeReasonPasswordChange); err != nil {
+		return err
 	}
 
 	if _, err := s.passwordReset.DeleteAllForUser(ctx, user.ID.String()); err != nil {
-		s.log.Info("Failed to delete password reset tokens: ", zap.Error(err))
+		s.logger(ctx).Info("Failed to delete password reset tokens: ", zap.Error(err))
 	}
 
 	return nil
@@ -467,7 +642,7 @@ func (s *AuthService) RequestEmailVerification(ctx context.Context) error {
 		rateLimitKey := fmt.Sprintf("email_ver:%s", userID.String())
 		limited, err := s.cache.CheckRateLimit(ctx, rateLimitKey)
 		if err != nil {
-			s.log.Warn("failed to check rate limit", zap.Error(err))
+			s.logger(ctx).Warn("failed to check rate limit", zap.Error(err))
 		} else if limited {
 			return ErrTooManyRequests
 		}
@@ -499,7 +674,7 @@ func (s *AuthService) RequestEmailVerification(ctx context.Context) error {
 	}
 
 	codeHash := util.Sha256Base64URL(rng)
-	s.log.Info("Код подтверждения почты", zap.String("code", rng))
+	s.logger(ctx).Info("Код подтверждения почты", zap.String("code", rng))
 
 	expiresAt := s.now().Add(24 * time.Hour)
 
@@ -519,19 +694,19 @@ func (s *AuthService) RequestEmailVerification(ctx context.Context) error {
 	if s.cache != nil {
 		rateLimitKey := fmt.Sprintf("email_ver:%s", userID.String())
 		if err := s.cache.SetRateLimit(ctx, rateLimitKey, time.Minute); err != nil {
-			s.log.Warn("failed to set rate limit", zap.Error(err))
+			s.logger(ctx).Warn("failed to set rate limit", zap.Error(err))
 		}
 	}
 
-	if err := s.emailProducer.SendEmail(ctx, u.Email, producer.EmailMessage{
+	if err := s.emailProducer.SendEmail(ctx, u.Email, personalize(u, producer.EmailMessage{
 		To:       u.Email,
 		Subject:  "Подтвердите email",
 		Template: "verify_email",
 		Data: map[string]any{
 			"ConfirmURL": "https://app/confirm?token=" + rng,
 		},
-	}); err != nil {
-		s.log.Warn("Couldn't send email via Kafka", zap.Error(err))
+	})); err != nil {
+		s.logger(ctx).Warn("Couldn't send email via Kafka", zap.Error(err))
 	}
 
 	return nil
@@ -581,15 +756,15 @@ func (s *AuthService) RequestEmailVerificationByEmail(ctx context.Context, email
 		return err
 	}
 
-	if err := s.emailProducer.SendEmail(ctx, email, producer.EmailMessage{
+	if err := s.emailProducer.SendEmail(ctx, email, personalize(u, producer.EmailMessage{
 		To:       email,
 		Subject:  "Подтвердите email",
 		Template: "verify_email",
 		Data: map[string]any{
 			"ConfirmURL": "https://app/confirm?token=" + rng,
 		},
-	}); err != nil {
-		s.log.Warn("Couldn't send email via Kafka", zap.Error(err))
+	})); err != nil {
+		s.logger(ctx).Warn("Couldn't send email via Kafka", zap.Error(err))
 	}
 
 	return nil
@@ -614,7 +789,7 @@ func (s *AuthService) ConfirmEmailVerificationRequest(ctx context.Context, code
 	}
 
 	if _, err := s.emailVerification.Consume(ctx, emailVer.ID.String()); err != nil {
-		s.log.Info("Failed to consume password reset token", zap.Error(err))
+		s.logger(ctx).Info("Failed to consume password reset token", zap.Error(err))
 	}
 
 	return nil
@@ -625,11 +800,38 @@ type ctxKey string
 const (
 	ctxUserIDKey ctxKey = "auth.user_id"
 	ctxRoleKey   ctxKey = "auth.role"
+	ctxAPIKeyKey ctxKey = "auth.api_key_id"
+	ctxActorKey  ctxKey = "auth.actor_id"
 )
 
 func WithUserID(ctx context.Context, id uuid.UUID) context.Context {
 	return context.WithValue(ctx, ctxUserIDKey, id)
 }
+func WithRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, ctxRoleKey, role)
+}
+func RoleFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(ctxRoleKey).(string)
+	return v, ok
+}
+
+// WithAPIKeyID отмечает, что запрос аутентифицирован API-ключом, а не сессией.
+func WithAPIKeyID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, ctxAPIKeyKey, id)
+}
+func APIKeyIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(ctxAPIKeyKey).(uuid.UUID)
+	return id, ok
+}
+
+// WithActorID отмечает, что запрос выполняет администратор от имени пользователя.
+func WithActorID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, ctxActorKey, id)
+}
+func ActorIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(ctxActorKey).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
 func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
 	v := ctx.Value(ctxUserIDKey)
 	if v == nil {
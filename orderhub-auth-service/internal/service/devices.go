@@ -0,0 +1,233 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"auth-service/internal/util"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAppURL = "https://app"
+	// signInAlertTTL — сколько действует ссылка «это был не я» из письма о новом входе
+	signInAlertTTL = 7 * 24 * time.Hour
+)
+
+// SetDeviceRepos включает письма о входе с нового устройства и список доверенных устройств
+func (s *AuthService) SetDeviceRepos(devices TrustedDeviceRepo, alerts SignInAlertRepo) {
+	s.devices = devices
+	s.signInAlerts = alerts
+}
+
+// SetAppURL задаёт адрес веб-приложения, на который ведут ссылки из писем
+func (s *AuthService) SetAppURL(url string) {
+	if url = strings.TrimRight(strings.TrimSpace(url), "/"); url != "" {
+		s.appURL = url
+	}
+}
+
+// IPNetwork сводит адрес к сети (/24 для IPv4, /64 для IPv6), чтобы смена адреса
+// внутри пула провайдера не считалась входом с нового места. Для нераспознанного адреса — "".
+func IPNetwork(ip string) string {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	bits := 64
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
+
+// noteSignIn запоминает устройство и, если cid или сеть пользователю незнакомы, отправляет
+// письмо о новом входе. Ошибки только логируются: вход из-за них не отклоняется.
+func (s *AuthService) noteSignIn(ctx context.Context, user *models.User, session *models.UserSession, meta ClientMeta) {
+	if s.devices == nil {
+		return
+	}
+	network := ""
+	if meta.IP != nil {
+		network = IPNetwork(*meta.IP)
+	}
+
+	match, err := s.devices.Match(ctx, user.ID, session.ClientID, network)
+	if err != nil {
+		s.logger(ctx).Warn("failed to match trusted device", zap.String("user_id", user.ID.String()), zap.Error(err))
+		return
+	}
+	now := s.now()
+	if err := s.devices.Upsert(ctx, &models.TrustedDevice{
+		UserID:      user.ID,
+		ClientID:    session.ClientID,
+		IPNetwork:   network,
+		UserAgent:   meta.UserAgent,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	}); err != nil {
+		s.logger(ctx).Warn("failed to save trusted device", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+
+	// первый вход (обычно сразу после регистрации) сравнивать не с чем
+	if !match.Any || (match.Client && match.Network) {
+		return
+	}
+	if err := s.sendSignInAlert(ctx, user, session, network, meta, now); err != nil {
+		s.logger(ctx).Error("failed to send new sign-in alert", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+}
+
+func (s *AuthService) sendSignInAlert(ctx context.Context, user *models.User, session *models.UserSession, network string, meta ClientMeta, now time.Time) error {
+	if s.signInAlerts == nil {
+		return errors.New("sign-in alerts are not configured")
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	alert := &models.SignInAlert{
+		UserID:    user.ID,
+		SessionID: session.ID,
+		ClientID:  session.ClientID,
+		IPNetwork: network,
+		TokenHash: util.Sha256Base64URL(token),
+		ExpiresAt: now.Add(signInAlertTTL),
+		CreatedAt: now,
+	}
+	if err := s.signInAlerts.Create(ctx, alert); err != nil {
+		return err
+	}
+
+	return s.emailProducer.SendEmail(ctx, user.Email, personalize(user, producer.EmailMessage{
+		To:       user.Email,
+		Subject:  "Новый вход в аккаунт",
+		Template: "new_sign_in",
+		Data: map[string]any{
+			"Time":      now.UTC().Format("02.01.2006 15:04 UTC"),
+			"IP":        valueOr(meta.IP, "неизвестен"),
+			"UserAgent": valueOr(meta.UserAgent, "неизвестно"),
+			"ReportURL": s.appURL + "/security/not-me?token=" + token,
+		},
+	}))
+}
+
+func valueOr(p *string, def string) string {
+	if p == nil || *p == "" {
+		return def
+	}
+	return *p
+}
+
+// ReportSignIn обрабатывает ссылку «это был не я»: пароль считается скомпрометированным,
+// поэтому отзываются все сессии и токены, устройство забывается, вход блокируется до
+// сброса пароля, а на почту уходит код сброса.
+func (s *AuthService) ReportSignIn(ctx context.Context, token string) error {
+	if s.signInAlerts == nil {
+		return errors.New("sign-in alerts are not configured")
+	}
+	now := s.now()
+	alert, err := s.signInAlerts.GetValidByHash(ctx, util.Sha256Base64URL(token), now)
+	if err != nil {
+		return err
+	}
+	if alert == nil {
+		return ErrInvalidAlertToken
+	}
+
+	// ссылку гасим только после всех отзывов: шаги идемпотентны, и при сбое
+	// пользователь может перейти по ней ещё раз
+	user, err := s.users.GetByID(ctx, alert.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.DeletedAt != nil {
+		return ErrNotFound
+	}
+
+	if err := s.users.RequirePasswordReset(ctx, user.ID); err != nil {
+		return err
+	}
+	if _, err := s.refresh.RevokeAll(ctx, user.ID); err != nil {
+		return err
+	}
+	if s.sessions != nil {
+		if _, err := s.sessions.RevokeAllByUser(ctx, user.ID); err != nil {
+			return err
+		}
+	}
+	if err := s.revokeAccessTokens(ctx, user.ID, RevokeReasonCompromised); err != nil {
+		return err
+	}
+	if s.devices != nil {
+		if _, err := s.devices.DeleteByClient(ctx, user.ID, alert.ClientID); err != nil {
+			s.logger(ctx).Warn("failed to forget reported device", zap.String("user_id", user.ID.String()), zap.Error(err))
+		}
+	}
+	used, err := s.signInAlerts.MarkUsed(ctx, alert.ID, now)
+	if err != nil {
+		return err
+	}
+	if !used {
+		// параллельный переход по той же ссылке уже всё сделал
+		return nil
+	}
+	s.logger(ctx).Warn("sign-in reported by user", zap.String("user_id", user.ID.String()), zap.String("session_id", alert.SessionID.String()))
+
+	// вход уже заблокирован; если письмо не ушло, пользователь запросит сброс сам
+	if err := s.RequestPasswordReset(ctx, user.Email); err != nil {
+		s.logger(ctx).Warn("failed to send password reset after reported sign-in", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+	return nil
+}
+
+func (s *AuthService) deviceUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, ErrUnauthenticated
+	}
+	if s.devices == nil {
+		return uuid.Nil, errors.New("trusted devices are not configured")
+	}
+	return userID, nil
+}
+
+// ListTrustedDevices — устройства и сети текущего пользователя, недавние первыми
+func (s *AuthService) ListTrustedDevices(ctx context.Context) ([]models.TrustedDevice, error) {
+	userID, err := s.deviceUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.devices.ListByUser(ctx, userID)
+}
+
+// ForgetTrustedDevice удаляет устройство; следующий вход с него снова вызовет письмо
+func (s *AuthService) ForgetTrustedDevice(ctx context.Context, id uuid.UUID) error {
+	userID, err := s.deviceUserID(ctx)
+	if err != nil {
+		return err
+	}
+	ok, err := s.devices.Delete(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrDeviceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/dpop"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	TokenTypeBearer = "Bearer"
+	TokenTypeDPoP   = "DPoP"
+
+	// DPoPNonceTTL — сколько живёт выданный сервером nonce
+	DPoPNonceTTL = 5 * time.Minute
+	// jti помним, пока доказательство с ним проходит проверку свежести
+	dpopReplayTTL = dpop.DefaultMaxAge + dpop.DefaultSkew
+)
+
+// DPoPProof — доказательство из заголовка DPoP и запрос, для которого оно подписано
+type DPoPProof struct {
+	Proof  string
+	Method string
+	URL    string
+}
+
+// SetDPoP включает приём DPoP-доказательств. store — общее для реплик хранилище nonce и
+// использованных jti; requireNonce заставляет клиентов подписывать nonce сервера (RFC 9449, 8).
+func (s *AuthService) SetDPoP(store DPoPStore, requireNonce bool) {
+	s.dpopStore = store
+	s.dpopRequireNonce = requireNonce
+}
+
+// NewDPoPNonce выдаёт nonce, который клиент должен положить в следующее доказательство
+func (s *AuthService) NewDPoPNonce(ctx context.Context) (string, error) {
+	if s.dpopStore == nil {
+		return "", nil
+	}
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(buf)
+	if _, err := s.dpopStore.SetNX(ctx, "dpop_nonce:"+nonce, "1", DPoPNonceTTL); err != nil {
+		return "", err
+	}
+	return nonce, nil
+}
+
+// verifyDPoP проверяет доказательство, nonce и одноразовость jti и возвращает отпечаток ключа.
+// accessToken передаётся, когда доказательство сопровождает access-токен (claim ath).
+func (s *AuthService) verifyDPoP(ctx context.Context, p *DPoPProof, accessToken string) (string, error) {
+	if p == nil {
+		return "", fmt.Errorf("%w: proof required", ErrInvalidDPoPProof)
+	}
+	if s.dpopStore == nil {
+		return "", fmt.Errorf("%w: dpop is not enabled", ErrInvalidDPoPProof)
+	}
+	proof, err := dpop.Verify(p.Proof, dpop.VerifyOptions{
+		Method:      p.Method,
+		URL:         p.URL,
+		AccessToken: accessToken,
+		Now:         s.now(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidDPoPProof, err)
+	}
+
+	if s.dpopRequireNonce {
+		if proof.Nonce == "" {
+			return "", ErrUseDPoPNonce
+		}
+		if _, err := s.dpopStore.Get(ctx, "dpop_nonce:"+proof.Nonce); err != nil {
+			return "", ErrUseDPoPNonce
+		}
+	}
+
+	// jti привязан к ключу: чужой клиент не может «занять» jti и сломать доказательство
+	fresh, err := s.dpopStore.SetNX(ctx, "dpop_jti:"+proof.Thumbprint+":"+proof.JTI, "1", dpopReplayTTL)
+	if err != nil {
+		// без хранилища повтор не отличить от нового запроса — не пропускаем
+		return "", fmt.Errorf("check dpop replay: %w", err)
+	}
+	if !fresh {
+		return "", fmt.Errorf("%w: jti already used", ErrInvalidDPoPProof)
+	}
+	return proof.Thumbprint, nil
+}
+
+// signAccess выпускает access-токен, привязанный к ключу, если jkt не пуст
+func (s *AuthService) signAccess(ctx context.Context, userID uuid.UUID, role, jkt string) (string, time.Time, string, error) {
+	if jkt == "" {
+		token, exp, err := s.tokens.SignAccess(ctx, userID, role, s.accessTTL)
+		return token, exp, TokenTypeBearer, err
+	}
+	token, exp, err := s.tokens.SignAccessBound(ctx, userID, role, jkt, s.accessTTL)
+	return token, exp, TokenTypeDPoP, err
+}
+
+// IntrospectDPoP проверяет access-токен, предъявленный со схемой DPoP: доказательство
+// должно быть подписано тем ключом, к которому привязан токен. Так gateway проверяет запросы
+// на входе; внутренние сервисы вызывают обычный Introspect и смотрят только на claims.
+func (s *AuthService) IntrospectDPoP(ctx context.Context, access string, p *DPoPProof) (bool, *Claims, error) {
+	active, claims, err := s.Introspect(ctx, access)
+	if err != nil || !active {
+		return active, claims, err
+	}
+	jkt, err := s.verifyDPoP(ctx, p, access)
+	if err != nil {
+		if errors.Is(err, ErrInvalidDPoPProof) {
+			s.logger(ctx).Info("dpop proof rejected", zap.Error(err))
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	// Bearer-токен со схемой DPoP не принимаем: у него нет cnf.jkt, с которым можно сверить ключ
+	if claims.JKT != jkt {
+		return false, nil, nil
+	}
+	return true, claims, nil
+}
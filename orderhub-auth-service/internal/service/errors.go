@@ -1,18 +1,50 @@
 package service
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+)
 
 var (
-	ErrNotFound                    = errors.New("not found")
-	ErrAlreadyExists               = errors.New("already exists")
-	ErrEmailExists                 = errors.New("email already exists")
-	ErrInvalidCredentials          = errors.New("invalid credentials")
-	ErrTokenExpired                = errors.New("token expired")
-	ErrTokenRevoked                = errors.New("token revoked")
-	ErrTokenNotFoundOrRevoked      = errors.New("refresh token not found or already revoked")
-	ErrPasswordResetInProgress     = errors.New("password reset in progress")
-	ErrTooManyRequests             = errors.New("too many requests")
-	ErrInvalidOrExpiredCode        = errors.New("invalid or expired reset code")
-	ErrEmailVerificationInProgress = errors.New("email verification in progress")
-	ErrEmailAlreadyVerified        = errors.New("email already verified")
+	ErrNotFound                     = errors.New("not found")
+	ErrAlreadyExists                = errors.New("already exists")
+	ErrEmailExists                  = errors.New("email already exists")
+	ErrInvalidCredentials           = errors.New("invalid credentials")
+	ErrTokenExpired                 = errors.New("token expired")
+	ErrTokenRevoked                 = errors.New("token revoked")
+	ErrTokenNotFoundOrRevoked       = errors.New("refresh token not found or already revoked")
+	ErrPasswordResetInProgress      = errors.New("password reset in progress")
+	ErrTooManyRequests              = errors.New("too many requests")
+	ErrInvalidOrExpiredCode         = errors.New("invalid or expired reset code")
+	ErrEmailVerificationInProgress  = errors.New("email verification in progress")
+	ErrEmailAlreadyVerified         = errors.New("email already verified")
+	ErrAccountDisabled              = errors.New("account disabled")
+	ErrForbidden                    = authz.ErrForbidden
+	ErrPermissionNotFound           = errors.New("permission not found")
+	ErrUnauthenticated              = errors.New("unauthenticated")
+	ErrAlreadyVendor                = errors.New("user is already a vendor")
+	ErrVendorApplicationPending     = errors.New("vendor application already pending")
+	ErrVendorApplicationReviewed    = errors.New("vendor application already reviewed")
+	ErrVendorApplicationNotFound    = errors.New("vendor application not found")
+	ErrAPIKeyNotFound               = errors.New("api key not found")
+	ErrInvalidAPIKey                = errors.New("invalid api key")
+	ErrScopeNotGranted              = errors.New("scope is not granted to the user")
+	ErrInvalidExpiry                = errors.New("expiry must be in the future")
+	ErrImpersonationForbidden       = errors.New("operation is not allowed while impersonating")
+	ErrInvalidAlertToken            = errors.New("invalid or expired sign-in alert token")
+	ErrPasswordResetRequired        = errors.New("password reset required")
+	ErrDeviceNotFound               = errors.New("trusted device not found")
+	ErrWeakPassword                 = errors.New("password does not satisfy the policy")
+	ErrNotGuest                     = errors.New("user is not a guest")
+	ErrGuestAccount                 = errors.New("guest account must complete registration first")
+	ErrInvalidClient                = errors.New("invalid client credentials")
+	ErrOAuthClientNotFound          = errors.New("oauth client not found")
+	ErrInvalidTimeZone              = errors.New("unknown time zone")
+	ErrPhoneNotSet                  = errors.New("phone number is not set")
+	ErrPhoneAlreadyVerified         = errors.New("phone already verified")
+	ErrVerificationAttemptsExceeded = errors.New("too many verification attempts")
+	ErrPhoneVerificationDisabled    = errors.New("phone verification is not configured")
+	ErrInvalidDPoPProof             = errors.New("invalid dpop proof")
+	ErrUseDPoPNonce                 = errors.New("dpop proof must use the server nonce")
 )
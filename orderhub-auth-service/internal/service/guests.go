@@ -0,0 +1,118 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// guestRateLimitTTL — не чаще одного гостевого аккаунта с одного IP за этот интервал
+const guestRateLimitTTL = 10 * time.Second
+
+// guestEmail — заглушка вместо email: колонка NOT NULL и уникальна
+func guestEmail(id uuid.UUID) string {
+	return fmt.Sprintf("guest-%s@guest.invalid", id)
+}
+
+// CreateGuest заводит анонимного покупателя и выдаёт ему пару токенов. Гость не может
+// войти по паролю; сохранить аккаунт можно только через UpgradeGuest.
+func (s *AuthService) CreateGuest(ctx context.Context, meta ClientMeta) (uuid.UUID, TokenPair, error) {
+	rateLimitKey := ""
+	if s.cache != nil && meta.IP != nil {
+		rateLimitKey = "guest:" + *meta.IP
+		limited, err := s.cache.CheckRateLimit(ctx, rateLimitKey)
+		if err != nil {
+			s.logger(ctx).Warn("failed to check rate limit", zap.Error(err))
+		} else if limited {
+			return uuid.Nil, TokenPair{}, ErrTooManyRequests
+		}
+	}
+
+	id := uuid.New()
+	u := &models.User{
+		ID:      id,
+		Email:   guestEmail(id),
+		Role:    models.RoleCustomer,
+		IsGuest: true,
+	}
+	if err := s.users.Create(ctx, u); err != nil {
+		return uuid.Nil, TokenPair{}, err
+	}
+
+	_, pair, err := s.startSession(ctx, u, meta)
+	if err != nil {
+		return uuid.Nil, TokenPair{}, err
+	}
+
+	if rateLimitKey != "" {
+		if err := s.cache.SetRateLimit(ctx, rateLimitKey, guestRateLimitTTL); err != nil {
+			s.logger(ctx).Warn("failed to set rate limit", zap.Error(err))
+		}
+	}
+	return id, pair, nil
+}
+
+// UpgradeGuest привязывает к гостю email и пароль. user_id не меняется, поэтому заказы,
+// оформленные гостем, остаются у аккаунта; выданные токены продолжают работать.
+func (s *AuthService) UpgradeGuest(ctx context.Context, email, password string) (*models.User, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if _, viaKey := APIKeyIDFromContext(ctx); viaKey {
+		return nil, ErrForbidden
+	}
+	if err := denyImpersonated(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+	if !user.IsGuest {
+		return nil, ErrNotGuest
+	}
+
+	email = strings.TrimSpace(email)
+	if err := s.checkPassword(ctx, nil, email, password); err != nil {
+		return nil, err
+	}
+	exists, err := s.users.ExistsByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrEmailExists
+	}
+
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+	upgraded, err := s.users.UpgradeGuest(ctx, userID, email, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !upgraded {
+		// параллельный запрос уже завершил регистрацию
+		return nil, ErrNotGuest
+	}
+	s.rememberPassword(ctx, userID, hash)
+
+	user.Email = email
+	user.Password = hash
+	user.IsGuest = false
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		s.logger(ctx).Warn("failed to send verification email after guest upgrade", zap.String("user_id", userID.String()), zap.Error(err))
+	}
+	return user, nil
+}
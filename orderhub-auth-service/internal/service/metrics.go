@@ -0,0 +1,37 @@
+package service
+
+import "errors"
+
+// loginOutcome — метка исхода входа для auth_logins_total
+func loginOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrInvalidCredentials):
+		return "invalid_credentials"
+	case errors.Is(err, ErrAccountDisabled):
+		return "disabled"
+	case errors.Is(err, ErrPasswordResetRequired):
+		return "reset_required"
+	case errors.Is(err, ErrInvalidDPoPProof), errors.Is(err, ErrUseDPoPNonce):
+		return "dpop_rejected"
+	default:
+		return "error"
+	}
+}
+
+// refreshOutcome — метка исхода обмена refresh-токена для auth_refresh_rotations_total
+func refreshOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "rotated"
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrTokenExpired), errors.Is(err, ErrTokenNotFoundOrRevoked):
+		return "invalid"
+	case errors.Is(err, ErrAccountDisabled):
+		return "disabled"
+	case errors.Is(err, ErrInvalidDPoPProof), errors.Is(err, ErrUseDPoPNonce):
+		return "dpop_rejected"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/util"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Значения token_type_hint (RFC 7009) и token_type в ответе интроспекции
+const (
+	TokenTypeAccess  = "access_token"
+	TokenTypeRefresh = "refresh_token"
+)
+
+// RevokeReasonTokenRevoked — отзыв access-токена через /oauth/revoke без Redis
+const RevokeReasonTokenRevoked = "token_revoked"
+
+// oauthClientIDPrefix отличает client_id resource server'ов от прочих идентификаторов
+const oauthClientIDPrefix = "rs_"
+
+// TokenIntrospection — ответ интроспекции (RFC 7662). Для неактивного токена заполнено только Active.
+type TokenIntrospection struct {
+	Active    bool
+	Scope     []string
+	ClientID  string
+	Subject   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	JTI       string
+	TokenType string
+	Actor     string
+}
+
+// SetOAuthClients включает интроспекцию и отзыв для сторонних клиентов. firstPartyClientID —
+// client_id, под которым в ответах фигурируют токены, выданные самим auth-service (JWT audience).
+func (s *AuthService) SetOAuthClients(clients OAuthClientRepo, firstPartyClientID string) {
+	s.oauthClients = clients
+	s.firstPartyClientID = firstPartyClientID
+}
+
+// authenticateClient проверяет client_id/client_secret resource server'а
+func (s *AuthService) authenticateClient(ctx context.Context, clientID, secret string) error {
+	if s.oauthClients == nil {
+		return errors.New("oauth clients are not configured")
+	}
+	client, err := s.oauthClients.GetByClientID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if client == nil || subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(util.Sha256Base64URL(secret))) != 1 {
+		return ErrInvalidClient
+	}
+	if err := s.oauthClients.Touch(ctx, client.ID, s.now()); err != nil {
+		s.logger(ctx).Warn("failed to update oauth client last_used_at", zap.String("client_id", clientID), zap.Error(err))
+	}
+	return nil
+}
+
+// looksLikeJWT — access-токены у нас JWT, refresh — непрозрачная строка без точек.
+// По формату тип определяется надёжнее подсказки клиента, поэтому token_type_hint
+// только принимается (RFC 7662 разрешает его игнорировать).
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// IntrospectToken возвращает сведения о токене для стороннего resource server'а
+func (s *AuthService) IntrospectToken(ctx context.Context, clientID, secret, token string) (*TokenIntrospection, error) {
+	if err := s.authenticateClient(ctx, clientID, secret); err != nil {
+		return nil, err
+	}
+	if looksLikeJWT(token) {
+		return s.introspectAccess(ctx, token), nil
+	}
+	return s.introspectRefresh(ctx, token)
+}
+
+func (s *AuthService) introspectAccess(ctx context.Context, token string) *TokenIntrospection {
+	claims, err := s.tokens.ParseAndValidateAccess(ctx, token)
+	if err != nil {
+		return &TokenIntrospection{}
+	}
+	out := &TokenIntrospection{
+		Active:    true,
+		Scope:     claims.Perms,
+		ClientID:  s.firstPartyClientID,
+		Subject:   claims.UserID.String(),
+		IssuedAt:  claims.IssuedAt,
+		ExpiresAt: claims.Exp,
+		JTI:       claims.ID,
+		TokenType: TokenTypeAccess,
+	}
+	if claims.Actor != uuid.Nil {
+		out.Actor = claims.Actor.String()
+	}
+	return out
+}
+
+func (s *AuthService) introspectRefresh(ctx context.Context, token string) (*TokenIntrospection, error) {
+	rt, err := s.refresh.GetByHashOnly(ctx, util.Sha256Base64URL(token))
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && rt == nil) {
+		return &TokenIntrospection{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rt.Revoked || !rt.ExpiresAt.After(s.now()) {
+		return &TokenIntrospection{}, nil
+	}
+	user, err := s.users.GetByID(ctx, rt.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.IsDisabled || user.DeletedAt != nil {
+		return &TokenIntrospection{}, nil
+	}
+
+	var scope []string
+	if s.permissions != nil {
+		if scope, err = s.permissions.ListByRole(ctx, user.Role); err != nil {
+			return nil, err
+		}
+	}
+	return &TokenIntrospection{
+		Active:    true,
+		Scope:     scope,
+		ClientID:  s.firstPartyClientID,
+		Subject:   user.ID.String(),
+		IssuedAt:  rt.CreatedAt,
+		ExpiresAt: rt.ExpiresAt,
+		JTI:       rt.ID.String(),
+		TokenType: TokenTypeRefresh,
+	}, nil
+}
+
+// RevokeToken отзывает access- или refresh-токен. Недействительный или неизвестный токен
+// ошибкой не считается (RFC 7009, раздел 2.2). Access-токен попадает в blacklist по jti;
+// без Redis blacklist'а нет, и отзываются все access-токены владельца — refresh-токены
+// остаются, так что клиенты просто обновят пару.
+func (s *AuthService) RevokeToken(ctx context.Context, clientID, secret, token string) error {
+	if err := s.authenticateClient(ctx, clientID, secret); err != nil {
+		return err
+	}
+
+	if !looksLikeJWT(token) {
+		err := s.LogoutWithAccessToken(ctx, token, "")
+		if errors.Is(err, ErrTokenNotFoundOrRevoked) || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	claims, err := s.tokens.ParseAndValidateAccess(ctx, token)
+	if err != nil {
+		return nil
+	}
+	if blacklister, ok := s.tokens.(interface {
+		CanBlacklist() bool
+		BlacklistToken(ctx context.Context, token string) error
+	}); ok && blacklister.CanBlacklist() {
+		return blacklister.BlacklistToken(ctx, token)
+	}
+	return s.revokeAccessTokens(ctx, claims.UserID, RevokeReasonTokenRevoked)
+}
+
+// CreateOAuthClient регистрирует resource server. Секрет возвращается только здесь; в БД — хэш.
+func (s *AuthService) CreateOAuthClient(ctx context.Context, name string) (*models.OAuthClient, string, error) {
+	if err := s.requireOAuthClientManage(ctx); err != nil {
+		return nil, "", err
+	}
+
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return nil, "", err
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(buf)
+
+	client := &models.OAuthClient{
+		ClientID:   oauthClientIDPrefix + hex.EncodeToString(id),
+		Name:       strings.TrimSpace(name),
+		SecretHash: util.Sha256Base64URL(secret),
+		CreatedAt:  s.now(),
+	}
+	if err := s.oauthClients.Create(ctx, client); err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+func (s *AuthService) ListOAuthClients(ctx context.Context) ([]models.OAuthClient, error) {
+	if err := s.requireOAuthClientManage(ctx); err != nil {
+		return nil, err
+	}
+	return s.oauthClients.List(ctx)
+}
+
+func (s *AuthService) DeleteOAuthClient(ctx context.Context, clientID string) error {
+	if err := s.requireOAuthClientManage(ctx); err != nil {
+		return err
+	}
+	ok, err := s.oauthClients.Delete(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+func (s *AuthService) requireOAuthClientManage(ctx context.Context) error {
+	if err := authz.Require(ctx, authz.PermOAuthClientManage); err != nil {
+		return err
+	}
+	if s.oauthClients == nil {
+		return errors.New("oauth clients are not configured")
+	}
+	return nil
+}
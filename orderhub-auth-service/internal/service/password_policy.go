@@ -0,0 +1,90 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/password"
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PasswordPolicyError — новый пароль нарушает политику; Violations уходят клиенту
+// как ошибки поля. errors.Is(err, ErrWeakPassword) == true.
+type PasswordPolicyError struct {
+	Violations []password.Violation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return "password " + strings.Join(msgs, "; ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error { return ErrWeakPassword }
+
+// SetPasswordPolicy включает политику паролей; history нужен для запрета повторов
+// и может быть nil, тогда повторы не проверяются.
+func (s *AuthService) SetPasswordPolicy(policy *password.Policy, history PasswordHistoryRepo) {
+	s.passwordPolicy = policy
+	s.passwordHistory = history
+}
+
+// checkPassword проверяет новый пароль. user == nil при регистрации: истории ещё нет.
+func (s *AuthService) checkPassword(ctx context.Context, user *models.User, email, pw string) error {
+	if s.passwordPolicy == nil {
+		return nil
+	}
+	violations := s.passwordPolicy.Check(pw, email)
+
+	if user != nil && s.passwordPolicy.History > 0 {
+		reused, err := s.isRecentPassword(ctx, user, pw)
+		if err != nil {
+			return err
+		}
+		if reused {
+			violations = append(violations, password.Violation{
+				Code:    password.CodeReused,
+				Message: "must differ from your recent passwords",
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+func (s *AuthService) isRecentPassword(ctx context.Context, user *models.User, pw string) (bool, error) {
+	// текущий пароль проверяем всегда: у пользователей до появления истории её может не быть
+	if user.Password != "" && s.hasher.Compare(user.Password, pw) {
+		return true, nil
+	}
+	if s.passwordHistory == nil {
+		return false, nil
+	}
+	hashes, err := s.passwordHistory.ListRecent(ctx, user.ID, s.passwordPolicy.History)
+	if err != nil {
+		return false, err
+	}
+	for _, h := range hashes {
+		if s.hasher.Compare(h, pw) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rememberPassword пишет хэш в историю; ошибка не отменяет уже сохранённый пароль
+func (s *AuthService) rememberPassword(ctx context.Context, userID uuid.UUID, hash string) {
+	if s.passwordPolicy == nil || s.passwordHistory == nil || s.passwordPolicy.History <= 0 {
+		return
+	}
+	if err := s.passwordHistory.Add(ctx, userID, hash, s.passwordPolicy.History); err != nil {
+		s.logger(ctx).Warn("failed to save password history", zap.String("user_id", userID.String()), zap.Error(err))
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+)
+
+// SetPermissionRepo подключает хранилище прав ролей (RBAC)
+func (s *AuthService) SetPermissionRepo(permissions PermissionRepo) {
+	s.permissions = permissions
+}
+
+func (s *AuthService) requireRBAC(ctx context.Context) error {
+	if s.permissions == nil {
+		return errors.New("rbac is not configured")
+	}
+	return authz.Require(ctx, authz.PermRBACManage)
+}
+
+func (s *AuthService) ListPermissions(ctx context.Context) ([]models.Permission, error) {
+	if err := s.requireRBAC(ctx); err != nil {
+		return nil, err
+	}
+	return s.permissions.ListAll(ctx)
+}
+
+func (s *AuthService) ListRolePermissions(ctx context.Context, role models.Role) ([]string, error) {
+	if err := s.requireRBAC(ctx); err != nil {
+		return nil, err
+	}
+	return s.permissions.ListByRole(ctx, role)
+}
+
+// GrantRolePermission выдаёт право роли. Уже выпущенные токены получат его
+// только после обновления (claim perms фиксируется при выдаче токена).
+func (s *AuthService) GrantRolePermission(ctx context.Context, role models.Role, code string) error {
+	if err := s.requireRBAC(ctx); err != nil {
+		return err
+	}
+	exists, err := s.permissions.Exists(ctx, code)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrPermissionNotFound
+	}
+	return s.permissions.Grant(ctx, role, code)
+}
+
+// RevokeRolePermission отзывает право у роли. Действует на новые токены;
+// старые сохраняют право до истечения access TTL.
+func (s *AuthService) RevokeRolePermission(ctx context.Context, role models.Role, code string) error {
+	if err := s.requireRBAC(ctx); err != nil {
+		return err
+	}
+	if role == models.RoleAdmin && code == authz.PermRBACManage {
+		// не даём администраторам лишить себя возможности управлять правами
+		return ErrForbidden
+	}
+	ok, err := s.permissions.Revoke(ctx, role, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"auth-service/internal/util"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// PhoneCodeTTL — время жизни SMS-кода
+	PhoneCodeTTL = 10 * time.Minute
+	// PhoneCodeMaxAttempts — сколько раз можно ошибиться при вводе одного кода
+	PhoneCodeMaxAttempts = 5
+	phoneCodeCooldown    = time.Minute
+)
+
+// SetPhoneVerification включает подтверждение телефона по SMS
+func (s *AuthService) SetPhoneVerification(repo PhoneVerificationRepo, sms SMSProducer) {
+	s.phoneVerification = repo
+	s.smsProducer = sms
+}
+
+// RequestPhoneVerification отправляет 6-значный код на телефон из профиля.
+// Возвращает момент, до которого код действителен.
+func (s *AuthService) RequestPhoneVerification(ctx context.Context) (time.Time, error) {
+	if s.phoneVerification == nil || s.smsProducer == nil {
+		return time.Time{}, ErrPhoneVerificationDisabled
+	}
+	u, err := s.GetMe(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if u.Phone == nil {
+		return time.Time{}, ErrPhoneNotSet
+	}
+	if u.IsPhoneVerified {
+		return time.Time{}, ErrPhoneAlreadyVerified
+	}
+
+	latest, err := s.phoneVerification.FindLatestByUser(ctx, u.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if latest != nil && s.now().Sub(latest.CreatedAt) < phoneCodeCooldown {
+		return time.Time{}, ErrTooManyRequests
+	}
+
+	code, err := phoneCode()
+	if err != nil {
+		return time.Time{}, err
+	}
+	expiresAt := s.now().Add(PhoneCodeTTL)
+	v := &models.PhoneVerification{
+		UserID:    u.ID,
+		Phone:     *u.Phone,
+		CodeHash:  phoneCodeHash(u.ID, code),
+		ExpiresAt: expiresAt,
+		CreatedAt: s.now(),
+	}
+	if err := s.phoneVerification.Create(ctx, v); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := s.smsProducer.SendSMS(ctx, u.ID.String(), producer.SMSMessage{
+		To:       *u.Phone,
+		Template: "verify_phone",
+		Data: map[string]any{
+			"Code":       code,
+			"TTLMinutes": int(PhoneCodeTTL / time.Minute),
+		},
+		Locale: emailLocale(u),
+	}); err != nil {
+		s.logger(ctx).Error("failed to send phone verification sms", zap.Error(err))
+		return time.Time{}, err
+	}
+	return expiresAt, nil
+}
+
+// ConfirmPhoneVerification проверяет последний выданный код. Каждая попытка засчитывается
+// до сравнения, поэтому перебор ограничен PhoneCodeMaxAttempts даже при параллельных запросах.
+func (s *AuthService) ConfirmPhoneVerification(ctx context.Context, code string) error {
+	if s.phoneVerification == nil {
+		return ErrPhoneVerificationDisabled
+	}
+	u, err := s.GetMe(ctx)
+	if err != nil {
+		return err
+	}
+	if u.Phone == nil {
+		return ErrPhoneNotSet
+	}
+	if u.IsPhoneVerified {
+		return ErrPhoneAlreadyVerified
+	}
+
+	v, err := s.phoneVerification.FindLatestByUser(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	// код, отправленный на прежний номер, не подтверждает новый
+	if v == nil || v.Consumed || !v.ExpiresAt.After(s.now()) || v.Phone != *u.Phone {
+		return ErrInvalidOrExpiredCode
+	}
+
+	ok, err := s.phoneVerification.RegisterAttempt(ctx, v.ID, PhoneCodeMaxAttempts)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerificationAttemptsExceeded
+	}
+
+	if subtle.ConstantTimeCompare([]byte(phoneCodeHash(u.ID, code)), []byte(v.CodeHash)) != 1 {
+		return ErrInvalidOrExpiredCode
+	}
+
+	consumed, err := s.phoneVerification.Consume(ctx, v.ID)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return ErrInvalidOrExpiredCode
+	}
+
+	verified, err := s.users.SetPhoneVerified(ctx, u.ID, v.Phone)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		// номер сменили между проверкой и подтверждением
+		return ErrInvalidOrExpiredCode
+	}
+	return nil
+}
+
+func phoneCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// phoneCodeHash привязывает код к пользователю: у 6-значных кодов много совпадений
+func phoneCodeHash(userID uuid.UUID, code string) string {
+	return util.Sha256Base64URL(userID.String() + ":" + code)
+}
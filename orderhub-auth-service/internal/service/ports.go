@@ -18,6 +18,12 @@ type UserRepo interface {
 	UpdatePassword(ctx context.Context, user *models.User) error
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	UpdateIsEmailVerified(ctx context.Context, user *models.User) error
+	UpdateRole(ctx context.Context, id uuid.UUID, role models.Role) error
+	SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	RequirePasswordReset(ctx context.Context, id uuid.UUID) error
+	UpgradeGuest(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error)
+	UpdateProfile(ctx context.Context, user *models.User) error
+	SetPhoneVerified(ctx context.Context, id uuid.UUID, phone string) (bool, error)
 }
 
 type RefreshRepo interface {
@@ -44,9 +50,14 @@ type PasswordHasher interface {
 }
 
 type Claims struct {
-	UserID uuid.UUID
-	Role   string
-	Exp    time.Time
+	UserID   uuid.UUID
+	Role     string
+	Perms    []string
+	Exp      time.Time
+	Actor    uuid.UUID // администратор из claim act; uuid.Nil — обычный токен
+	ID       string    // jti
+	IssuedAt time.Time
+	JKT      string // отпечаток DPoP-ключа (cnf.jkt); пусто — обычный Bearer-токен
 }
 
 type TokenPair struct {
@@ -55,10 +66,15 @@ type TokenPair struct {
 	RefreshOpaque    string // выдаём клиенту
 	RefreshExpiresAt time.Time
 	RefreshHash      string // сохраняем в БД
+	TokenType        string // TokenTypeBearer или TokenTypeDPoP
 }
 
 type TokenProvider interface {
 	SignAccess(ctx context.Context, sub uuid.UUID, role string, ttl time.Duration) (token string, exp time.Time, err error)
+	// SignImpersonation выпускает access-токен sub с claim'ом act = actor
+	SignImpersonation(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (token string, exp time.Time, err error)
+	// SignAccessBound выпускает access-токен, привязанный к DPoP-ключу (claim cnf.jkt)
+	SignAccessBound(ctx context.Context, sub uuid.UUID, role, jkt string, ttl time.Duration) (token string, exp time.Time, err error)
 	NewRefresh(ctx context.Context, sub uuid.UUID, ttl time.Duration) (opaque string, hash string, exp time.Time, err error)
 	ParseAndValidateAccess(ctx context.Context, token string) (*Claims, error)
 	// JWKS нужен только при RSA, при HS можно вернуть пусто
@@ -89,6 +105,19 @@ type EmailVerificationRepo interface {
 	FindLatestByUser(ctx context.Context, userID uuid.UUID) (*models.EmailVerification, error)
 }
 
+type PhoneVerificationRepo interface {
+	Create(ctx context.Context, v *models.PhoneVerification) error
+	FindLatestByUser(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error)
+	RegisterAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error)
+	Consume(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// DPoPStore хранит выданные nonce и использованные jti доказательств
+type DPoPStore interface {
+	SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error)
+	Get(ctx context.Context, key string) (string, error)
+}
+
 type CacheClient interface {
 	// Rate limiting
 	SetRateLimit(ctx context.Context, key string, ttl time.Duration) error
@@ -108,6 +137,82 @@ type CacheClient interface {
 	Del(ctx context.Context, keys ...string) error
 }
 
+type PermissionRepo interface {
+	ListAll(ctx context.Context) ([]models.Permission, error)
+	Exists(ctx context.Context, code string) (bool, error)
+	ListByRole(ctx context.Context, role models.Role) ([]string, error)
+	Grant(ctx context.Context, role models.Role, code string) error
+	Revoke(ctx context.Context, role models.Role, code string) (bool, error)
+}
+
+// TokenRevoker сдвигает водяной знак отзыва: все access-токены пользователя,
+// выпущенные раньше момента вызова, перестают проходить валидацию.
+type TokenRevoker interface {
+	RevokeIssuedBefore(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error
+}
+
+// AccountSnapshot — алиас репозиторного типа (см. PublicJWK)
+type AccountSnapshot = repo.AccountSnapshot
+
+type AccountRepo interface {
+	Snapshot(ctx context.Context, userID uuid.UUID) (*AccountSnapshot, error)
+	Anonymize(ctx context.Context, userID uuid.UUID, at time.Time, event *models.UserEventOutbox) error
+}
+
+type VendorApplicationRepo interface {
+	Create(ctx context.Context, a *models.VendorApplication) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error)
+	HasPendingByUser(ctx context.Context, userID uuid.UUID) (bool, error)
+	List(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error)
+	Review(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error)
+}
+
+type APIKeyRepo interface {
+	Create(ctx context.Context, k *models.APIKey) error
+	GetByHash(ctx context.Context, hash string) (*models.APIKey, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error)
+	Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) (bool, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time, ip *string) error
+}
+
+type OAuthClientRepo interface {
+	Create(ctx context.Context, c *models.OAuthClient) error
+	GetByClientID(ctx context.Context, clientID string) (*models.OAuthClient, error)
+	List(ctx context.Context) ([]models.OAuthClient, error)
+	Delete(ctx context.Context, clientID string) (bool, error)
+	Touch(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// DeviceMatch — алиас репозиторного типа (см. PublicJWK)
+type DeviceMatch = repo.DeviceMatch
+
+type TrustedDeviceRepo interface {
+	Match(ctx context.Context, userID uuid.UUID, clientID, network string) (DeviceMatch, error)
+	Upsert(ctx context.Context, d *models.TrustedDevice) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.TrustedDevice, error)
+	Delete(ctx context.Context, id, userID uuid.UUID) (bool, error)
+	DeleteByClient(ctx context.Context, userID uuid.UUID, clientID string) (int64, error)
+}
+
+type SignInAlertRepo interface {
+	Create(ctx context.Context, a *models.SignInAlert) error
+	GetValidByHash(ctx context.Context, hash string, now time.Time) (*models.SignInAlert, error)
+	MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+}
+
+type PasswordHistoryRepo interface {
+	ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error)
+	Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error
+}
+
+type ImpersonationRepo interface {
+	Create(ctx context.Context, e *models.ImpersonationEvent) error
+}
+
 type EmailProducer interface {
 	SendEmail(ctx context.Context, key string, msg producer.EmailMessage) error
 }
+
+type SMSProducer interface {
+	SendSMS(ctx context.Context, key string, msg producer.SMSMessage) error
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"context"
+	"strings"
+	"time"
+	_ "time/tzdata" // часовые пояса не должны зависеть от tzdata в образе
+)
+
+// DefaultLocale — язык писем, если пользователь его не выбрал
+const DefaultLocale = "ru"
+
+// ProfileUpdate — изменения профиля; nil — поле не меняется, пустая строка очищает имя или телефон
+type ProfileUpdate struct {
+	DisplayName      *string
+	Phone            *string
+	Locale           *string
+	TimeZone         *string
+	MarketingConsent *bool
+}
+
+// GetMe возвращает профиль текущего пользователя
+func (s *AuthService) GetMe(ctx context.Context) (*models.User, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+	return user, nil
+}
+
+// UpdateMe меняет переданные поля профиля. Согласие на рассылки даёт только сам пользователь,
+// поэтому под impersonation-токеном его менять нельзя.
+func (s *AuthService) UpdateMe(ctx context.Context, upd ProfileUpdate) (*models.User, error) {
+	user, err := s.GetMe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if upd.DisplayName != nil {
+		user.DisplayName = ptrNonEmpty(*upd.DisplayName)
+	}
+	if upd.Phone != nil {
+		phone := ptrNonEmpty(*upd.Phone)
+		// новый номер нужно подтвердить заново
+		if !equalPtr(phone, user.Phone) {
+			user.IsPhoneVerified = false
+		}
+		user.Phone = phone
+	}
+	if upd.Locale != nil {
+		user.Locale = *upd.Locale
+	}
+	if upd.TimeZone != nil {
+		tz := strings.TrimSpace(*upd.TimeZone)
+		// LoadLocation принимает и "Local", и пустую строку — это не IANA-пояса
+		if tz == "" || tz == "Local" {
+			return nil, ErrInvalidTimeZone
+		}
+		if _, err := time.LoadLocation(tz); err != nil {
+			return nil, ErrInvalidTimeZone
+		}
+		user.TimeZone = tz
+	}
+	if upd.MarketingConsent != nil && *upd.MarketingConsent != user.MarketingConsent {
+		if err := denyImpersonated(ctx); err != nil {
+			return nil, err
+		}
+		now := s.now()
+		user.MarketingConsent = *upd.MarketingConsent
+		user.MarketingConsentAt = &now
+	}
+
+	if err := s.users.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func ptrNonEmpty(v string) *string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func equalPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// emailLocale — язык писем пользователя
+func emailLocale(u *models.User) string {
+	if u == nil || u.Locale == "" {
+		return DefaultLocale
+	}
+	return u.Locale
+}
+
+// personalize добавляет в письмо язык и имя получателя, чтобы notification-service
+// выбрал шаблон на нужном языке и мог обратиться по имени
+func personalize(u *models.User, msg producer.EmailMessage) producer.EmailMessage {
+	msg.Locale = emailLocale(u)
+	if u != nil && u.DisplayName != nil {
+		if msg.Data == nil {
+			msg.Data = map[string]any{}
+		}
+		msg.Data["Name"] = *u.DisplayName
+	}
+	return msg
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/google/uuid"
+)
+
+// SetUserRole — смена роли администратором (право user:manage).
+func (s *AuthService) SetUserRole(ctx context.Context, userID uuid.UUID, role models.Role) error {
+	if err := authz.Require(ctx, authz.PermUserManage); err != nil {
+		return err
+	}
+	if caller, _ := UserIDFromContext(ctx); caller == userID && role != models.RoleAdmin {
+		// не даём администратору случайно лишить себя доступа
+		return ErrForbidden
+	}
+	return s.ChangeUserRole(ctx, userID, role)
+}
+
+// BlockUser — блокировка учётной записи администратором (право user:manage).
+func (s *AuthService) BlockUser(ctx context.Context, userID uuid.UUID) error {
+	if err := authz.Require(ctx, authz.PermUserManage); err != nil {
+		return err
+	}
+	if caller, _ := UserIDFromContext(ctx); caller == userID {
+		return ErrForbidden
+	}
+	return s.DisableUser(ctx, userID)
+}
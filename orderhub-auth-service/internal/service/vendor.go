@@ -0,0 +1,186 @@
+package service
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/producer"
+	"context"
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SetVendorApplicationRepo подключает хранилище заявок продавцов
+func (s *AuthService) SetVendorApplicationRepo(apps VendorApplicationRepo) {
+	s.vendorApps = apps
+}
+
+func (s *AuthService) requireVendorReview(ctx context.Context) (uuid.UUID, error) {
+	if s.vendorApps == nil {
+		return uuid.Nil, errors.New("vendor applications are not configured")
+	}
+	if err := authz.Require(ctx, authz.PermVendorReview); err != nil {
+		return uuid.Nil, err
+	}
+	reviewer, _ := UserIDFromContext(ctx)
+	return reviewer, nil
+}
+
+// SubmitVendorApplication создаёт заявку текущего пользователя на статус продавца.
+// Одновременно у пользователя может быть только одна заявка на рассмотрении.
+func (s *AuthService) SubmitVendorApplication(ctx context.Context, app *models.VendorApplication) (*models.VendorApplication, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if s.vendorApps == nil {
+		return nil, errors.New("vendor applications are not configured")
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+	if user.Role == models.RoleVendor {
+		return nil, ErrAlreadyVendor
+	}
+	if user.IsGuest {
+		return nil, ErrGuestAccount
+	}
+
+	pending, err := s.vendorApps.HasPendingByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrVendorApplicationPending
+	}
+
+	app.ID = uuid.Nil
+	app.UserID = userID
+	app.Status = models.VendorApplicationPending
+	app.RejectReason = nil
+	app.ReviewedBy = nil
+	app.ReviewedAt = nil
+	if err := s.vendorApps.Create(ctx, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+func (s *AuthService) ListVendorApplications(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error) {
+	if _, err := s.requireVendorReview(ctx); err != nil {
+		return nil, 0, err
+	}
+	return s.vendorApps.List(ctx, status, limit, offset)
+}
+
+// ApproveVendorApplication одобряет заявку: пользователь получает ROLE_VENDOR,
+// его прежние access-токены отзываются, на почту уходит уведомление.
+func (s *AuthService) ApproveVendorApplication(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+	reviewer, err := s.requireVendorReview(ctx)
+	if err != nil {
+		return nil, err
+	}
+	app, err := s.pendingVendorApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// роль меняем до смены статуса: ChangeUserRole идемпотентна, поэтому при сбое
+	// заявка остаётся PENDING и одобрение можно повторить
+	if err := s.ChangeUserRole(ctx, app.UserID, models.RoleVendor); err != nil {
+		return nil, err
+	}
+	if err := s.reviewVendorApplication(ctx, app, models.VendorApplicationApproved, reviewer, nil); err != nil {
+		return nil, err
+	}
+
+	s.notifyVendorApplicant(ctx, app, "vendor_approved", "Заявка продавца одобрена", map[string]any{
+		"CompanyName": app.CompanyName,
+	})
+	return app, nil
+}
+
+// RejectVendorApplication отклоняет заявку с необязательной причиной.
+func (s *AuthService) RejectVendorApplication(ctx context.Context, id uuid.UUID, reason string) (*models.VendorApplication, error) {
+	reviewer, err := s.requireVendorReview(ctx)
+	if err != nil {
+		return nil, err
+	}
+	app, err := s.pendingVendorApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *string
+	if reason != "" {
+		r = &reason
+	}
+	if err := s.reviewVendorApplication(ctx, app, models.VendorApplicationRejected, reviewer, r); err != nil {
+		return nil, err
+	}
+
+	s.notifyVendorApplicant(ctx, app, "vendor_rejected", "Заявка продавца отклонена", map[string]any{
+		"CompanyName": app.CompanyName,
+		"Reason":      reason,
+	})
+	return app, nil
+}
+
+func (s *AuthService) pendingVendorApplication(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+	app, err := s.vendorApps.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if app == nil {
+		return nil, ErrVendorApplicationNotFound
+	}
+	if app.Status != models.VendorApplicationPending {
+		return nil, ErrVendorApplicationReviewed
+	}
+	return app, nil
+}
+
+func (s *AuthService) reviewVendorApplication(ctx context.Context, app *models.VendorApplication, to models.VendorApplicationStatus, reviewer uuid.UUID, reason *string) error {
+	now := s.now()
+	var by *uuid.UUID
+	if reviewer != uuid.Nil {
+		by = &reviewer
+	}
+	ok, err := s.vendorApps.Review(ctx, app.ID, to, by, reason, now)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// заявку успели рассмотреть параллельно
+		return ErrVendorApplicationReviewed
+	}
+	app.Status = to
+	app.RejectReason = reason
+	app.ReviewedBy = by
+	app.ReviewedAt = &now
+	return nil
+}
+
+// notifyVendorApplicant отправляет письмо о решении по заявке; ошибки только логируются,
+// решение уже сохранено.
+func (s *AuthService) notifyVendorApplicant(ctx context.Context, app *models.VendorApplication, template, subject string, data map[string]any) {
+	user, err := s.users.GetByID(ctx, app.UserID)
+	if err != nil || user == nil {
+		s.logger(ctx).Warn("vendor application: user not found for notification", zap.String("application_id", app.ID.String()), zap.Error(err))
+		return
+	}
+	if err := s.emailProducer.SendEmail(ctx, user.Email, personalize(user, producer.EmailMessage{
+		To:       user.Email,
+		Subject:  subject,
+		Template: template,
+		Data:     data,
+	})); err != nil {
+		s.logger(ctx).Error("failed to send vendor application email", zap.String("application_id", app.ID.String()), zap.Error(err))
+	}
+}
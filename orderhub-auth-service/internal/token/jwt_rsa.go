@@ -11,6 +11,7 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -38,9 +39,16 @@ type CacheClient interface {
 	IsTokenBlacklisted(ctx context.Context, jti string) (bool, error)
 }
 
+// PermissionSource отдаёт права роли для claim'а perms.
+type PermissionSource interface {
+	ListByRole(ctx context.Context, role models.Role) ([]string, error)
+}
+
 type RSAProvider struct {
 	store    JWKStore
 	cache    CacheClient // добавляем Redis кэш
+	marks    *Watermarks
+	perms    PermissionSource
 	issuer   string
 	audience string
 
@@ -69,6 +77,16 @@ func (p *RSAProvider) SetCache(cache CacheClient) {
 	p.cache = cache
 }
 
+// SetPermissions включает выдачу claim'а perms по роли (опционально)
+func (p *RSAProvider) SetPermissions(perms PermissionSource) {
+	p.perms = perms
+}
+
+// SetWatermarks включает проверку водяного знака отзыва по iat (опционально)
+func (p *RSAProvider) SetWatermarks(marks *Watermarks) {
+	p.marks = marks
+}
+
 func (p *RSAProvider) ensureActiveKey(ctx context.Context) error {
 	p.mu.RLock()
 	if p.privKey != nil && p.activeKid != "" {
@@ -170,12 +188,23 @@ func randomKid() string {
 // ===== Реализация TokenProvider =====
 
 type customClaims struct {
-	Sub  string `json:"sub"`
-	Role string `json:"role"`
-	Ver  int    `json:"ver,omitempty"`
+	Sub   string    `json:"sub"`
+	Role  string    `json:"role"`
+	Perms *[]string `json:"perms,omitempty"` // nil — токен выпущен до RBAC; пустой список — у роли нет прав
+	Ver   int       `json:"ver,omitempty"`
+	Act   *actClaim `json:"act,omitempty"` // RFC 8693: кто действует от имени sub
+	Cnf   *cnfClaim `json:"cnf,omitempty"` // RFC 9449: токен привязан к DPoP-ключу клиента
 	jwt.RegisteredClaims
 }
 
+type cnfClaim struct {
+	JKT string `json:"jkt"`
+}
+
+type actClaim struct {
+	Sub string `json:"sub"`
+}
+
 // ListPublicJWK возвращает публичные ключи (JWKS) через store.
 // Делегирование в репозиторий позволяет переиспользовать готовую выборку N/E/kid.
 func (p *RSAProvider) ListPublicJWK(ctx context.Context) ([]service.PublicJWK, error) {
@@ -183,18 +212,54 @@ func (p *RSAProvider) ListPublicJWK(ctx context.Context) ([]service.PublicJWK, e
 }
 
 func (p *RSAProvider) SignAccess(ctx context.Context, sub uuid.UUID, role string, ttl time.Duration) (string, time.Time, error) {
+	return p.signAccess(ctx, sub, role, nil, "", ttl)
+}
+
+// SignAccessBound — access-токен, привязанный к DPoP-ключу с отпечатком jkt (claim cnf)
+func (p *RSAProvider) SignAccessBound(ctx context.Context, sub uuid.UUID, role, jkt string, ttl time.Duration) (string, time.Time, error) {
+	if jkt == "" {
+		return "", time.Time{}, errors.New("empty jkt")
+	}
+	return p.signAccess(ctx, sub, role, nil, jkt, ttl)
+}
+
+// SignImpersonation — access-токен sub, выданный администратору actor (claim act)
+func (p *RSAProvider) SignImpersonation(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+	if actor == uuid.Nil {
+		return "", time.Time{}, errors.New("empty actor")
+	}
+	return p.signAccess(ctx, sub, role, &actClaim{Sub: actor.String()}, "", ttl)
+}
+
+func (p *RSAProvider) signAccess(ctx context.Context, sub uuid.UUID, role string, act *actClaim, jkt string, ttl time.Duration) (string, time.Time, error) {
 	if err := p.ensureActiveKey(ctx); err != nil {
 		return "", time.Time{}, err
 	}
-	now := p.now()
-	exp := now.Add(ttl)
+	// NumericDate в JWT — целые секунды: отбрасываем дробную часть сразу, чтобы iat
+	// в токене совпадал с тем, что сравнивается с водяным знаком (см. IsRevoked)
+	now := p.now().Truncate(time.Second)
+	exp := now.Add(ttl).Truncate(time.Second)
 
 	// Генерируем уникальный JTI для blacklist
 	jti := uuid.New().String()
 
+	var perms *[]string
+	if p.perms != nil {
+		list, err := p.perms.ListByRole(ctx, models.Role(role))
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		if list == nil {
+			list = []string{}
+		}
+		perms = &list
+	}
+
 	claims := customClaims{
-		Sub:  sub.String(),
-		Role: role,
+		Sub:   sub.String(),
+		Role:  role,
+		Perms: perms,
+		Act:   act,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        jti, // добавляем JTI
 			Issuer:    p.issuer,
@@ -205,6 +270,10 @@ func (p *RSAProvider) SignAccess(ctx context.Context, sub uuid.UUID, role string
 		},
 	}
 
+	if jkt != "" {
+		claims.Cnf = &cnfClaim{JKT: jkt}
+	}
+
 	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	t.Header["kid"] = p.activeKid
 
@@ -303,7 +372,43 @@ func (p *RSAProvider) ParseAndValidateAccess(ctx context.Context, token string)
 	if err != nil {
 		return nil, err
 	}
-	return &service.Claims{UserID: uid, Role: cc.Role, Exp: cc.ExpiresAt.Time}, nil
+
+	// Проверяем водяной знак отзыва (смена пароля, logout-all и т.п.)
+	if p.marks != nil {
+		if cc.IssuedAt == nil {
+			return nil, errors.New("token has no iat")
+		}
+		// при ошибке проверки токен не принимаем: иначе сбой БД вернул бы к жизни отозванные токены
+		revoked, err := p.marks.IsRevoked(ctx, uid, cc.IssuedAt.Time)
+		if err != nil {
+			return nil, fmt.Errorf("check token watermark: %w", err)
+		}
+		if revoked {
+			return nil, errors.New("token is revoked")
+		}
+	}
+	// Токены, выпущенные до появления perms (claim отсутствует), получают актуальные права роли
+	var perms []string
+	if cc.Perms != nil {
+		perms = *cc.Perms
+	} else if p.perms != nil {
+		if perms, err = p.perms.ListByRole(ctx, models.Role(cc.Role)); err != nil {
+			return nil, err
+		}
+	}
+	claims := &service.Claims{UserID: uid, Role: cc.Role, Perms: perms, Exp: cc.ExpiresAt.Time, ID: cc.ID}
+	if cc.IssuedAt != nil {
+		claims.IssuedAt = cc.IssuedAt.Time
+	}
+	if cc.Cnf != nil {
+		claims.JKT = cc.Cnf.JKT
+	}
+	if cc.Act != nil {
+		if claims.Actor, err = uuid.Parse(cc.Act.Sub); err != nil {
+			return nil, fmt.Errorf("invalid act claim: %w", err)
+		}
+	}
+	return claims, nil
 }
 
 func jwkToPublic(nB64, eB64 string) (*rsa.PublicKey, error) {
@@ -362,6 +467,9 @@ func publicKeyToPEM(pubKey *rsa.PublicKey) ([]byte, error) {
 	return pem.EncodeToMemory(pemBlock), nil
 }
 
+// CanBlacklist сообщает, доступен ли отзыв отдельных токенов (blacklist хранится в Redis)
+func (p *RSAProvider) CanBlacklist() bool { return p.cache != nil }
+
 // BlacklistToken добавляет токен в blacklist до его истечения
 func (p *RSAProvider) BlacklistToken(ctx context.Context, token string) error {
 	if p.cache == nil {
@@ -0,0 +1,115 @@
+package token
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WatermarkStore interface {
+	Bump(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error
+	Get(ctx context.Context, userID uuid.UUID) (*models.TokenWatermark, error)
+}
+
+type WatermarkCache interface {
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+	Get(ctx context.Context, key string) (string, error)
+	Del(ctx context.Context, keys ...string) error
+}
+
+// Watermarks хранит для каждого пользователя момент, раньше которого
+// выпущенные access-токены недействительны. Источник истины — БД,
+// Redis используется как кэш (в том числе для отрицательных ответов).
+type Watermarks struct {
+	store WatermarkStore
+	cache WatermarkCache
+	ttl   time.Duration
+}
+
+func NewWatermarks(store WatermarkStore, cacheTTL time.Duration) *Watermarks {
+	return &Watermarks{store: store, ttl: cacheTTL}
+}
+
+// SetCache устанавливает Redis кэш (опционально)
+func (w *Watermarks) SetCache(cache WatermarkCache) {
+	w.cache = cache
+}
+
+func watermarkKey(userID uuid.UUID) string {
+	return fmt.Sprintf("revoked_before:%s", userID)
+}
+
+// RevokeIssuedBefore сдвигает водяной знак пользователя на момент at.
+func (w *Watermarks) RevokeIssuedBefore(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error {
+	if err := w.store.Bump(ctx, userID, at, reason); err != nil {
+		return err
+	}
+	if w.cache == nil {
+		return nil
+	}
+
+	// перечитываем из БД: при гонке двух Bump в кэш должен попасть максимум
+	key := watermarkKey(userID)
+	if wm, err := w.store.Get(ctx, userID); err == nil && wm != nil {
+		if err := w.cache.Set(ctx, key, strconv.FormatInt(wm.RevokedBefore.UnixNano(), 10), w.ttl); err == nil {
+			return nil
+		}
+	}
+	// в кэше мог остаться прежний знак (или «0») — без удаления ключа отозванные
+	// токены принимались бы до истечения TTL
+	if err := w.cache.Del(ctx, key); err != nil {
+		return fmt.Errorf("invalidate watermark cache: %w", err)
+	}
+	return nil
+}
+
+// RevokedBefore возвращает водяной знак пользователя или нулевое время, если его нет.
+func (w *Watermarks) RevokedBefore(ctx context.Context, userID uuid.UUID) (time.Time, error) {
+	if w.cache != nil {
+		if v, err := w.cache.Get(ctx, watermarkKey(userID)); err == nil {
+			if ns, err := strconv.ParseInt(v, 10, 64); err == nil {
+				if ns == 0 {
+					return time.Time{}, nil
+				}
+				return time.Unix(0, ns), nil
+			}
+		}
+	}
+
+	wm, err := w.store.Get(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var at time.Time
+	if wm != nil {
+		at = wm.RevokedBefore
+	}
+
+	if w.cache != nil {
+		var ns int64
+		if !at.IsZero() {
+			ns = at.UnixNano()
+		}
+		_ = w.cache.Set(ctx, watermarkKey(userID), strconv.FormatInt(ns, 10), w.ttl)
+	}
+	return at, nil
+}
+
+// IsRevoked сообщает, отозван ли токен, выпущенный в issuedAt.
+// iat в JWT хранится в целых секундах, поэтому и знак сравнивается с точностью до секунды:
+// отозваны токены, выпущенные в более ранние секунды. Токен, выданный в ту же секунду
+// сразу после отзыва (новая пара после смены пароля), остаётся действительным.
+func (w *Watermarks) IsRevoked(ctx context.Context, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	at, err := w.RevokedBefore(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if at.IsZero() {
+		return false, nil
+	}
+	return issuedAt.Truncate(time.Second).Before(at.Truncate(time.Second)), nil
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"auth-service/internal/models"
 	"auth-service/internal/service"
 	"context"
 	"errors"
@@ -13,8 +14,11 @@ import (
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
 	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -37,20 +41,28 @@ func NewAuthServer(userService *service.AuthService, log *zap.Logger) *AuthServe
 	}
 }
 
+// logger добавляет к строкам лога request_id и trace_id вызова
+func (s *AuthServer) logger(ctx context.Context) *zap.Logger {
+	return correlation.Logger(ctx, s.log)
+}
+
 func (s *AuthServer) Register(ctx context.Context, req *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
-	s.log.Info("Registering user", zap.String("email", req.Email))
+	s.logger(ctx).Info("Registering user", zap.String("email", req.Email))
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid registration request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid registration request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 	u, err := s.userService.Register(ctx, req.Email, req.Password, "ROLE_CUSTOMER")
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrEmailExists):
-			s.log.Warn("failed", zap.String("op", "Register"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "Register"), zap.Error(err))
 			return nil, status.Errorf(codes.AlreadyExists, "user already exists: %v", err)
+		case errors.Is(err, service.ErrWeakPassword):
+			s.logger(ctx).Warn("failed", zap.String("op", "Register"), zap.Error(err))
+			return nil, weakPasswordStatus("password", err)
 		default:
-			s.log.Error("failed", zap.String("op", "Register"), zap.Error(err))
+			s.logger(ctx).Error("failed", zap.String("op", "Register"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
@@ -92,9 +104,9 @@ func toProtoRole(role string) commonv1.Role {
 	return commonv1.Role_ROLE_CUSTOMER
 }
 func (s *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
-	s.log.Info("Logging in user", zap.String("email", req.Email))
+	s.logger(ctx).Info("Logging in user", zap.String("email", req.Email))
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid login request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid login request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
@@ -106,6 +118,7 @@ func (s *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*auth
 		ClientID:  ptrNonEmpty(clientID),
 		IP:        ptrNonEmpty(ip),
 		UserAgent: ptrNonEmpty(ua),
+		DPoP:      fromProtoDPoP(req.Dpop),
 	}
 
 	grpc.SetHeader(ctx, metadata.Pairs("x-client-id", clientID))
@@ -124,13 +137,21 @@ func (s *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*auth
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrNotFound):
-			s.log.Warn("failed", zap.String("op", "Login"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "Login"), zap.Error(err))
 			return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
 		case errors.Is(err, service.ErrInvalidCredentials):
-			s.log.Warn("failed", zap.String("op", "Login"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "Login"), zap.Error(err))
 			return nil, status.Errorf(codes.Unauthenticated, "invalid credentials: %v", err)
+		case errors.Is(err, service.ErrAccountDisabled):
+			s.logger(ctx).Warn("failed", zap.String("op", "Login"), zap.Error(err))
+			return nil, status.Errorf(codes.PermissionDenied, "account disabled: %v", err)
+		case errors.Is(err, service.ErrPasswordResetRequired):
+			s.logger(ctx).Warn("failed", zap.String("op", "Login"), zap.Error(err))
+			return nil, status.Error(codes.FailedPrecondition, "password reset required")
+		case errors.Is(err, service.ErrInvalidDPoPProof), errors.Is(err, service.ErrUseDPoPNonce):
+			return nil, s.dpopStatusErr(ctx, "Login", err)
 		default:
-			s.log.Error("failed", zap.String("op", "Login"), zap.Error(err))
+			s.logger(ctx).Error("failed", zap.String("op", "Login"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
@@ -143,15 +164,19 @@ func (s *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*auth
 			RefreshToken:     tokenPair.RefreshOpaque,
 			AccessExpiresIn:  tokenPair.AccessExpiresAt.Unix(),
 			RefreshExpiresIn: tokenPair.RefreshExpiresAt.Unix(),
+			TokenType:        tokenPair.TokenType,
 		},
 	}
+	if meta.DPoP != nil {
+		s.setDPoPNonce(ctx)
+	}
 	return resp, nil
 }
 
 func (s *AuthServer) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.RefreshResponse, error) {
-	s.log.Info("Refreshing tokens", zap.String("refresh_token", req.RefreshToken))
+	s.logger(ctx).Info("Refreshing tokens", zap.String("refresh_token", req.RefreshToken))
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid refresh request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid refresh request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
@@ -163,6 +188,7 @@ func (s *AuthServer) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*
 		ClientID:  ptrNonEmpty(clientID),
 		IP:        ptrNonEmpty(ip),
 		UserAgent: ptrNonEmpty(ua),
+		DPoP:      fromProtoDPoP(req.Dpop),
 	}
 
 	grpc.SetHeader(ctx, metadata.Pairs("x-client-id", clientID))
@@ -181,13 +207,18 @@ func (s *AuthServer) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrNotFound):
-			s.log.Warn("failed", zap.String("op", "Refresh"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "Refresh"), zap.Error(err))
 			return nil, status.Errorf(codes.NotFound, "refresh token not found: %v", err)
 		case errors.Is(err, service.ErrTokenExpired):
-			s.log.Warn("failed", zap.String("op", "Refresh"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "Refresh"), zap.Error(err))
 			return nil, status.Errorf(codes.Unauthenticated, "refresh token expired: %v", err)
+		case errors.Is(err, service.ErrAccountDisabled):
+			s.logger(ctx).Warn("failed", zap.String("op", "Refresh"), zap.Error(err))
+			return nil, status.Errorf(codes.PermissionDenied, "account disabled: %v", err)
+		case errors.Is(err, service.ErrInvalidDPoPProof), errors.Is(err, service.ErrUseDPoPNonce):
+			return nil, s.dpopStatusErr(ctx, "Refresh", err)
 		default:
-			s.log.Error("failed", zap.String("op", "Refresh"), zap.Error(err))
+			s.logger(ctx).Error("failed", zap.String("op", "Refresh"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
@@ -199,16 +230,20 @@ func (s *AuthServer) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*
 			RefreshToken:     tokenPair.RefreshOpaque,
 			AccessExpiresIn:  tokenPair.AccessExpiresAt.Unix(),
 			RefreshExpiresIn: tokenPair.RefreshExpiresAt.Unix(),
+			TokenType:        tokenPair.TokenType,
 		},
 	}
+	if meta.DPoP != nil {
+		s.setDPoPNonce(ctx)
+	}
 	return resp, nil
 }
 
 func (s *AuthServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*emptypb.Empty, error) {
-	s.log.Info("Logging out", zap.String("request", fmt.Sprintf("%+v", req)))
+	s.logger(ctx).Info("Logging out", zap.String("request", fmt.Sprintf("%+v", req)))
 
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid refresh request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid refresh request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
@@ -231,24 +266,28 @@ func (s *AuthServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*em
 		if err := s.userService.LogoutWithAccessToken(ctx, refresh, accessToken); err != nil {
 			switch {
 			case errors.Is(err, service.ErrTokenNotFoundOrRevoked):
-				s.log.Warn("failed", zap.String("op", "Logout"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "Logout"), zap.Error(err))
 				return nil, status.Errorf(codes.NotFound, "refresh token not found or revoked: %v", err)
 			default:
-				s.log.Error("failed", zap.String("op", "Logout"), zap.Error(err))
+				s.logger(ctx).Error("failed", zap.String("op", "Logout"), zap.Error(err))
 				return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 			}
 		}
-		s.log.Info("refresh token revoked and access token blacklisted")
+		s.logger(ctx).Info("refresh token revoked and access token blacklisted")
 		return &emptypb.Empty{}, nil
 	}
 
 	if req.GetAll() { // mass logout
 		cnt, err := s.userService.LogoutAll(ctx)
+		if errors.Is(err, service.ErrImpersonationForbidden) {
+			s.logger(ctx).Warn("failed", zap.String("op", "LogoutAll"), zap.Error(err))
+			return nil, status.Error(codes.PermissionDenied, "operation is not allowed while impersonating")
+		}
 		if err != nil {
-			s.log.Error("failed", zap.String("op", "LogoutAll"), zap.Error(err))
+			s.logger(ctx).Error("failed", zap.String("op", "LogoutAll"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
-		s.log.Info("all refresh tokens revoked", zap.Int64("count", cnt))
+		s.logger(ctx).Info("all refresh tokens revoked", zap.Int64("count", cnt))
 		return &emptypb.Empty{}, nil
 	}
 
@@ -256,11 +295,11 @@ func (s *AuthServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*em
 }
 
 func (s *AuthServer) GetJwks(ctx context.Context, req *authv1.GetJwksRequest) (*authv1.GetJwksResponse, error) {
-	s.log.Info("Getting JWKS", zap.String("request", fmt.Sprintf("%+v", req)))
+	s.logger(ctx).Info("Getting JWKS", zap.String("request", fmt.Sprintf("%+v", req)))
 
 	keys, err := s.userService.GetJwks(ctx)
 	if err != nil {
-		s.log.Error("failed", zap.String("op", "GetJwks"), zap.Error(err))
+		s.logger(ctx).Error("failed", zap.String("op", "GetJwks"), zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
@@ -282,130 +321,1027 @@ func (s *AuthServer) GetJwks(ctx context.Context, req *authv1.GetJwksRequest) (*
 }
 
 func (s *AuthServer) Introspect(ctx context.Context, req *authv1.IntrospectRequest) (*authv1.IntrospectResponse, error) {
-	s.log.Info("Introspecting token", zap.String("request", fmt.Sprintf("%+v", req)))
+	s.logger(ctx).Info("Introspecting token", zap.String("request", fmt.Sprintf("%+v", req)))
 
-	active, uid, role, exp, err := s.userService.Introspect(ctx, req.AccessToken)
+	var (
+		active bool
+		claims *service.Claims
+		err    error
+	)
+	if req.Dpop != nil {
+		active, claims, err = s.userService.IntrospectDPoP(ctx, req.AccessToken, fromProtoDPoP(req.Dpop))
+		if err == nil {
+			s.setDPoPNonce(ctx)
+		}
+	} else {
+		active, claims, err = s.userService.Introspect(ctx, req.AccessToken)
+	}
+	if errors.Is(err, service.ErrUseDPoPNonce) {
+		return nil, s.dpopStatusErr(ctx, "Introspect", err)
+	}
 	if err != nil {
-		s.log.Error("failed", zap.String("op", "Introspect"), zap.Error(err))
+		s.logger(ctx).Error("failed", zap.String("op", "Introspect"), zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	userID := uid
-	if !active || userID == uuid.Nil {
-		userID = uuid.Nil
+	var c service.Claims
+	if active && claims != nil {
+		c = *claims
 	}
 
 	resp := &authv1.IntrospectResponse{
 		Active:  active,
-		UserId:  toProtoUUID(userID),
-		Role:    toProtoRole(role),
-		ExpUnix: exp.Unix(),
-		Scopes:  nil, // пока без scope
+		UserId:  toProtoUUID(c.UserID),
+		Role:    toProtoRole(c.Role),
+		ExpUnix: c.Exp.Unix(),
+		Scopes:  c.Perms, // права роли (RBAC)
+		Jkt:     c.JKT,
+	}
+	if c.Actor != uuid.Nil {
+		resp.ActorId = toProtoUUID(c.Actor)
 	}
 	return resp, nil
 }
 
 func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *authv1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
-	s.log.Info("Request password reset", zap.String("request", req.Email))
+	s.logger(ctx).Info("Request password reset", zap.String("request", req.Email))
 
 	if err := s.userService.RequestPasswordReset(ctx, req.Email); err != nil {
 		switch {
 		case errors.Is(err, service.ErrNotFound):
-			s.log.Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.NotFound, "user not found")
 		case errors.Is(err, service.ErrTooManyRequests):
-			s.log.Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.ResourceExhausted, "too many requests")
 		default:
-			s.log.Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "RequestPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
 
-	s.log.Info("request password reset send")
+	s.logger(ctx).Info("request password reset send")
 	return &emptypb.Empty{}, nil
 }
 
 func (s *AuthServer) ConfirmPasswordReset(ctx context.Context, req *authv1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
-	s.log.Info("Confirm password reset", zap.String("code", req.Code))
+	s.logger(ctx).Info("Confirm password reset", zap.String("code", req.Code))
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid confirm password request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid confirm password request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
 	if err := s.userService.ConfirmPasswordReset(ctx, req.Code, req.NewPassword); err != nil {
 		switch {
 		case errors.Is(err, service.ErrInvalidOrExpiredCode):
-			s.log.Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.InvalidArgument, "invalid or expired code")
 		case errors.Is(err, service.ErrNotFound):
-			s.log.Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.NotFound, "code not found")
+		case errors.Is(err, service.ErrWeakPassword):
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
+			return nil, weakPasswordStatus("new_password", err)
 		default:
-			s.log.Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmPasswordReset"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
 
-	s.log.Info("reset password confirmed")
+	s.logger(ctx).Info("reset password confirmed")
 	return &emptypb.Empty{}, nil
 }
 
 func (s *AuthServer) RequestEmailVerification(ctx context.Context, req *authv1.RequestEmailVerificationRequest) (*emptypb.Empty, error) {
-	s.log.Info("Request email verification", zap.String("email", req.Email))
+	s.logger(ctx).Info("Request email verification", zap.String("email", req.Email))
 	if req.Email == "" {
 		if err := s.userService.RequestEmailVerification(ctx); err != nil {
 			switch {
 			case errors.Is(err, service.ErrNotFound):
-				s.log.Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
 				return nil, status.Errorf(codes.NotFound, "user not found")
 			case errors.Is(err, service.ErrEmailAlreadyVerified):
-				s.log.Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
 				return nil, status.Errorf(codes.FailedPrecondition, "email already verified")
 			case errors.Is(err, service.ErrEmailVerificationInProgress):
-				s.log.Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
 				return nil, status.Errorf(codes.ResourceExhausted, "email verification in progress")
 			case errors.Is(err, service.ErrTooManyRequests):
-				s.log.Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
 				return nil, status.Errorf(codes.ResourceExhausted, "too many requests")
 			default:
-				s.log.Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
+				s.logger(ctx).Warn("failed", zap.String("op", "RequestEmailVerification"), zap.Error(err))
 				return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 			}
 		}
 	}
 
-	s.log.Info("request email verification sent")
+	s.logger(ctx).Info("request email verification sent")
 	return &emptypb.Empty{}, nil
 }
 
 func (s *AuthServer) ConfirmEmailVerification(ctx context.Context, req *authv1.ConfirmEmailVerificationRequest) (*emptypb.Empty, error) {
-	s.log.Info("Confirm email verification reset", zap.String("code", req.Code))
+	s.logger(ctx).Info("Confirm email verification reset", zap.String("code", req.Code))
 	if err := req.Validate(); err != nil {
-		s.log.Warn("Invalid confirm email verification request", zap.Error(err))
+		s.logger(ctx).Warn("Invalid confirm email verification request", zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
 	if err := s.userService.ConfirmEmailVerificationRequest(ctx, req.Code); err != nil {
 		switch {
 		case errors.Is(err, service.ErrInvalidOrExpiredCode):
-			s.log.Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
 			return nil, status.Errorf(codes.InvalidArgument, "invalid or expired code")
 		case errors.Is(err, service.ErrNotFound):
-			s.log.Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
 			return nil, status.Errorf(codes.NotFound, "user not found")
 		default:
-			s.log.Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
+			s.logger(ctx).Warn("failed", zap.String("op", "ConfirmEmailVerification"), zap.Error(err))
 			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
 		}
 	}
 
-	s.log.Info("email verification confirmed")
+	s.logger(ctx).Info("email verification confirmed")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) ListPermissions(ctx context.Context, req *authv1.ListPermissionsRequest) (*authv1.ListPermissionsResponse, error) {
+	perms, err := s.userService.ListPermissions(ctx)
+	if err != nil {
+		return nil, s.rbacStatusErr(ctx, "ListPermissions", err)
+	}
+
+	resp := &authv1.ListPermissionsResponse{Permissions: make([]*authv1.Permission, 0, len(perms))}
+	for _, p := range perms {
+		resp.Permissions = append(resp.Permissions, &authv1.Permission{Code: p.Code, Description: p.Description})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) ListRolePermissions(ctx context.Context, req *authv1.ListRolePermissionsRequest) (*authv1.ListRolePermissionsResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid list role permissions request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	perms, err := s.userService.ListRolePermissions(ctx, models.Role(req.Role.String()))
+	if err != nil {
+		return nil, s.rbacStatusErr(ctx, "ListRolePermissions", err)
+	}
+	return &authv1.ListRolePermissionsResponse{Role: req.Role, Permissions: perms}, nil
+}
+
+func (s *AuthServer) GrantRolePermission(ctx context.Context, req *authv1.GrantRolePermissionRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid grant role permission request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	if err := s.userService.GrantRolePermission(ctx, models.Role(req.Role.String()), req.Permission); err != nil {
+		return nil, s.rbacStatusErr(ctx, "GrantRolePermission", err)
+	}
+	s.logger(ctx).Info("role permission granted", zap.String("role", req.Role.String()), zap.String("permission", req.Permission))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) RevokeRolePermission(ctx context.Context, req *authv1.RevokeRolePermissionRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid revoke role permission request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	if err := s.userService.RevokeRolePermission(ctx, models.Role(req.Role.String()), req.Permission); err != nil {
+		return nil, s.rbacStatusErr(ctx, "RevokeRolePermission", err)
+	}
+	s.logger(ctx).Info("role permission revoked", zap.String("role", req.Role.String()), zap.String("permission", req.Permission))
 	return &emptypb.Empty{}, nil
 }
 
+func (s *AuthServer) SetUserRole(ctx context.Context, req *authv1.SetUserRoleRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid set user role request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	userID, err := uuid.Parse(req.UserId.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	if err := s.userService.SetUserRole(ctx, userID, models.Role(req.Role.String())); err != nil {
+		return nil, s.userAdminStatusErr(ctx, "SetUserRole", err)
+	}
+	s.logger(ctx).Info("user role changed", zap.String("user_id", userID.String()), zap.String("role", req.Role.String()))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) DisableUser(ctx context.Context, req *authv1.DisableUserRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid disable user request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	userID, err := uuid.Parse(req.UserId.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	if err := s.userService.BlockUser(ctx, userID); err != nil {
+		return nil, s.userAdminStatusErr(ctx, "DisableUser", err)
+	}
+	s.logger(ctx).Info("user disabled", zap.String("user_id", userID.String()))
+	return &emptypb.Empty{}, nil
+}
+
+// Impersonate выдаёт администратору короткоживущий токен от имени пользователя
+func (s *AuthServer) Impersonate(ctx context.Context, req *authv1.ImpersonateRequest) (*authv1.ImpersonateResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid impersonate request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	userID, err := uuid.Parse(req.UserId.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	token, exp, err := s.userService.Impersonate(ctx, userID, req.Reason, clientIPFromContext(ctx))
+	if err != nil {
+		return nil, s.userAdminStatusErr(ctx, "Impersonate", err)
+	}
+	actorID, _ := service.UserIDFromContext(ctx)
+	return &authv1.ImpersonateResponse{
+		AccessToken: token,
+		ExpUnix:     exp.Unix(),
+		ActorId:     toProtoUUID(actorID),
+	}, nil
+}
+
+func (s *AuthServer) userAdminStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrImpersonationForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "operation is not allowed while impersonating")
+	case errors.Is(err, service.ErrAccountDisabled):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "account disabled")
+	case errors.Is(err, service.ErrForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func (s *AuthServer) rbacStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, service.ErrPermissionNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "permission not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func (s *AuthServer) DeleteAccount(ctx context.Context, req *authv1.DeleteAccountRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid delete account request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	if err := s.userService.DeleteAccount(ctx, req.Password); err != nil {
+		return nil, s.accountStatusErr(ctx, "DeleteAccount", err)
+	}
+	s.logger(ctx).Info("account deleted")
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) ExportMyData(ctx context.Context, req *authv1.ExportMyDataRequest) (*authv1.ExportMyDataResponse, error) {
+	data, at, err := s.userService.ExportMyData(ctx)
+	if err != nil {
+		return nil, s.accountStatusErr(ctx, "ExportMyData", err)
+	}
+	return &authv1.ExportMyDataResponse{Data: data, GeneratedAt: timestamppb.New(at)}, nil
+}
+
+func (s *AuthServer) accountStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrImpersonationForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "operation is not allowed while impersonating")
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrInvalidCredentials):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "invalid password")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func (s *AuthServer) SubmitVendorApplication(ctx context.Context, req *authv1.SubmitVendorApplicationRequest) (*authv1.VendorApplication, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid submit vendor application request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	app, err := s.userService.SubmitVendorApplication(ctx, &models.VendorApplication{
+		CompanyName: strings.TrimSpace(req.CompanyName),
+		TaxID:       req.TaxId,
+		Website:     strings.TrimSpace(req.Website),
+		Phone:       strings.TrimSpace(req.Phone),
+		Description: strings.TrimSpace(req.Description),
+	})
+	if err != nil {
+		return nil, s.vendorStatusErr(ctx, "SubmitVendorApplication", err)
+	}
+	s.logger(ctx).Info("vendor application submitted", zap.String("application_id", app.ID.String()), zap.String("user_id", app.UserID.String()))
+	return toProtoVendorApplication(app), nil
+}
+
+func (s *AuthServer) ListVendorApplications(ctx context.Context, req *authv1.ListVendorApplicationsRequest) (*authv1.ListVendorApplicationsResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid list vendor applications request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	apps, total, err := s.userService.ListVendorApplications(ctx, fromProtoVendorStatus(req.Status), int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, s.vendorStatusErr(ctx, "ListVendorApplications", err)
+	}
+
+	resp := &authv1.ListVendorApplicationsResponse{
+		Applications: make([]*authv1.VendorApplication, 0, len(apps)),
+		Total:        int32(total),
+	}
+	for i := range apps {
+		resp.Applications = append(resp.Applications, toProtoVendorApplication(&apps[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) ApproveVendorApplication(ctx context.Context, req *authv1.ApproveVendorApplicationRequest) (*authv1.VendorApplication, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid approve vendor application request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	id, err := uuid.Parse(req.Id.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid application id")
+	}
+
+	app, err := s.userService.ApproveVendorApplication(ctx, id)
+	if err != nil {
+		return nil, s.vendorStatusErr(ctx, "ApproveVendorApplication", err)
+	}
+	s.logger(ctx).Info("vendor application approved", zap.String("application_id", app.ID.String()), zap.String("user_id", app.UserID.String()))
+	return toProtoVendorApplication(app), nil
+}
+
+func (s *AuthServer) RejectVendorApplication(ctx context.Context, req *authv1.RejectVendorApplicationRequest) (*authv1.VendorApplication, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid reject vendor application request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	id, err := uuid.Parse(req.Id.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid application id")
+	}
+
+	app, err := s.userService.RejectVendorApplication(ctx, id, strings.TrimSpace(req.Reason))
+	if err != nil {
+		return nil, s.vendorStatusErr(ctx, "RejectVendorApplication", err)
+	}
+	s.logger(ctx).Info("vendor application rejected", zap.String("application_id", app.ID.String()), zap.String("user_id", app.UserID.String()))
+	return toProtoVendorApplication(app), nil
+}
+
+func (s *AuthServer) vendorStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, service.ErrAlreadyVendor):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "user is already a vendor")
+	case errors.Is(err, service.ErrGuestAccount):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "guest account must complete registration first")
+	case errors.Is(err, service.ErrVendorApplicationPending):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.AlreadyExists, "vendor application already pending")
+	case errors.Is(err, service.ErrVendorApplicationReviewed):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "vendor application already reviewed")
+	case errors.Is(err, service.ErrVendorApplicationNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "vendor application not found")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func toProtoVendorApplication(a *models.VendorApplication) *authv1.VendorApplication {
+	out := &authv1.VendorApplication{
+		Id:          toProtoUUID(a.ID),
+		UserId:      toProtoUUID(a.UserID),
+		CompanyName: a.CompanyName,
+		TaxId:       a.TaxID,
+		Website:     a.Website,
+		Phone:       a.Phone,
+		Description: a.Description,
+		Status:      toProtoVendorStatus(a.Status),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+	if a.RejectReason != nil {
+		out.RejectReason = *a.RejectReason
+	}
+	if a.ReviewedAt != nil {
+		out.ReviewedAt = timestamppb.New(*a.ReviewedAt)
+	}
+	return out
+}
+
+func toProtoVendorStatus(st models.VendorApplicationStatus) authv1.VendorApplicationStatus {
+	if v, ok := authv1.VendorApplicationStatus_value["VENDOR_APPLICATION_STATUS_"+string(st)]; ok {
+		return authv1.VendorApplicationStatus(v)
+	}
+	return authv1.VendorApplicationStatus_VENDOR_APPLICATION_STATUS_UNSPECIFIED
+}
+
+// fromProtoVendorStatus — UNSPECIFIED превращается в пустой фильтр («все статусы»)
+func fromProtoVendorStatus(st authv1.VendorApplicationStatus) models.VendorApplicationStatus {
+	if st == authv1.VendorApplicationStatus_VENDOR_APPLICATION_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return models.VendorApplicationStatus(strings.TrimPrefix(st.String(), "VENDOR_APPLICATION_STATUS_"))
+}
+
+func (s *AuthServer) CreateApiKey(ctx context.Context, req *authv1.CreateApiKeyRequest) (*authv1.CreateApiKeyResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid create api key request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	key, secret, err := s.userService.CreateAPIKey(ctx, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, s.apiKeyStatusErr(ctx, "CreateApiKey", err)
+	}
+	s.logger(ctx).Info("api key created", zap.String("key_id", key.ID.String()), zap.String("user_id", key.UserID.String()))
+	return &authv1.CreateApiKeyResponse{ApiKey: toProtoAPIKey(key), Key: secret}, nil
+}
+
+func (s *AuthServer) ListApiKeys(ctx context.Context, req *authv1.ListApiKeysRequest) (*authv1.ListApiKeysResponse, error) {
+	keys, err := s.userService.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, s.apiKeyStatusErr(ctx, "ListApiKeys", err)
+	}
+	resp := &authv1.ListApiKeysResponse{Keys: make([]*authv1.ApiKey, 0, len(keys))}
+	for i := range keys {
+		resp.Keys = append(resp.Keys, toProtoAPIKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) RevokeApiKey(ctx context.Context, req *authv1.RevokeApiKeyRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid revoke api key request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	id, err := uuid.Parse(req.Id.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid api key id")
+	}
+
+	if err := s.userService.RevokeAPIKey(ctx, id); err != nil {
+		return nil, s.apiKeyStatusErr(ctx, "RevokeApiKey", err)
+	}
+	s.logger(ctx).Info("api key revoked", zap.String("key_id", id.String()))
+	return &emptypb.Empty{}, nil
+}
+
+// ResolveApiKey отвечает active=false на неизвестный, отозванный или просроченный ключ,
+// как Introspect на недействительный токен.
+func (s *AuthServer) ResolveApiKey(ctx context.Context, req *authv1.ResolveApiKeyRequest) (*authv1.ResolveApiKeyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return &authv1.ResolveApiKeyResponse{Active: false}, nil
+	}
+
+	id, err := s.userService.ResolveAPIKey(ctx, req.Key, req.Ip)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidAPIKey) {
+			return &authv1.ResolveApiKeyResponse{Active: false}, nil
+		}
+		s.logger(ctx).Error("failed", zap.String("op", "ResolveApiKey"), zap.Error(err))
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &authv1.ResolveApiKeyResponse{
+		Active: true,
+		UserId: toProtoUUID(id.UserID),
+		Role:   toProtoRole(string(id.Role)),
+		Scopes: id.Scopes,
+		KeyId:  toProtoUUID(id.KeyID),
+	}
+	if id.ExpiresAt != nil {
+		resp.ExpUnix = id.ExpiresAt.Unix()
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) apiKeyStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrImpersonationForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "operation is not allowed while impersonating")
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "api keys can only be managed from a login session")
+	case errors.Is(err, service.ErrScopeNotGranted):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "scope is not granted to the user")
+	case errors.Is(err, service.ErrInvalidExpiry):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	case errors.Is(err, service.ErrAPIKeyNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "api key not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func toProtoAPIKey(k *models.APIKey) *authv1.ApiKey {
+	out := &authv1.ApiKey{
+		Id:        toProtoUUID(k.ID),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if k.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		out.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.LastUsedIP != nil {
+		out.LastUsedIp = *k.LastUsedIP
+	}
+	if k.RevokedAt != nil {
+		out.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return out
+}
+
+func (s *AuthServer) ListTrustedDevices(ctx context.Context, req *authv1.ListTrustedDevicesRequest) (*authv1.ListTrustedDevicesResponse, error) {
+	devices, err := s.userService.ListTrustedDevices(ctx)
+	if err != nil {
+		return nil, s.deviceStatusErr(ctx, "ListTrustedDevices", err)
+	}
+	resp := &authv1.ListTrustedDevicesResponse{Devices: make([]*authv1.TrustedDevice, 0, len(devices))}
+	for i := range devices {
+		resp.Devices = append(resp.Devices, toProtoTrustedDevice(&devices[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) ForgetTrustedDevice(ctx context.Context, req *authv1.ForgetTrustedDeviceRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid forget device request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	id, err := uuid.Parse(req.Id.GetValue())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid device id")
+	}
+	if err := s.userService.ForgetTrustedDevice(ctx, id); err != nil {
+		return nil, s.deviceStatusErr(ctx, "ForgetTrustedDevice", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ReportSignIn — публичный метод: ссылка из письма сама является доказательством
+func (s *AuthServer) ReportSignIn(ctx context.Context, req *authv1.ReportSignInRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid report sign-in request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	if err := s.userService.ReportSignIn(ctx, req.Token); err != nil {
+		return nil, s.deviceStatusErr(ctx, "ReportSignIn", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) deviceStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrDeviceNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "trusted device not found")
+	case errors.Is(err, service.ErrInvalidAlertToken):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.InvalidArgument, "invalid or expired link")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func toProtoTrustedDevice(d *models.TrustedDevice) *authv1.TrustedDevice {
+	out := &authv1.TrustedDevice{
+		Id:          toProtoUUID(d.ID),
+		ClientId:    d.ClientID,
+		IpNetwork:   d.IPNetwork,
+		FirstSeenAt: timestamppb.New(d.FirstSeenAt),
+		LastSeenAt:  timestamppb.New(d.LastSeenAt),
+	}
+	if d.UserAgent != nil {
+		out.UserAgent = *d.UserAgent
+	}
+	return out
+}
+
+// CreateGuest — публичный метод: гость получает токены без email и пароля
+func (s *AuthServer) CreateGuest(ctx context.Context, req *authv1.CreateGuestRequest) (*authv1.CreateGuestResponse, error) {
+	clientID := clientIDFromContextOrGenerate(ctx)
+	meta := service.ClientMeta{
+		ClientID:  ptrNonEmpty(clientID),
+		IP:        ptrNonEmpty(clientIPFromContext(ctx)),
+		UserAgent: ptrNonEmpty(userAgentFromContext(ctx)),
+	}
+	grpc.SetHeader(ctx, metadata.Pairs("x-client-id", clientID))
+
+	id, pair, err := s.userService.CreateGuest(ctx, meta)
+	if err != nil {
+		if errors.Is(err, service.ErrTooManyRequests) {
+			s.logger(ctx).Warn("failed", zap.String("op", "CreateGuest"), zap.Error(err))
+			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		}
+		s.logger(ctx).Error("failed", zap.String("op", "CreateGuest"), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+	return &authv1.CreateGuestResponse{
+		UserId: toProtoUUID(id),
+		Tokens: &authv1.TokenPair{
+			AccessToken:      pair.AccessToken,
+			RefreshToken:     pair.RefreshOpaque,
+			AccessExpiresIn:  pair.AccessExpiresAt.Unix(),
+			RefreshExpiresIn: pair.RefreshExpiresAt.Unix(),
+		},
+	}, nil
+}
+
+func (s *AuthServer) UpgradeGuest(ctx context.Context, req *authv1.UpgradeGuestRequest) (*authv1.UpgradeGuestResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid upgrade guest request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	u, err := s.userService.UpgradeGuest(ctx, req.Email, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnauthenticated):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+		case errors.Is(err, service.ErrForbidden), errors.Is(err, service.ErrImpersonationForbidden):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, service.ErrNotGuest):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Error(codes.FailedPrecondition, "user is not a guest")
+		case errors.Is(err, service.ErrEmailExists):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Error(codes.AlreadyExists, "user already exists")
+		case errors.Is(err, service.ErrWeakPassword):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, weakPasswordStatus("password", err)
+		case errors.Is(err, service.ErrNotFound):
+			s.logger(ctx).Warn("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			s.logger(ctx).Error("failed", zap.String("op", "UpgradeGuest"), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+		}
+	}
+	return &authv1.UpgradeGuestResponse{UserId: toProtoUUID(u.ID), Email: u.Email}, nil
+}
+
+func (s *AuthServer) GetMe(ctx context.Context, req *authv1.GetMeRequest) (*authv1.Me, error) {
+	u, err := s.userService.GetMe(ctx)
+	if err != nil {
+		return nil, s.profileStatusErr(ctx, "GetMe", err)
+	}
+	return toProtoMe(u), nil
+}
+
+func (s *AuthServer) UpdateMe(ctx context.Context, req *authv1.UpdateMeRequest) (*authv1.Me, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid update me request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	u, err := s.userService.UpdateMe(ctx, service.ProfileUpdate{
+		DisplayName:      req.DisplayName,
+		Phone:            req.Phone,
+		Locale:           req.Locale,
+		TimeZone:         req.TimeZone,
+		MarketingConsent: req.MarketingConsent,
+	})
+	if err != nil {
+		return nil, s.profileStatusErr(ctx, "UpdateMe", err)
+	}
+	return toProtoMe(u), nil
+}
+
+func (s *AuthServer) profileStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrImpersonationForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "marketing consent cannot be changed while impersonating")
+	case errors.Is(err, service.ErrInvalidTimeZone):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.InvalidArgument, "unknown time zone")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func (s *AuthServer) RequestPhoneVerification(ctx context.Context, req *authv1.RequestPhoneVerificationRequest) (*authv1.RequestPhoneVerificationResponse, error) {
+	expiresAt, err := s.userService.RequestPhoneVerification(ctx)
+	if err != nil {
+		return nil, s.phoneStatusErr(ctx, "RequestPhoneVerification", err)
+	}
+	return &authv1.RequestPhoneVerificationResponse{ExpiresAt: timestamppb.New(expiresAt)}, nil
+}
+
+func (s *AuthServer) ConfirmPhoneVerification(ctx context.Context, req *authv1.ConfirmPhoneVerificationRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid confirm phone verification request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	if err := s.userService.ConfirmPhoneVerification(ctx, req.Code); err != nil {
+		return nil, s.phoneStatusErr(ctx, "ConfirmPhoneVerification", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) phoneStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrPhoneNotSet):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "phone number is not set")
+	case errors.Is(err, service.ErrPhoneAlreadyVerified):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "phone already verified")
+	case errors.Is(err, service.ErrTooManyRequests):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "too many requests")
+	case errors.Is(err, service.ErrVerificationAttemptsExceeded):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "too many verification attempts, request a new code")
+	case errors.Is(err, service.ErrInvalidOrExpiredCode):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.InvalidArgument, "invalid or expired code")
+	case errors.Is(err, service.ErrPhoneVerificationDisabled):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unimplemented, "phone verification is not configured")
+	case errors.Is(err, service.ErrNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "user not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func toProtoMe(u *models.User) *authv1.Me {
+	out := &authv1.Me{
+		UserId:           toProtoUUID(u.ID),
+		Email:            u.Email,
+		Role:             toProtoRole(string(u.Role)),
+		IsEmailVerified:  u.IsEmailVerified,
+		IsGuest:          u.IsGuest,
+		Locale:           u.Locale,
+		TimeZone:         u.TimeZone,
+		MarketingConsent: u.MarketingConsent,
+		IsPhoneVerified:  u.IsPhoneVerified,
+		CreatedAt:        timestamppb.New(u.CreatedAt),
+	}
+	if u.DisplayName != nil {
+		out.DisplayName = *u.DisplayName
+	}
+	if u.Phone != nil {
+		out.Phone = *u.Phone
+	}
+	if u.MarketingConsentAt != nil {
+		out.MarketingConsentAt = timestamppb.New(*u.MarketingConsentAt)
+	}
+	return out
+}
+
+// IntrospectToken — публичный метод: resource server аутентифицируется client_id/client_secret.
+// Недействительный токен — не ошибка, а active=false (RFC 7662).
+func (s *AuthServer) IntrospectToken(ctx context.Context, req *authv1.IntrospectTokenRequest) (*authv1.IntrospectTokenResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid introspect token request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	info, err := s.userService.IntrospectToken(ctx, req.Client.ClientId, req.Client.ClientSecret, req.Token)
+	if err != nil {
+		return nil, s.oauthStatusErr(ctx, "IntrospectToken", err)
+	}
+	if !info.Active {
+		return &authv1.IntrospectTokenResponse{Active: false}, nil
+	}
+	return &authv1.IntrospectTokenResponse{
+		Active:    true,
+		Scope:     strings.Join(info.Scope, " "),
+		ClientId:  info.ClientID,
+		Sub:       info.Subject,
+		Iat:       info.IssuedAt.Unix(),
+		Exp:       info.ExpiresAt.Unix(),
+		Jti:       info.JTI,
+		TokenType: info.TokenType,
+		ActSub:    info.Actor,
+	}, nil
+}
+
+// RevokeToken — публичный метод, отвечает успехом и на уже недействительный токен (RFC 7009)
+func (s *AuthServer) RevokeToken(ctx context.Context, req *authv1.RevokeTokenRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid revoke token request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	if err := s.userService.RevokeToken(ctx, req.Client.ClientId, req.Client.ClientSecret, req.Token); err != nil {
+		return nil, s.oauthStatusErr(ctx, "RevokeToken", err)
+	}
+	s.logger(ctx).Info("token revoked by oauth client", zap.String("client_id", req.Client.ClientId))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) CreateOAuthClient(ctx context.Context, req *authv1.CreateOAuthClientRequest) (*authv1.CreateOAuthClientResponse, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid create oauth client request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	client, secret, err := s.userService.CreateOAuthClient(ctx, req.Name)
+	if err != nil {
+		return nil, s.oauthStatusErr(ctx, "CreateOAuthClient", err)
+	}
+	s.logger(ctx).Info("oauth client created", zap.String("client_id", client.ClientID))
+	return &authv1.CreateOAuthClientResponse{Client: toProtoOAuthClient(client), ClientSecret: secret}, nil
+}
+
+func (s *AuthServer) ListOAuthClients(ctx context.Context, req *authv1.ListOAuthClientsRequest) (*authv1.ListOAuthClientsResponse, error) {
+	clients, err := s.userService.ListOAuthClients(ctx)
+	if err != nil {
+		return nil, s.oauthStatusErr(ctx, "ListOAuthClients", err)
+	}
+	resp := &authv1.ListOAuthClientsResponse{Clients: make([]*authv1.OAuthClient, 0, len(clients))}
+	for i := range clients {
+		resp.Clients = append(resp.Clients, toProtoOAuthClient(&clients[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) DeleteOAuthClient(ctx context.Context, req *authv1.DeleteOAuthClientRequest) (*emptypb.Empty, error) {
+	if err := req.Validate(); err != nil {
+		s.logger(ctx).Warn("Invalid delete oauth client request", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	if err := s.userService.DeleteOAuthClient(ctx, req.ClientId); err != nil {
+		return nil, s.oauthStatusErr(ctx, "DeleteOAuthClient", err)
+	}
+	s.logger(ctx).Info("oauth client deleted", zap.String("client_id", req.ClientId))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AuthServer) oauthStatusErr(ctx context.Context, op string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidClient):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "invalid client credentials")
+	case errors.Is(err, service.ErrUnauthenticated):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	case errors.Is(err, service.ErrForbidden):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.PermissionDenied, "permission denied")
+	case errors.Is(err, service.ErrOAuthClientNotFound):
+		s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+		return status.Error(codes.NotFound, "oauth client not found")
+	default:
+		s.logger(ctx).Error("failed", zap.String("op", op), zap.Error(err))
+		return status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+}
+
+func toProtoOAuthClient(c *models.OAuthClient) *authv1.OAuthClient {
+	out := &authv1.OAuthClient{
+		ClientId:  c.ClientID,
+		Name:      c.Name,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+	if c.LastUsedAt != nil {
+		out.LastUsedAt = timestamppb.New(*c.LastUsedAt)
+	}
+	return out
+}
+
 // -------------------------------УТИЛИТЫ----------------------------------
 
+// weakPasswordStatus — InvalidArgument с нарушениями политики в деталях BadRequest,
+// чтобы gateway вернул их клиенту как ошибки поля
+func fromProtoDPoP(p *authv1.DPoPProof) *service.DPoPProof {
+	if p == nil || p.Proof == "" {
+		return nil
+	}
+	return &service.DPoPProof{Proof: p.Proof, Method: p.Htm, URL: p.Htu}
+}
+
+// setDPoPNonce отдаёт свежий nonce в трейлере "dpop-nonce"; gateway переносит его в заголовок
+// DPoP-Nonce, и клиент подписывает им следующее доказательство
+func (s *AuthServer) setDPoPNonce(ctx context.Context) {
+	nonce, err := s.userService.NewDPoPNonce(ctx)
+	if err != nil {
+		s.logger(ctx).Warn("failed to issue dpop nonce", zap.Error(err))
+		return
+	}
+	if nonce != "" {
+		grpc.SetTrailer(ctx, metadata.Pairs("dpop-nonce", nonce))
+	}
+}
+
+func (s *AuthServer) dpopStatusErr(ctx context.Context, op string, err error) error {
+	s.logger(ctx).Warn("failed", zap.String("op", op), zap.Error(err))
+	if errors.Is(err, service.ErrUseDPoPNonce) {
+		// nonce для повтора уходит вместе с ошибкой (RFC 9449, 8)
+		s.setDPoPNonce(ctx)
+		return status.Error(codes.FailedPrecondition, "use_dpop_nonce")
+	}
+	return status.Error(codes.InvalidArgument, "invalid_dpop_proof")
+}
+
+func weakPasswordStatus(field string, err error) error {
+	st := status.New(codes.InvalidArgument, "password does not satisfy the policy")
+	var perr *service.PasswordPolicyError
+	if !errors.As(err, &perr) {
+		return st.Err()
+	}
+	br := &errdetails.BadRequest{}
+	for _, v := range perr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: v.Message,
+			Reason:      v.Code,
+		})
+	}
+	if withDetails, derr := st.WithDetails(br); derr == nil {
+		return withDetails.Err()
+	}
+	return st.Err()
+}
+
 func clientIPFromContext(ctx context.Context) string {
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		// x-forwarded-for может быть "ip1, ip2, ..."
@@ -6,6 +6,8 @@ import (
 
 	"auth-service/internal/service"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,7 +19,16 @@ type AuthDeps interface {
 	ParseAndValidateAccess(ctx context.Context, token string) (*service.Claims, error)
 }
 
-func NewAuthUnaryServerInterceptor(tokens AuthDeps) grpc.UnaryServerInterceptor {
+// APIKeyResolver проверяет персональные API-ключи (схема "ApiKey")
+type APIKeyResolver interface {
+	ResolveAPIKey(ctx context.Context, secret, ip string) (*service.APIKeyIdentity, error)
+}
+
+// NewAuthUnaryServerInterceptor принимает "Bearer <jwt>" и, если keys не nil, "ApiKey <ключ>".
+// Результат проверки ключа кэшируется на apikey.DefaultCacheTTL.
+func NewAuthUnaryServerInterceptor(tokens AuthDeps, keys APIKeyResolver) grpc.UnaryServerInterceptor {
+	keyCache := apikey.NewCache(apikey.DefaultCacheTTL)
+
 	public := map[string]struct{}{
 		"/auth.v1.AuthService/Register": {},
 		"/auth.v1.AuthService/Login":    {},
@@ -28,8 +39,14 @@ func NewAuthUnaryServerInterceptor(tokens AuthDeps) grpc.UnaryServerInterceptor
 		"/auth.v1.AuthService/RequestPasswordReset":     {},
 		"/auth.v1.AuthService/ConfirmPasswordReset":     {},
 		"/auth.v1.AuthService/Introspect":               {}, // если хочешь — оставь публичным
+		"/auth.v1.AuthService/ResolveApiKey":            {},
+		"/auth.v1.AuthService/ReportSignIn":             {},
+		"/auth.v1.AuthService/CreateGuest":              {},
+		"/auth.v1.AuthService/IntrospectToken":          {}, // клиент аутентифицируется client_id/client_secret
+		"/auth.v1.AuthService/RevokeToken":              {},
 		"/grpc.health.v1.Health/Check":                  {},
 		"/grpc.health.v1.Health/List":                   {},
+		"/grpc.health.v1.Health/Watch":                  {},
 	}
 
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
@@ -49,18 +66,41 @@ func NewAuthUnaryServerInterceptor(tokens AuthDeps) grpc.UnaryServerInterceptor
 		if !ok {
 			return nil, status.Errorf(codes.Unauthenticated, "missing metadata (method=%s)", info.FullMethod)
 		}
-		authz := getFirst(md, "authorization")
-		if authz == "" {
+		header := getFirst(md, "authorization")
+		if header == "" {
 			return nil, status.Errorf(codes.Unauthenticated, "authorization header not found (method=%s)", info.FullMethod)
 		}
-		prefix := "bearer "
-		if len(authz) < len(prefix) || !strings.EqualFold(authz[:len(prefix)], prefix) {
+		scheme, credential, ok := apikey.ParseAuthorization(header)
+		if !ok || (scheme == apikey.SchemeAPIKey && keys == nil) {
 			return nil, status.Error(codes.Unauthenticated, "invalid authorization scheme")
 		}
-		access := strings.TrimSpace(authz[len(prefix):])
-		if access == "" {
-			return nil, status.Error(codes.Unauthenticated, "empty bearer token")
+		if scheme == apikey.SchemeAPIKey {
+			id, ok := keyCache.Get(credential)
+			if !ok {
+				resolved, err := keys.ResolveAPIKey(ctx, credential, clientIPFromContext(ctx))
+				if err != nil {
+					return nil, status.Errorf(codes.Unauthenticated, "invalid api key: %v", err)
+				}
+				id = apikey.Identity{
+					KeyID:  resolved.KeyID.String(),
+					UserID: resolved.UserID.String(),
+					Role:   string(resolved.Role),
+					Scopes: resolved.Scopes,
+				}
+				if resolved.ExpiresAt != nil {
+					id.ExpiresAt = *resolved.ExpiresAt
+				}
+				keyCache.Set(credential, id)
+			}
+			uid, _ := uuid.Parse(id.UserID)
+			keyID, _ := uuid.Parse(id.KeyID)
+			ctx = service.WithUserID(ctx, uid)
+			ctx = service.WithRole(ctx, id.Role)
+			ctx = service.WithAPIKeyID(ctx, keyID)
+			ctx = authz.WithPermissions(ctx, id.Scopes)
+			return handler(ctx, req)
 		}
+		access := credential
 
 		claims, err := tokens.ParseAndValidateAccess(ctx, access)
 		if err != nil {
@@ -71,9 +111,15 @@ func NewAuthUnaryServerInterceptor(tokens AuthDeps) grpc.UnaryServerInterceptor
 			return nil, status.Error(codes.Unauthenticated, "invalid subject")
 		}
 
-		// Положим идентичность в контекст
+		// Положим идентичность и права в контекст
 		ctx = service.WithUserID(ctx, uid)
-		// по желанию: ctx = context.WithValue(ctx, ctxRoleKey, claims.Role)
+		ctx = service.WithRole(ctx, claims.Role)
+		ctx = authz.WithPermissions(ctx, claims.Perms)
+		if claims.Actor != uuid.Nil {
+			// токен имперсонации: запоминаем администратора для запретов и аудита
+			ctx = service.WithActorID(ctx, claims.Actor)
+			ctx = authz.WithActor(ctx, claims.Actor.String())
+		}
 
 		return handler(ctx, req)
 	}
@@ -0,0 +1,50 @@
+package cleanup_test
+
+import (
+	"auth-service/internal/cleanup"
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Defaults(t *testing.T) {
+	got, err := cleanup.ParseSchedule("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := cleanup.DefaultSchedule()
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for job, every := range want {
+		if got[job] != every {
+			t.Errorf("%s: expected %s, got %s", job, every, got[job])
+		}
+	}
+}
+
+func TestParseSchedule_Overrides(t *testing.T) {
+	got, err := cleanup.ParseSchedule(" expired=15m, guests=1d ,sessions=off")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got[cleanup.JobExpired] != 15*time.Minute {
+		t.Errorf("expired: got %s", got[cleanup.JobExpired])
+	}
+	if got[cleanup.JobGuests] != 24*time.Hour {
+		t.Errorf("guests: got %s", got[cleanup.JobGuests])
+	}
+	if _, ok := got[cleanup.JobSessions]; ok {
+		t.Error("sessions must be disabled")
+	}
+	if got[cleanup.JobConsumed] != 6*time.Hour {
+		t.Errorf("consumed must keep default, got %s", got[cleanup.JobConsumed])
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, s := range []string{"unknown=1h", "expired", "expired=soon", "expired=0s", "expired=-5m"} {
+		if _, err := cleanup.ParseSchedule(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package outbox_test
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/outbox"
+	"auth-service/internal/producer"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// memStore — outbox в памяти
+type memStore struct {
+	events  []models.UserEventOutbox
+	listErr error
+}
+
+func (s *memStore) ListPending(ctx context.Context, now time.Time, limit int) ([]models.UserEventOutbox, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	var out []models.UserEventOutbox
+	for _, e := range s.events {
+		if e.SentAt == nil && !e.NextAttemptAt.After(now) && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (s *memStore) find(id uuid.UUID) *models.UserEventOutbox {
+	for i := range s.events {
+		if s.events[i].ID == id {
+			return &s.events[i]
+		}
+	}
+	return nil
+}
+
+func (s *memStore) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	s.find(id).SentAt = &at
+	return nil
+}
+
+func (s *memStore) MarkFailed(ctx context.Context, id uuid.UUID, reason string, retryAt time.Time) error {
+	e := s.find(id)
+	e.Attempts++
+	e.LastError = &reason
+	e.NextAttemptAt = retryAt
+	return nil
+}
+
+// flakyPublisher отказывает первые failures вызовов
+type flakyPublisher struct {
+	failures   int
+	published  []producer.UserEvent
+	requestIDs []string
+}
+
+func (p *flakyPublisher) PublishUserEvent(ctx context.Context, ev producer.UserEvent) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("kafka unavailable")
+	}
+	p.published = append(p.published, ev)
+	p.requestIDs = append(p.requestIDs, correlation.FromContext(ctx).RequestID)
+	return nil
+}
+
+func newEvent(userID uuid.UUID) models.UserEventOutbox {
+	return models.UserEventOutbox{
+		ID:         uuid.New(),
+		EventType:  producer.UserEventAccountDeleted,
+		UserID:     userID,
+		OccurredAt: time.Now(),
+	}
+}
+
+func TestRelay_RunOnce_PublishesAndMarksSent(t *testing.T) {
+	userID := uuid.New()
+	store := &memStore{events: []models.UserEventOutbox{newEvent(userID)}}
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	sent, err := relay.RunOnce(context.Background())
+	if err != nil || sent != 1 {
+		t.Fatalf("Expected 1 sent, got %d err=%v", sent, err)
+	}
+	if len(pub.published) != 1 || pub.published[0].UserID != userID.String() || pub.published[0].Type != producer.UserEventAccountDeleted {
+		t.Fatalf("Unexpected published events: %+v", pub.published)
+	}
+	if store.events[0].SentAt == nil {
+		t.Error("Expected event to be marked sent")
+	}
+
+	// повторный проход ничего не отправляет
+	if sent, _ := relay.RunOnce(context.Background()); sent != 0 {
+		t.Errorf("Expected nothing to send, got %d", sent)
+	}
+}
+
+func TestRelay_RunOnce_PropagatesRequestID(t *testing.T) {
+	event := newEvent(uuid.New())
+	requestID := "req-42"
+	event.RequestID = &requestID
+	store := &memStore{events: []models.UserEventOutbox{event}}
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	if _, err := relay.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+	if len(pub.requestIDs) != 1 || pub.requestIDs[0] != requestID {
+		t.Errorf("Expected request id %q in publish context, got %v", requestID, pub.requestIDs)
+	}
+}
+
+func TestRelay_RunOnce_RetriesAfterFailure(t *testing.T) {
+	store := &memStore{events: []models.UserEventOutbox{newEvent(uuid.New()), newEvent(uuid.New())}}
+	pub := &flakyPublisher{failures: 1}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	sent, err := relay.RunOnce(context.Background())
+	if err != nil || sent != 1 {
+		t.Fatalf("Expected one event sent despite a failure, got %d err=%v", sent, err)
+	}
+	failed := store.events[0]
+	if failed.SentAt != nil || failed.Attempts != 1 || failed.LastError == nil {
+		t.Fatalf("Expected first event to be postponed, got %+v", failed)
+	}
+	if !failed.NextAttemptAt.After(time.Now()) {
+		t.Errorf("Expected retry in the future, got %v", failed.NextAttemptAt)
+	}
+
+	// до наступления срока событие не трогаем
+	if sent, _ := relay.RunOnce(context.Background()); sent != 0 {
+		t.Errorf("Expected postponed event to wait, got %d sent", sent)
+	}
+
+	store.events[0].NextAttemptAt = time.Now().Add(-time.Second)
+	if sent, err := relay.RunOnce(context.Background()); err != nil || sent != 1 {
+		t.Fatalf("Expected retry to succeed, got %d err=%v", sent, err)
+	}
+	if store.events[0].SentAt == nil {
+		t.Error("Expected retried event to be marked sent")
+	}
+}
+
+func TestRelay_RunOnce_StoreError(t *testing.T) {
+	dbErr := errors.New("db down")
+	relay := outbox.NewRelay(&memStore{listErr: dbErr}, &flakyPublisher{}, zap.NewNop())
+
+	if _, err := relay.RunOnce(context.Background()); !errors.Is(err, dbErr) {
+		t.Errorf("Expected %v, got %v", dbErr, err)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  5 * time.Second,
+		2:  10 * time.Second,
+		3:  20 * time.Second,
+		20: 10 * time.Minute,
+	}
+	for attempt, want := range cases {
+		if got := outbox.RetryDelay(attempt); got != want {
+			t.Errorf("RetryDelay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
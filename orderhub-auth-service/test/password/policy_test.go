@@ -0,0 +1,87 @@
+package password_test
+
+import (
+	"auth-service/internal/password"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func codes(vs []password.Violation) []string {
+	out := make([]string, 0, len(vs))
+	for _, v := range vs {
+		out = append(out, v.Code)
+	}
+	return out
+}
+
+func TestPolicy_Check(t *testing.T) {
+	breached, err := password.LoadBreachedList("")
+	if err != nil {
+		t.Fatalf("load bundled list: %v", err)
+	}
+	p := &password.Policy{
+		MinLength:       10,
+		RequiredClasses: []string{password.ClassLetter, password.ClassDigit, password.ClassSymbol},
+		ForbidEmail:     true,
+		Breached:        breached,
+	}
+
+	cases := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{"ok", "correct-horse-42", nil},
+		{"short and no symbol", "xk7q2m", []string{password.CodeMinLength, password.CodeCharClass}},
+		{"contains email local part", "Alice-2024-secret", []string{password.CodeContainsEmail}},
+		{"breached", "P@ssw0rd", []string{password.CodeMinLength, password.CodeBreached}},
+	}
+	for _, tc := range cases {
+		got := codes(p.Check(tc.password, "alice@example.com"))
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParseClasses(t *testing.T) {
+	got, err := password.ParseClasses(" Upper, digit ,,")
+	if err != nil || strings.Join(got, ",") != "upper,digit" {
+		t.Fatalf("got %v err=%v", got, err)
+	}
+	if _, err := password.ParseClasses("emoji"); err == nil {
+		t.Fatal("expected error for unknown class")
+	}
+}
+
+func TestLoadBreachedList_OperatorFile(t *testing.T) {
+	sum := sha1.Sum([]byte("orderhub-leaked-2025"))
+	full := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	content := "# operator list\n" + strings.ToLower(full[:12]) + ":1024\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	l, err := password.LoadBreachedList(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if !l.Contains("orderhub-leaked-2025") {
+		t.Error("expected prefix from operator file to match")
+	}
+	if l.Contains("orderhub-not-leaked-2025") {
+		t.Error("unexpected match")
+	}
+
+	if err := os.WriteFile(path, []byte("XYZ\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := password.LoadBreachedList(path); err == nil {
+		t.Error("expected error for malformed line")
+	}
+}
@@ -2,25 +2,77 @@ package repository_test
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"auth-service/internal/cleanup"
 	"auth-service/internal/migrate"
 	"auth-service/internal/models"
 	"auth-service/internal/repository"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/testutil"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+func TestMigrations_UpDownStatus(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	ctx := context.Background()
+
+	m, err := migrate.New(db, zap.NewNop())
+	if err != nil {
+		t.Fatalf("load migrations: %v", err)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil || applied == 0 {
+		t.Fatalf("up: applied=%d err=%v", applied, err)
+	}
+
+	// повторный запуск ничего не применяет
+	if again, err := m.Up(ctx); err != nil || again != 0 {
+		t.Fatalf("second up: applied=%d err=%v", again, err)
+	}
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if len(status) != applied {
+		t.Fatalf("expected %d migrations in status, got %d", applied, len(status))
+	}
+	for _, st := range status {
+		if st.AppliedAt == nil || st.Missing {
+			t.Fatalf("migration %d_%s not applied", st.Version, st.Name)
+		}
+	}
+
+	// откатываем всё и накатываем заново
+	if n, err := m.Down(ctx, applied); err != nil || n != applied {
+		t.Fatalf("down: rolled back=%d err=%v", n, err)
+	}
+	if v, err := m.Version(ctx); err != nil || v != 0 {
+		t.Fatalf("expected empty schema, version=%d err=%v", v, err)
+	}
+	if n, err := m.Up(ctx); err != nil || n != applied {
+		t.Fatalf("re-up: applied=%d err=%v", n, err)
+	}
+
+	// сиды RBAC на месте
+	perms, err := repository.NewPermissionRepo(db).ListByRole(ctx, models.RoleAdmin)
+	if err != nil || !slices.Contains(perms, authz.PermRBACManage) {
+		t.Fatalf("admin must have rbac:manage after migrations, got %v err=%v", perms, err)
+	}
+}
+
 func TestUserRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
 
 	// Запускаем миграцию явно в тесте
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 
@@ -106,7 +158,7 @@ func TestRefreshRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
 
 	// Запускаем миграцию явно в тесте
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 
@@ -248,7 +300,7 @@ func TestPasswordResetRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
 
 	// Запускаем миграцию явно в тесте
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 
@@ -360,7 +412,7 @@ func TestPasswordResetRepo(t *testing.T) {
 
 func TestJWKRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 
@@ -431,7 +483,7 @@ func TestJWKRepo(t *testing.T) {
 
 func TestSessionRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 	ctx := context.Background()
@@ -502,9 +554,230 @@ func TestSessionRepo(t *testing.T) {
 	}
 }
 
+func TestWatermarkRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	wrepo := repository.NewWatermarkRepo(db)
+
+	u := models.User{Email: "wm@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	// Нет водяного знака
+	if wm, err := wrepo.Get(ctx, u.ID); err != nil || wm != nil {
+		t.Fatalf("expected no watermark, got %+v err=%v", wm, err)
+	}
+
+	later := time.Now().UTC().Truncate(time.Microsecond)
+	if err := wrepo.Bump(ctx, u.ID, later, "logout_all"); err != nil {
+		t.Fatalf("bump: %v", err)
+	}
+
+	// Более ранняя отметка не должна откатить водяной знак назад
+	if err := wrepo.Bump(ctx, u.ID, later.Add(-time.Hour), "password_change"); err != nil {
+		t.Fatalf("bump earlier: %v", err)
+	}
+
+	wm, err := wrepo.Get(ctx, u.ID)
+	if err != nil || wm == nil {
+		t.Fatalf("get watermark: %v", err)
+	}
+	if !wm.RevokedBefore.Equal(later) {
+		t.Fatalf("watermark moved back: got %v want %v", wm.RevokedBefore, later)
+	}
+}
+
+func TestAccountRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	sessRepo := repository.NewSessionRepo(db)
+	refreshRepo := repository.NewRefreshRepo(db)
+	wrepo := repository.NewWatermarkRepo(db)
+	arepo := repository.NewAccountRepo(db)
+
+	u := models.User{Email: "gone@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	s := models.UserSession{UserID: u.ID, ClientID: "c1"}
+	if err := sessRepo.Create(ctx, &s); err != nil {
+		t.Fatalf("create session: %v", err)
+	}
+	rt := models.RefreshToken{UserID: u.ID, SessionID: &s.ID, TokenHash: "h1", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := refreshRepo.Create(ctx, &rt); err != nil {
+		t.Fatalf("create refresh: %v", err)
+	}
+	name, phone := "Gone", "+79990000000"
+	u.DisplayName, u.Phone, u.Locale, u.TimeZone, u.MarketingConsent = &name, &phone, "en", "Europe/Berlin", true
+	if err := userRepo.UpdateProfile(ctx, &u); err != nil {
+		t.Fatalf("update profile: %v", err)
+	}
+
+	snap, err := arepo.Snapshot(ctx, u.ID)
+	if err != nil || snap == nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if snap.User.Email != u.Email || snap.User.Locale != "en" || snap.User.Phone == nil || len(snap.Sessions) != 1 || len(snap.RefreshTokens) != 1 {
+		t.Fatalf("unexpected snapshot: %+v", snap)
+	}
+
+	now := time.Now()
+	if err := wrepo.Bump(ctx, u.ID, now, "account_deleted"); err != nil {
+		t.Fatalf("bump: %v", err)
+	}
+	event := &models.UserEventOutbox{EventType: "account_deleted", UserID: u.ID, OccurredAt: now}
+	if err := arepo.Anonymize(ctx, u.ID, now, event); err != nil {
+		t.Fatalf("anonymize: %v", err)
+	}
+
+	got, err := userRepo.GetByID(ctx, u.ID)
+	if err != nil || got == nil {
+		t.Fatalf("user row must stay: %v", err)
+	}
+	if got.Email == u.Email || got.Password != "" || !got.IsDisabled || got.DeletedAt == nil {
+		t.Fatalf("user not anonymized: %+v", got)
+	}
+	if got.DisplayName != nil || got.Phone != nil || got.MarketingConsent {
+		t.Fatalf("profile not anonymized: %+v", got)
+	}
+	if byEmail, _ := userRepo.GetByEmail(ctx, u.Email); byEmail != nil {
+		t.Fatalf("old email must be free")
+	}
+
+	snap, err = arepo.Snapshot(ctx, u.ID)
+	if err != nil || snap == nil {
+		t.Fatalf("snapshot after anonymize: %v", err)
+	}
+	if len(snap.Sessions) != 0 || len(snap.RefreshTokens) != 0 {
+		t.Fatalf("sessions/tokens must be deleted: %+v", snap)
+	}
+	if snap.Watermark == nil {
+		t.Fatalf("watermark must survive anonymization")
+	}
+
+	if snap, err := arepo.Snapshot(ctx, uuid.New()); err != nil || snap != nil {
+		t.Fatalf("expected nil snapshot for unknown user, got %+v err=%v", snap, err)
+	}
+}
+
+func TestUserEventOutboxRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	arepo := repository.NewAccountRepo(db)
+	orepo := repository.NewUserEventOutboxRepo(db)
+
+	u := models.User{Email: "outbox@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	// неудачное обезличивание не оставляет события в outbox
+	missing := uuid.New()
+	now := time.Now()
+	err := arepo.Anonymize(ctx, missing, now, &models.UserEventOutbox{EventType: "account_deleted", UserID: missing, OccurredAt: now})
+	if err == nil {
+		t.Fatalf("expected error for unknown user")
+	}
+	if pending, err := orepo.ListPending(ctx, now.Add(time.Minute), 10); err != nil || len(pending) != 0 {
+		t.Fatalf("expected empty outbox after rollback, got %+v err=%v", pending, err)
+	}
+
+	if err := arepo.Anonymize(ctx, u.ID, now, &models.UserEventOutbox{EventType: "account_deleted", UserID: u.ID, OccurredAt: now}); err != nil {
+		t.Fatalf("anonymize: %v", err)
+	}
+	pending, err := orepo.ListPending(ctx, now.Add(time.Minute), 10)
+	if err != nil || len(pending) != 1 || pending[0].UserID != u.ID {
+		t.Fatalf("expected one pending event, got %+v err=%v", pending, err)
+	}
+	ev := pending[0]
+
+	retryAt := now.Add(time.Hour)
+	if err := orepo.MarkFailed(ctx, ev.ID, "kafka down", retryAt); err != nil {
+		t.Fatalf("mark failed: %v", err)
+	}
+	if pending, _ := orepo.ListPending(ctx, now.Add(time.Minute), 10); len(pending) != 0 {
+		t.Fatalf("event must wait until retry time, got %+v", pending)
+	}
+	pending, err = orepo.ListPending(ctx, retryAt.Add(time.Second), 10)
+	if err != nil || len(pending) != 1 || pending[0].Attempts != 1 || pending[0].LastError == nil {
+		t.Fatalf("expected retried event with one attempt, got %+v err=%v", pending, err)
+	}
+
+	if err := orepo.MarkSent(ctx, ev.ID, time.Now()); err != nil {
+		t.Fatalf("mark sent: %v", err)
+	}
+	if pending, _ := orepo.ListPending(ctx, retryAt.Add(time.Second), 10); len(pending) != 0 {
+		t.Fatalf("sent event must not be pending, got %+v", pending)
+	}
+}
+
+func TestPermissionRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	prepo := repository.NewPermissionRepo(db)
+
+	// Начальные права из миграции
+	adminPerms, err := prepo.ListByRole(ctx, models.RoleAdmin)
+	if err != nil {
+		t.Fatalf("list admin perms: %v", err)
+	}
+	if !slices.Contains(adminPerms, authz.PermRBACManage) {
+		t.Fatalf("expected admin to have %s, got %v", authz.PermRBACManage, adminPerms)
+	}
+
+	if ok, err := prepo.Exists(ctx, authz.PermStockAdjustAny); err != nil || !ok {
+		t.Fatalf("expected permission to exist: %v ok=%v", err, ok)
+	}
+
+	if err := prepo.Grant(ctx, models.RoleVendor, authz.PermStockAdjustAny); err != nil {
+		t.Fatalf("grant: %v", err)
+	}
+	// повторная выдача идемпотентна
+	if err := prepo.Grant(ctx, models.RoleVendor, authz.PermStockAdjustAny); err != nil {
+		t.Fatalf("grant twice: %v", err)
+	}
+
+	vendorPerms, _ := prepo.ListByRole(ctx, models.RoleVendor)
+	if !slices.Contains(vendorPerms, authz.PermStockAdjustAny) {
+		t.Fatalf("expected vendor to have %s, got %v", authz.PermStockAdjustAny, vendorPerms)
+	}
+
+	if ok, err := prepo.Revoke(ctx, models.RoleVendor, authz.PermStockAdjustAny); err != nil || !ok {
+		t.Fatalf("revoke: %v ok=%v", err, ok)
+	}
+	if ok, _ := prepo.Revoke(ctx, models.RoleVendor, authz.PermStockAdjustAny); ok {
+		t.Fatal("expected second revoke to affect nothing")
+	}
+
+	// Повторная миграция не возвращает отозванные права
+	if err := migrate.MigrateAuthDB(ctx, db, zap.NewNop()); err != nil {
+		t.Fatalf("second migration failed: %v", err)
+	}
+	vendorPerms, _ = prepo.ListByRole(ctx, models.RoleVendor)
+	if slices.Contains(vendorPerms, authz.PermStockAdjustAny) {
+		t.Fatalf("revoked permission came back after migration: %v", vendorPerms)
+	}
+}
+
 func TestEmailVerificationRepo(t *testing.T) {
 	db := testutil.SetupTestPostgres(t)
-	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migration failed: %v", err)
 	}
 	ctx := context.Background()
@@ -583,3 +856,427 @@ func TestEmailVerificationRepo(t *testing.T) {
 		t.Fatalf("expected latest code hash to be verify_hash4, got %s", latest.CodeHash)
 	}
 }
+
+func TestVendorApplicationRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	repo := repository.NewVendorApplicationRepo(db)
+
+	u := models.User{Email: "shop@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	app := models.VendorApplication{UserID: u.ID, CompanyName: "ООО Ромашка", TaxID: "7701234567"}
+	if err := repo.Create(ctx, &app); err != nil {
+		t.Fatalf("create application: %v", err)
+	}
+	if app.Status != models.VendorApplicationPending {
+		t.Fatalf("expected PENDING, got %s", app.Status)
+	}
+
+	// вторая заявка на рассмотрении запрещена уникальным индексом
+	dup := models.VendorApplication{UserID: u.ID, CompanyName: "ООО Лютик", TaxID: "7701234568"}
+	if err := repo.Create(ctx, &dup); err == nil {
+		t.Fatal("expected unique violation for second pending application")
+	}
+
+	pending, err := repo.HasPendingByUser(ctx, u.ID)
+	if err != nil || !pending {
+		t.Fatalf("HasPendingByUser: %v %v", pending, err)
+	}
+
+	items, total, err := repo.List(ctx, models.VendorApplicationPending, 10, 0)
+	if err != nil || total != 1 || len(items) != 1 {
+		t.Fatalf("List: total=%d len=%d err=%v", total, len(items), err)
+	}
+
+	reviewer := uuid.New()
+	ok, err := repo.Review(ctx, app.ID, models.VendorApplicationApproved, &reviewer, nil, time.Now())
+	if err != nil || !ok {
+		t.Fatalf("Review: %v %v", ok, err)
+	}
+	// повторное рассмотрение ничего не меняет
+	ok, err = repo.Review(ctx, app.ID, models.VendorApplicationRejected, &reviewer, nil, time.Now())
+	if err != nil || ok {
+		t.Fatalf("second Review: %v %v", ok, err)
+	}
+
+	got, err := repo.GetByID(ctx, app.ID)
+	if err != nil || got == nil || got.Status != models.VendorApplicationApproved || got.ReviewedAt == nil {
+		t.Fatalf("GetByID: %+v %v", got, err)
+	}
+	if missing, err := repo.GetByID(ctx, uuid.New()); err != nil || missing != nil {
+		t.Fatalf("GetByID missing: %+v %v", missing, err)
+	}
+}
+
+func TestAPIKeyRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	krepo := repository.NewAPIKeyRepo(db)
+
+	u := models.User{Email: "keys@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	k := models.APIKey{UserID: u.ID, Name: "erp", Prefix: "ohk_abcdefgh", KeyHash: "hash-1", Scopes: []string{"product:write"}}
+	if err := krepo.Create(ctx, &k); err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	dup := models.APIKey{UserID: u.ID, Name: "dup", Prefix: "ohk_abcdefgh", KeyHash: "hash-1", Scopes: []string{}}
+	if err := krepo.Create(ctx, &dup); err == nil {
+		t.Fatalf("expected unique violation on key_hash")
+	}
+
+	got, err := krepo.GetByHash(ctx, "hash-1")
+	if err != nil || got == nil || got.ID != k.ID || len(got.Scopes) != 1 || got.Scopes[0] != "product:write" {
+		t.Fatalf("unexpected key: %+v err=%v", got, err)
+	}
+	if got, err := krepo.GetByHash(ctx, "missing"); err != nil || got != nil {
+		t.Fatalf("expected nil for unknown hash, got %+v err=%v", got, err)
+	}
+
+	ip := "203.0.113.7"
+	now := time.Now()
+	if err := krepo.Touch(ctx, k.ID, now, &ip); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	list, err := krepo.ListByUser(ctx, u.ID)
+	if err != nil || len(list) != 1 || list[0].LastUsedAt == nil || list[0].LastUsedIP == nil || *list[0].LastUsedIP != ip {
+		t.Fatalf("unexpected list: %+v err=%v", list, err)
+	}
+
+	if ok, err := krepo.Revoke(ctx, k.ID, uuid.New(), now); err != nil || ok {
+		t.Fatalf("foreign user must not revoke: ok=%v err=%v", ok, err)
+	}
+	if ok, err := krepo.Revoke(ctx, k.ID, u.ID, now); err != nil || !ok {
+		t.Fatalf("revoke: ok=%v err=%v", ok, err)
+	}
+	if ok, _ := krepo.Revoke(ctx, k.ID, u.ID, now); ok {
+		t.Fatalf("second revoke must report false")
+	}
+}
+
+func TestImpersonationRepo_InAccountSnapshot(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	irepo := repository.NewImpersonationRepo(db)
+	arepo := repository.NewAccountRepo(db)
+
+	u := models.User{Email: "support-target@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	ev := models.ImpersonationEvent{ActorID: uuid.New(), TargetID: u.ID, Reason: "ticket #42", ExpiresAt: time.Now().Add(15 * time.Minute)}
+	if err := irepo.Create(ctx, &ev); err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+	if ev.ID == uuid.Nil {
+		t.Fatalf("expected generated id")
+	}
+
+	snap, err := arepo.Snapshot(ctx, u.ID)
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	if len(snap.Impersonations) != 1 || snap.Impersonations[0].Reason != "ticket #42" {
+		t.Fatalf("expected impersonation in snapshot, got %+v", snap.Impersonations)
+	}
+
+	// журнал аудита переживает удаление учётной записи
+	if err := arepo.Anonymize(ctx, u.ID, time.Now(), nil); err != nil {
+		t.Fatalf("anonymize: %v", err)
+	}
+	var cnt int64
+	if err := db.Model(&models.ImpersonationEvent{}).Where("target_id = ?", u.ID).Count(&cnt).Error; err != nil || cnt != 1 {
+		t.Fatalf("expected audit record to survive, count=%d err=%v", cnt, err)
+	}
+}
+
+func TestTrustedDeviceRepo_MatchUpsertAndAlerts(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	drepo := repository.NewTrustedDeviceRepo(db)
+	arepo := repository.NewSignInAlertRepo(db)
+
+	u := models.User{Email: "devices@example.com", Password: "pwd"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	m, err := drepo.Match(ctx, u.ID, "cid-1", "203.0.113.0/24")
+	if err != nil || m.Any {
+		t.Fatalf("expected no devices yet, got %+v err=%v", m, err)
+	}
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		d := models.TrustedDevice{UserID: u.ID, ClientID: "cid-1", IPNetwork: "203.0.113.0/24", FirstSeenAt: now, LastSeenAt: now.Add(time.Duration(i) * time.Minute)}
+		if err := drepo.Upsert(ctx, &d); err != nil {
+			t.Fatalf("upsert: %v", err)
+		}
+	}
+	list, err := drepo.ListByUser(ctx, u.ID)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("expected one device after repeated upsert, got %d err=%v", len(list), err)
+	}
+	m, err = drepo.Match(ctx, u.ID, "cid-2", "203.0.113.0/24")
+	if err != nil || !m.Any || m.Client || !m.Network {
+		t.Fatalf("unexpected match %+v err=%v", m, err)
+	}
+
+	if ok, err := drepo.Delete(ctx, list[0].ID, uuid.New()); err != nil || ok {
+		t.Fatalf("foreign user must not delete device: ok=%v err=%v", ok, err)
+	}
+	if ok, err := drepo.Delete(ctx, list[0].ID, u.ID); err != nil || !ok {
+		t.Fatalf("delete: ok=%v err=%v", ok, err)
+	}
+
+	a := models.SignInAlert{UserID: u.ID, SessionID: uuid.New(), ClientID: "cid-2", TokenHash: "alert-hash", ExpiresAt: now.Add(time.Hour), CreatedAt: now}
+	if err := arepo.Create(ctx, &a); err != nil {
+		t.Fatalf("create alert: %v", err)
+	}
+	got, err := arepo.GetValidByHash(ctx, "alert-hash", now)
+	if err != nil || got == nil || got.ID != a.ID {
+		t.Fatalf("expected valid alert, got %+v err=%v", got, err)
+	}
+	if used, err := arepo.MarkUsed(ctx, a.ID, now); err != nil || !used {
+		t.Fatalf("mark used: used=%v err=%v", used, err)
+	}
+	if used, _ := arepo.MarkUsed(ctx, a.ID, now); used {
+		t.Fatalf("alert must be usable only once")
+	}
+	if got, _ := arepo.GetValidByHash(ctx, "alert-hash", now); got != nil {
+		t.Fatalf("used alert must not be returned")
+	}
+}
+
+func TestPasswordHistoryRepo_KeepsLastN(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	hrepo := repository.NewPasswordHistoryRepo(db)
+
+	u := models.User{Email: "history@example.com", Password: "h0"}
+	if err := userRepo.Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	for _, h := range []string{"h1", "h2", "h3", "h4"} {
+		if err := hrepo.Add(ctx, u.ID, h, 3); err != nil {
+			t.Fatalf("add %s: %v", h, err)
+		}
+	}
+
+	got, err := hrepo.ListRecent(ctx, u.ID, 10)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if strings.Join(got, ",") != "h4,h3,h2" {
+		t.Fatalf("expected last 3 hashes newest first, got %v", got)
+	}
+}
+
+func TestOAuthClientRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	repo := repository.NewOAuthClientRepo(db)
+
+	c := &models.OAuthClient{ClientID: "rs_reports", Name: "reports", SecretHash: "hash"}
+	if err := repo.Create(ctx, c); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := repo.Create(ctx, &models.OAuthClient{ClientID: "rs_reports", Name: "dup", SecretHash: "hash"}); err == nil {
+		t.Fatal("client_id must be unique")
+	}
+
+	if err := repo.Touch(ctx, c.ID, time.Now()); err != nil {
+		t.Fatalf("touch: %v", err)
+	}
+	got, err := repo.GetByClientID(ctx, "rs_reports")
+	if err != nil || got == nil || got.LastUsedAt == nil {
+		t.Fatalf("get: %+v, %v", got, err)
+	}
+	if missing, err := repo.GetByClientID(ctx, "rs_missing"); err != nil || missing != nil {
+		t.Fatalf("missing client: %+v, %v", missing, err)
+	}
+
+	if ok, err := repo.Delete(ctx, "rs_reports"); err != nil || !ok {
+		t.Fatalf("delete: ok=%v err=%v", ok, err)
+	}
+	if ok, _ := repo.Delete(ctx, "rs_reports"); ok {
+		t.Fatal("second delete must report missing client")
+	}
+}
+
+func TestPhoneVerificationRepo(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	repo := repository.NewPhoneVerificationRepo(db)
+
+	phone := "+79991234567"
+	u := &models.User{Email: "phone@example.com", Phone: &phone}
+	if err := userRepo.Create(ctx, u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if latest, err := repo.FindLatestByUser(ctx, u.ID); err != nil || latest != nil {
+		t.Fatalf("no codes yet: %+v, %v", latest, err)
+	}
+
+	v := &models.PhoneVerification{UserID: u.ID, Phone: phone, CodeHash: "hash", ExpiresAt: time.Now().Add(10 * time.Minute)}
+	if err := repo.Create(ctx, v); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if ok, err := repo.RegisterAttempt(ctx, v.ID, 2); err != nil || !ok {
+			t.Fatalf("attempt %d: ok=%v err=%v", i+1, ok, err)
+		}
+	}
+	if ok, _ := repo.RegisterAttempt(ctx, v.ID, 2); ok {
+		t.Fatal("attempts over the limit must be rejected")
+	}
+	if ok, err := repo.Consume(ctx, v.ID); err != nil || !ok {
+		t.Fatalf("consume: ok=%v err=%v", ok, err)
+	}
+	latest, err := repo.FindLatestByUser(ctx, u.ID)
+	if err != nil || latest == nil || !latest.Consumed || latest.Attempts != 2 {
+		t.Fatalf("latest: %+v, %v", latest, err)
+	}
+
+	if ok, _ := userRepo.SetPhoneVerified(ctx, u.ID, "+79990000000"); ok {
+		t.Fatal("other phone must not be verified")
+	}
+	if ok, err := userRepo.SetPhoneVerified(ctx, u.ID, phone); err != nil || !ok {
+		t.Fatalf("set verified: ok=%v err=%v", ok, err)
+	}
+	got, _ := userRepo.GetByID(ctx, u.ID)
+	if !got.IsPhoneVerified {
+		t.Fatal("phone must be verified")
+	}
+}
+
+func TestGuestAccounts_UpgradeAndPrune(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	userRepo := repository.NewUserRepo(db)
+	longAgo := time.Now().Add(-60 * 24 * time.Hour)
+
+	upgraded := models.User{Email: "guest-a@guest.invalid", IsGuest: true}
+	stale := models.User{Email: "guest-b@guest.invalid", IsGuest: true, CreatedAt: longAgo}
+	active := models.User{Email: "guest-c@guest.invalid", IsGuest: true, CreatedAt: longAgo}
+	for _, u := range []*models.User{&upgraded, &stale, &active} {
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("create guest: %v", err)
+		}
+	}
+	if err := repository.NewRefreshRepo(db).Create(ctx, &models.RefreshToken{
+		UserID: active.ID, TokenHash: "live", ExpiresAt: time.Now().Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("create refresh: %v", err)
+	}
+
+	ok, err := userRepo.UpgradeGuest(ctx, upgraded.ID, "erin@example.com", "hash")
+	if err != nil || !ok {
+		t.Fatalf("upgrade guest: ok=%v err=%v", ok, err)
+	}
+	if ok, _ := userRepo.UpgradeGuest(ctx, upgraded.ID, "other@example.com", "hash"); ok {
+		t.Fatal("second upgrade must be a no-op")
+	}
+	got, err := userRepo.GetByID(ctx, upgraded.ID)
+	if err != nil || got.IsGuest || got.Email != "erin@example.com" || got.Password != "hash" {
+		t.Fatalf("unexpected upgraded user: %+v err=%v", got, err)
+	}
+
+	if _, err := cleanup.NewCleanupService(db, zap.NewNop()).CleanupGuests(ctx, false); err != nil {
+		t.Fatalf("cleanup guests: %v", err)
+	}
+	var left []uuid.UUID
+	db.Model(&models.User{}).Pluck("id", &left)
+	if len(left) != 2 || !slices.Contains(left, upgraded.ID) || !slices.Contains(left, active.ID) {
+		t.Fatalf("expected only the stale guest to be pruned, left %v", left)
+	}
+	var events []models.UserEventOutbox
+	db.Find(&events)
+	if len(events) != 1 || events[0].UserID != stale.ID || events[0].EventType != "account_deleted" {
+		t.Fatalf("expected account_deleted for pruned guest, got %+v", events)
+	}
+}
+
+func TestCleanup_DryRunAndRunHistory(t *testing.T) {
+	db := testutil.SetupTestPostgres(t)
+	if err := migrate.MigrateAuthDB(context.Background(), db, zap.NewNop()); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	ctx := context.Background()
+	u := models.User{Email: "cleanup@example.com", Password: "h"}
+	if err := repository.NewUserRepo(db).Create(ctx, &u); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	refreshRepo := repository.NewRefreshRepo(db)
+	for _, hash := range []string{"expired-1", "expired-2"} {
+		if err := refreshRepo.Create(ctx, &models.RefreshToken{
+			UserID: u.ID, TokenHash: hash, ExpiresAt: time.Now().Add(-time.Hour),
+		}); err != nil {
+			t.Fatalf("create refresh: %v", err)
+		}
+	}
+	svc := cleanup.NewCleanupService(db, zap.NewNop())
+
+	counts, err := svc.Run(ctx, cleanup.JobExpired, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	var left int64
+	db.Model(&models.RefreshToken{}).Count(&left)
+	if counts["expired refresh_tokens"] != 2 || left != 2 {
+		t.Fatalf("dry run must only count: counts=%v left=%d", counts, left)
+	}
+
+	counts, ran, err := svc.RunExclusive(ctx, cleanup.JobExpired, time.Hour)
+	if err != nil || !ran || counts.Total() != 2 {
+		t.Fatalf("first run: ran=%v counts=%v err=%v", ran, counts, err)
+	}
+	// другая реплика сразу после — интервал ещё не истёк
+	if _, ran, err := svc.RunExclusive(ctx, cleanup.JobExpired, time.Hour); err != nil || ran {
+		t.Fatalf("second run must be skipped: ran=%v err=%v", ran, err)
+	}
+
+	var runs []models.CleanupRun
+	if err := db.Find(&runs).Error; err != nil {
+		t.Fatalf("list runs: %v", err)
+	}
+	if len(runs) != 1 || runs[0].Job != cleanup.JobExpired || runs[0].RowsDeleted != 2 ||
+		runs[0].Counts["expired refresh_tokens"] != 2 || runs[0].Error != nil {
+		t.Fatalf("unexpected run history: %+v", runs)
+	}
+}
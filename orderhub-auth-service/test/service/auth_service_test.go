@@ -1,14 +1,23 @@
 package service_test
 
 import (
+	"auth-service/internal/cache"
 	"auth-service/internal/models"
+	"auth-service/internal/password"
 	"auth-service/internal/producer"
 	"auth-service/internal/service"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/dpop"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -23,6 +32,12 @@ type MockUserRepo struct {
 	UpdatePasswordFunc        func(ctx context.Context, user *models.User) error
 	ExistsByEmailFunc         func(ctx context.Context, email string) (bool, error)
 	UpdateIsEmailVerifiedFunc func(ctx context.Context, user *models.User) error
+	UpdateRoleFunc            func(ctx context.Context, id uuid.UUID, role models.Role) error
+	SetDisabledFunc           func(ctx context.Context, id uuid.UUID, disabled bool) error
+	RequirePasswordResetFunc  func(ctx context.Context, id uuid.UUID) error
+	UpgradeGuestFunc          func(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error)
+	UpdateProfileFunc         func(ctx context.Context, user *models.User) error
+	SetPhoneVerifiedFunc      func(ctx context.Context, id uuid.UUID, phone string) (bool, error)
 }
 
 func (m *MockUserRepo) Create(ctx context.Context, u *models.User) error {
@@ -67,6 +82,48 @@ func (m *MockUserRepo) UpdateIsEmailVerified(ctx context.Context, user *models.U
 	return nil
 }
 
+func (m *MockUserRepo) UpdateRole(ctx context.Context, id uuid.UUID, role models.Role) error {
+	if m.UpdateRoleFunc != nil {
+		return m.UpdateRoleFunc(ctx, id, role)
+	}
+	return nil
+}
+
+func (m *MockUserRepo) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	if m.SetDisabledFunc != nil {
+		return m.SetDisabledFunc(ctx, id, disabled)
+	}
+	return nil
+}
+
+func (m *MockUserRepo) RequirePasswordReset(ctx context.Context, id uuid.UUID) error {
+	if m.RequirePasswordResetFunc != nil {
+		return m.RequirePasswordResetFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockUserRepo) UpgradeGuest(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error) {
+	if m.UpgradeGuestFunc != nil {
+		return m.UpgradeGuestFunc(ctx, id, email, passwordHash)
+	}
+	return true, nil
+}
+
+func (m *MockUserRepo) UpdateProfile(ctx context.Context, user *models.User) error {
+	if m.UpdateProfileFunc != nil {
+		return m.UpdateProfileFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepo) SetPhoneVerified(ctx context.Context, id uuid.UUID, phone string) (bool, error) {
+	if m.SetPhoneVerifiedFunc != nil {
+		return m.SetPhoneVerifiedFunc(ctx, id, phone)
+	}
+	return true, nil
+}
+
 // MockRefreshRepo
 type MockRefreshRepo struct {
 	CreateFunc             func(ctx context.Context, t *models.RefreshToken) error
@@ -162,6 +219,8 @@ func (m *MockPasswordHasher) Compare(hash, password string) bool {
 // MockTokenProvider
 type MockTokenProvider struct {
 	SignAccessFunc             func(ctx context.Context, sub uuid.UUID, role string, ttl time.Duration) (string, time.Time, error)
+	SignAccessBoundFunc        func(ctx context.Context, sub uuid.UUID, role, jkt string, ttl time.Duration) (string, time.Time, error)
+	SignImpersonationFunc      func(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (string, time.Time, error)
 	NewRefreshFunc             func(ctx context.Context, sub uuid.UUID, ttl time.Duration) (string, string, time.Time, error)
 	ParseAndValidateAccessFunc func(ctx context.Context, token string) (*service.Claims, error)
 	ListPublicJWKFunc          func(ctx context.Context) ([]service.PublicJWK, error)
@@ -175,6 +234,20 @@ func (m *MockTokenProvider) SignAccess(ctx context.Context, sub uuid.UUID, role
 	return "access_token", exp, nil
 }
 
+func (m *MockTokenProvider) SignAccessBound(ctx context.Context, sub uuid.UUID, role, jkt string, ttl time.Duration) (string, time.Time, error) {
+	if m.SignAccessBoundFunc != nil {
+		return m.SignAccessBoundFunc(ctx, sub, role, jkt, ttl)
+	}
+	return "bound_access_token:" + jkt, time.Now().Add(ttl), nil
+}
+
+func (m *MockTokenProvider) SignImpersonation(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+	if m.SignImpersonationFunc != nil {
+		return m.SignImpersonationFunc(ctx, sub, role, actor, ttl)
+	}
+	return "impersonation_token", time.Now().Add(ttl), nil
+}
+
 func (m *MockTokenProvider) NewRefresh(ctx context.Context, sub uuid.UUID, ttl time.Duration) (string, string, time.Time, error) {
 	if m.NewRefreshFunc != nil {
 		return m.NewRefreshFunc(ctx, sub, ttl)
@@ -421,7 +494,415 @@ func (m *MockCacheClient) Del(ctx context.Context, keys ...string) error {
 	return nil
 }
 
+// MockTokenRevoker
+type MockTokenRevoker struct {
+	RevokeIssuedBeforeFunc func(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error
+}
+
+func (m *MockTokenRevoker) RevokeIssuedBefore(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error {
+	if m.RevokeIssuedBeforeFunc != nil {
+		return m.RevokeIssuedBeforeFunc(ctx, userID, at, reason)
+	}
+	return nil
+}
+
+// MockAccountRepo
+type MockAccountRepo struct {
+	SnapshotFunc  func(ctx context.Context, userID uuid.UUID) (*service.AccountSnapshot, error)
+	AnonymizeFunc func(ctx context.Context, userID uuid.UUID, at time.Time, event *models.UserEventOutbox) error
+}
+
+func (m *MockAccountRepo) Snapshot(ctx context.Context, userID uuid.UUID) (*service.AccountSnapshot, error) {
+	if m.SnapshotFunc != nil {
+		return m.SnapshotFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockAccountRepo) Anonymize(ctx context.Context, userID uuid.UUID, at time.Time, event *models.UserEventOutbox) error {
+	if m.AnonymizeFunc != nil {
+		return m.AnonymizeFunc(ctx, userID, at, event)
+	}
+	return nil
+}
+
+// MockPermissionRepo
+type MockPermissionRepo struct {
+	ListAllFunc    func(ctx context.Context) ([]models.Permission, error)
+	ExistsFunc     func(ctx context.Context, code string) (bool, error)
+	ListByRoleFunc func(ctx context.Context, role models.Role) ([]string, error)
+	GrantFunc      func(ctx context.Context, role models.Role, code string) error
+	RevokeFunc     func(ctx context.Context, role models.Role, code string) (bool, error)
+}
+
+func (m *MockPermissionRepo) ListAll(ctx context.Context) ([]models.Permission, error) {
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPermissionRepo) Exists(ctx context.Context, code string) (bool, error) {
+	if m.ExistsFunc != nil {
+		return m.ExistsFunc(ctx, code)
+	}
+	return true, nil
+}
+
+func (m *MockPermissionRepo) ListByRole(ctx context.Context, role models.Role) ([]string, error) {
+	if m.ListByRoleFunc != nil {
+		return m.ListByRoleFunc(ctx, role)
+	}
+	return nil, nil
+}
+
+func (m *MockPermissionRepo) Grant(ctx context.Context, role models.Role, code string) error {
+	if m.GrantFunc != nil {
+		return m.GrantFunc(ctx, role, code)
+	}
+	return nil
+}
+
+func (m *MockPermissionRepo) Revoke(ctx context.Context, role models.Role, code string) (bool, error) {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, role, code)
+	}
+	return true, nil
+}
+
+// MockVendorApplicationRepo
+type MockVendorApplicationRepo struct {
+	CreateFunc           func(ctx context.Context, a *models.VendorApplication) error
+	GetByIDFunc          func(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error)
+	HasPendingByUserFunc func(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListFunc             func(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error)
+	ReviewFunc           func(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error)
+}
+
+func (m *MockVendorApplicationRepo) Create(ctx context.Context, a *models.VendorApplication) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, a)
+	}
+	return nil
+}
+
+func (m *MockVendorApplicationRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockVendorApplicationRepo) HasPendingByUser(ctx context.Context, userID uuid.UUID) (bool, error) {
+	if m.HasPendingByUserFunc != nil {
+		return m.HasPendingByUserFunc(ctx, userID)
+	}
+	return false, nil
+}
+
+func (m *MockVendorApplicationRepo) List(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, status, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *MockVendorApplicationRepo) Review(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error) {
+	if m.ReviewFunc != nil {
+		return m.ReviewFunc(ctx, id, status, reviewer, reason, at)
+	}
+	return true, nil
+}
+
 // Вспомогательная функция для создания тестового AuthService
+// MockAPIKeyRepo — хранилище ключей в памяти
+type MockAPIKeyRepo struct {
+	Keys    []models.APIKey
+	Touched map[uuid.UUID]*string
+}
+
+func (m *MockAPIKeyRepo) Create(ctx context.Context, k *models.APIKey) error {
+	k.ID = uuid.New()
+	m.Keys = append(m.Keys, *k)
+	return nil
+}
+
+func (m *MockAPIKeyRepo) GetByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	for i := range m.Keys {
+		if m.Keys[i].KeyHash == hash {
+			k := m.Keys[i]
+			return &k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockAPIKeyRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.APIKey, error) {
+	var out []models.APIKey
+	for _, k := range m.Keys {
+		if k.UserID == userID {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockAPIKeyRepo) Revoke(ctx context.Context, id, userID uuid.UUID, at time.Time) (bool, error) {
+	for i := range m.Keys {
+		if m.Keys[i].ID == id && m.Keys[i].UserID == userID && m.Keys[i].RevokedAt == nil {
+			m.Keys[i].RevokedAt = &at
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockAPIKeyRepo) Touch(ctx context.Context, id uuid.UUID, at time.Time, ip *string) error {
+	if m.Touched == nil {
+		m.Touched = map[uuid.UUID]*string{}
+	}
+	m.Touched[id] = ip
+	return nil
+}
+
+// MockImpersonationRepo — журнал входов от имени пользователя в памяти
+type MockImpersonationRepo struct {
+	Events    []models.ImpersonationEvent
+	CreateErr error
+}
+
+func (m *MockImpersonationRepo) Create(ctx context.Context, e *models.ImpersonationEvent) error {
+	if m.CreateErr != nil {
+		return m.CreateErr
+	}
+	e.ID = uuid.New()
+	m.Events = append(m.Events, *e)
+	return nil
+}
+
+// MockTrustedDeviceRepo — доверенные устройства в памяти
+type MockTrustedDeviceRepo struct {
+	Devices []models.TrustedDevice
+}
+
+func (m *MockTrustedDeviceRepo) Match(ctx context.Context, userID uuid.UUID, clientID, network string) (service.DeviceMatch, error) {
+	var match service.DeviceMatch
+	for _, d := range m.Devices {
+		if d.UserID != userID {
+			continue
+		}
+		match.Any = true
+		match.Client = match.Client || d.ClientID == clientID
+		match.Network = match.Network || d.IPNetwork == network
+	}
+	return match, nil
+}
+
+func (m *MockTrustedDeviceRepo) Upsert(ctx context.Context, d *models.TrustedDevice) error {
+	for i := range m.Devices {
+		if m.Devices[i].UserID == d.UserID && m.Devices[i].ClientID == d.ClientID && m.Devices[i].IPNetwork == d.IPNetwork {
+			m.Devices[i].LastSeenAt = d.LastSeenAt
+			return nil
+		}
+	}
+	d.ID = uuid.New()
+	m.Devices = append(m.Devices, *d)
+	return nil
+}
+
+func (m *MockTrustedDeviceRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.TrustedDevice, error) {
+	var out []models.TrustedDevice
+	for _, d := range m.Devices {
+		if d.UserID == userID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (m *MockTrustedDeviceRepo) Delete(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	for i := range m.Devices {
+		if m.Devices[i].ID == id && m.Devices[i].UserID == userID {
+			m.Devices = append(m.Devices[:i], m.Devices[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockTrustedDeviceRepo) DeleteByClient(ctx context.Context, userID uuid.UUID, clientID string) (int64, error) {
+	kept := m.Devices[:0]
+	var n int64
+	for _, d := range m.Devices {
+		if d.UserID == userID && d.ClientID == clientID {
+			n++
+			continue
+		}
+		kept = append(kept, d)
+	}
+	m.Devices = kept
+	return n, nil
+}
+
+// MockSignInAlertRepo — ссылки «это был не я» в памяти
+type MockSignInAlertRepo struct {
+	Alerts []models.SignInAlert
+}
+
+func (m *MockSignInAlertRepo) Create(ctx context.Context, a *models.SignInAlert) error {
+	a.ID = uuid.New()
+	m.Alerts = append(m.Alerts, *a)
+	return nil
+}
+
+func (m *MockSignInAlertRepo) GetValidByHash(ctx context.Context, hash string, now time.Time) (*models.SignInAlert, error) {
+	for i := range m.Alerts {
+		a := m.Alerts[i]
+		if a.TokenHash == hash && a.UsedAt == nil && a.ExpiresAt.After(now) {
+			return &a, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockSignInAlertRepo) MarkUsed(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	for i := range m.Alerts {
+		if m.Alerts[i].ID == id && m.Alerts[i].UsedAt == nil {
+			m.Alerts[i].UsedAt = &at
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MockPasswordHistoryRepo — история паролей в памяти, новые первыми
+type MockPasswordHistoryRepo struct {
+	Hashes map[uuid.UUID][]string
+}
+
+func (m *MockPasswordHistoryRepo) ListRecent(ctx context.Context, userID uuid.UUID, n int) ([]string, error) {
+	h := m.Hashes[userID]
+	if len(h) > n {
+		h = h[:n]
+	}
+	return h, nil
+}
+
+func (m *MockPasswordHistoryRepo) Add(ctx context.Context, userID uuid.UUID, hash string, keep int) error {
+	if m.Hashes == nil {
+		m.Hashes = map[uuid.UUID][]string{}
+	}
+	h := append([]string{hash}, m.Hashes[userID]...)
+	if len(h) > keep {
+		h = h[:keep]
+	}
+	m.Hashes[userID] = h
+	return nil
+}
+
+// MockOAuthClientRepo
+type MockOAuthClientRepo struct {
+	Clients map[string]*models.OAuthClient
+	Touched []uuid.UUID
+}
+
+func (m *MockOAuthClientRepo) Create(ctx context.Context, c *models.OAuthClient) error {
+	if m.Clients == nil {
+		m.Clients = map[string]*models.OAuthClient{}
+	}
+	c.ID = uuid.New()
+	m.Clients[c.ClientID] = c
+	return nil
+}
+
+func (m *MockOAuthClientRepo) GetByClientID(ctx context.Context, clientID string) (*models.OAuthClient, error) {
+	return m.Clients[clientID], nil
+}
+
+func (m *MockOAuthClientRepo) List(ctx context.Context) ([]models.OAuthClient, error) {
+	out := make([]models.OAuthClient, 0, len(m.Clients))
+	for _, c := range m.Clients {
+		out = append(out, *c)
+	}
+	return out, nil
+}
+
+func (m *MockOAuthClientRepo) Delete(ctx context.Context, clientID string) (bool, error) {
+	if _, ok := m.Clients[clientID]; !ok {
+		return false, nil
+	}
+	delete(m.Clients, clientID)
+	return true, nil
+}
+
+func (m *MockOAuthClientRepo) Touch(ctx context.Context, id uuid.UUID, at time.Time) error {
+	m.Touched = append(m.Touched, id)
+	return nil
+}
+
+// MockPhoneVerificationRepo хранит коды в памяти и повторяет условия SQL-запросов репозитория
+type MockPhoneVerificationRepo struct {
+	Items []*models.PhoneVerification
+}
+
+func (m *MockPhoneVerificationRepo) Create(ctx context.Context, v *models.PhoneVerification) error {
+	v.ID = uuid.New()
+	m.Items = append(m.Items, v)
+	return nil
+}
+
+func (m *MockPhoneVerificationRepo) FindLatestByUser(ctx context.Context, userID uuid.UUID) (*models.PhoneVerification, error) {
+	for i := len(m.Items) - 1; i >= 0; i-- {
+		if m.Items[i].UserID == userID {
+			return m.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockPhoneVerificationRepo) RegisterAttempt(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error) {
+	for _, v := range m.Items {
+		if v.ID == id && !v.Consumed && v.Attempts < maxAttempts {
+			v.Attempts++
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MockPhoneVerificationRepo) Consume(ctx context.Context, id uuid.UUID) (bool, error) {
+	for _, v := range m.Items {
+		if v.ID == id && !v.Consumed {
+			v.Consumed = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type MockSMSProducer struct {
+	Sent []producer.SMSMessage
+}
+
+func (m *MockSMSProducer) SendSMS(ctx context.Context, key string, msg producer.SMSMessage) error {
+	m.Sent = append(m.Sent, msg)
+	return nil
+}
+
+// blacklistingTokenProvider — MockTokenProvider с blacklist'ом, как RSAProvider при включённом Redis
+type blacklistingTokenProvider struct {
+	*MockTokenProvider
+	enabled     bool
+	blacklisted []string
+}
+
+func (p *blacklistingTokenProvider) CanBlacklist() bool { return p.enabled }
+
+func (p *blacklistingTokenProvider) BlacklistToken(ctx context.Context, token string) error {
+	p.blacklisted = append(p.blacklisted, token)
+	return nil
+}
+
 func createTestAuthService(
 	userRepo *MockUserRepo,
 	refreshRepo *MockRefreshRepo,
@@ -1065,7 +1546,7 @@ func TestAuthService_Introspect_Success(t *testing.T) {
 	)
 
 	ctx := context.Background()
-	valid, resultUserID, role, exp, err := authService.Introspect(ctx, "access_token")
+	valid, claims, err := authService.Introspect(ctx, "access_token")
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -1075,15 +1556,1899 @@ func TestAuthService_Introspect_Success(t *testing.T) {
 		t.Error("Expected token to be valid")
 	}
 
-	if resultUserID != userID {
-		t.Errorf("Expected userID %v, got %v", userID, resultUserID)
+	if claims.UserID != userID {
+		t.Errorf("Expected userID %v, got %v", userID, claims.UserID)
 	}
 
-	if role != "ROLE_CUSTOMER" {
-		t.Errorf("Expected role ROLE_CUSTOMER, got %s", role)
+	if claims.Role != "ROLE_CUSTOMER" {
+		t.Errorf("Expected role ROLE_CUSTOMER, got %s", claims.Role)
 	}
 
-	if exp.Before(time.Now()) {
+	if claims.Exp.Before(time.Now()) {
 		t.Error("Expected expiration time to be in the future")
 	}
 }
+
+func TestAuthService_LogoutAll_RevokesAccessTokens(t *testing.T) {
+	refreshRepo := &MockRefreshRepo{}
+	sessions := &MockSessionRepo{}
+	revoker := &MockTokenRevoker{}
+
+	userID := uuid.New()
+	ctx := service.WithUserID(context.Background(), userID)
+
+	var gotReason string
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, reason string) error {
+		if uid != userID {
+			t.Errorf("Expected userID %v, got %v", userID, uid)
+		}
+		if at.IsZero() {
+			t.Error("Expected non-zero watermark")
+		}
+		gotReason = reason
+		return nil
+	}
+
+	authService := createTestAuthService(
+		nil, refreshRepo, nil, nil, nil, sessions, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetTokenRevoker(revoker)
+
+	if _, err := authService.LogoutAll(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotReason != service.RevokeReasonLogoutAll {
+		t.Errorf("Expected reason %s, got %s", service.RevokeReasonLogoutAll, gotReason)
+	}
+}
+
+func TestAuthService_ChangeUserRole_RevokesAccessTokens(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	revoker := &MockTokenRevoker{}
+
+	userID := uuid.New()
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: userID, Role: models.RoleCustomer}, nil
+	}
+
+	var updatedRole models.Role
+	userRepo.UpdateRoleFunc = func(ctx context.Context, id uuid.UUID, role models.Role) error {
+		updatedRole = role
+		return nil
+	}
+
+	revoked := false
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, reason string) error {
+		if reason != service.RevokeReasonRoleChange {
+			t.Errorf("Expected reason %s, got %s", service.RevokeReasonRoleChange, reason)
+		}
+		revoked = true
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetTokenRevoker(revoker)
+
+	if err := authService.ChangeUserRole(context.Background(), userID, models.RoleVendor); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if updatedRole != models.RoleVendor {
+		t.Errorf("Expected role %s, got %s", models.RoleVendor, updatedRole)
+	}
+
+	if !revoked {
+		t.Error("Expected access tokens to be revoked")
+	}
+}
+
+func TestAuthService_Login_DisabledAccount(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	hasher := &MockPasswordHasher{}
+
+	userRepo.GetByEmailFunc = func(ctx context.Context, email string) (*models.User, error) {
+		return &models.User{
+			ID:         uuid.New(),
+			Email:      email,
+			Password:   "hashed_password123",
+			IsDisabled: true,
+		}, nil
+	}
+
+	hasher.CompareFunc = func(hash, password string) bool {
+		return true
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, hasher, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+
+	_, _, _, err := authService.Login(context.Background(), "test@example.com", "password123", service.ClientMeta{})
+
+	if !errors.Is(err, service.ErrAccountDisabled) {
+		t.Errorf("Expected ErrAccountDisabled, got %v", err)
+	}
+}
+
+func TestAuthService_GrantRolePermission_RequiresRBACManage(t *testing.T) {
+	perms := &MockPermissionRepo{}
+	perms.GrantFunc = func(ctx context.Context, role models.Role, code string) error {
+		t.Error("Grant must not be called without rbac:manage")
+		return nil
+	}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetPermissionRepo(perms)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermProductWrite})
+	err := authService.GrantRolePermission(ctx, models.RoleVendor, authz.PermStockAdjustAny)
+
+	if !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_GrantRolePermission_Success(t *testing.T) {
+	perms := &MockPermissionRepo{}
+
+	var gotRole models.Role
+	var gotCode string
+	perms.GrantFunc = func(ctx context.Context, role models.Role, code string) error {
+		gotRole, gotCode = role, code
+		return nil
+	}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetPermissionRepo(perms)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermRBACManage})
+	if err := authService.GrantRolePermission(ctx, models.RoleVendor, authz.PermStockAdjustAny); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotRole != models.RoleVendor || gotCode != authz.PermStockAdjustAny {
+		t.Errorf("Unexpected grant: %s %s", gotRole, gotCode)
+	}
+}
+
+func TestAuthService_GrantRolePermission_UnknownPermission(t *testing.T) {
+	perms := &MockPermissionRepo{}
+	perms.ExistsFunc = func(ctx context.Context, code string) (bool, error) {
+		return false, nil
+	}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetPermissionRepo(perms)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermRBACManage})
+	err := authService.GrantRolePermission(ctx, models.RoleVendor, "unknown:perm")
+
+	if !errors.Is(err, service.ErrPermissionNotFound) {
+		t.Errorf("Expected ErrPermissionNotFound, got %v", err)
+	}
+}
+
+func TestAuthService_RevokeRolePermission_KeepsAdminRBAC(t *testing.T) {
+	perms := &MockPermissionRepo{}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetPermissionRepo(perms)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermRBACManage})
+	err := authService.RevokeRolePermission(ctx, models.RoleAdmin, authz.PermRBACManage)
+
+	if !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_DeleteAccount_Success(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	accounts := &MockAccountRepo{}
+	revoker := &MockTokenRevoker{}
+
+	userID := uuid.New()
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "test@example.com", Password: "hashed_password123"}, nil
+	}
+
+	var revoked, anonymized bool
+	var outboxed *models.UserEventOutbox
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, reason string) error {
+		if reason != service.RevokeReasonDeleted {
+			t.Errorf("Expected reason %q, got %q", service.RevokeReasonDeleted, reason)
+		}
+		revoked = true
+		return nil
+	}
+	accounts.AnonymizeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, event *models.UserEventOutbox) error {
+		if !revoked {
+			t.Error("Access tokens must be revoked before anonymization")
+		}
+		anonymized = uid == userID
+		outboxed = event
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, &MockPasswordHasher{}, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetTokenRevoker(revoker)
+	authService.SetAccountRepo(accounts)
+
+	ctx := service.WithUserID(context.Background(), userID)
+	if err := authService.DeleteAccount(ctx, "password123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !anonymized {
+		t.Error("Expected account to be anonymized")
+	}
+	if outboxed == nil || outboxed.EventType != producer.UserEventAccountDeleted || outboxed.UserID != userID {
+		t.Errorf("Expected account_deleted event in outbox, got %+v", outboxed)
+	}
+}
+
+func TestAuthService_DeleteAccount_AnonymizeFails(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	accounts := &MockAccountRepo{}
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Password: "hashed_password123"}, nil
+	}
+	dbErr := errors.New("db down")
+	accounts.AnonymizeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, event *models.UserEventOutbox) error {
+		return dbErr
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, &MockPasswordHasher{}, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAccountRepo(accounts)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	if err := authService.DeleteAccount(ctx, "password123"); !errors.Is(err, dbErr) {
+		t.Errorf("Expected %v, got %v", dbErr, err)
+	}
+}
+
+func TestAuthService_DeleteAccount_WrongPassword(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	accounts := &MockAccountRepo{}
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Password: "hashed_password123"}, nil
+	}
+	accounts.AnonymizeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, event *models.UserEventOutbox) error {
+		t.Error("Anonymize must not be called with a wrong password")
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, &MockPasswordHasher{}, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAccountRepo(accounts)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	err := authService.DeleteAccount(ctx, "wrong")
+
+	if !errors.Is(err, service.ErrInvalidCredentials) {
+		t.Errorf("Expected ErrInvalidCredentials, got %v", err)
+	}
+}
+
+func TestAuthService_ExportMyData_OmitsSecrets(t *testing.T) {
+	accounts := &MockAccountRepo{}
+
+	userID := uuid.New()
+	accounts.SnapshotFunc = func(ctx context.Context, uid uuid.UUID) (*service.AccountSnapshot, error) {
+		return &service.AccountSnapshot{
+			User:          models.User{ID: uid, Email: "test@example.com", Password: "secret_hash", Role: models.RoleCustomer},
+			RefreshTokens: []models.RefreshToken{{ID: uuid.New(), UserID: uid, TokenHash: "refresh_hash"}},
+			PasswordResets: []models.PasswordResetToken{
+				{ID: uuid.New(), UserID: uid, Email: "test@example.com", CodeHash: "code_hash"},
+			},
+		}, nil
+	}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAccountRepo(accounts)
+
+	data, _, err := authService.ExportMyData(service.WithUserID(context.Background(), userID))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Export is not valid JSON: %v", err)
+	}
+	user, _ := out["user"].(map[string]any)
+	if user["email"] != "test@example.com" || user["id"] != userID.String() {
+		t.Errorf("Unexpected user in export: %v", user)
+	}
+	for _, secret := range []string{"secret_hash", "refresh_hash", "code_hash"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("Export must not contain %q", secret)
+		}
+	}
+}
+
+func TestAuthService_ExportMyData_Unauthenticated(t *testing.T) {
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAccountRepo(&MockAccountRepo{})
+
+	_, _, err := authService.ExportMyData(context.Background())
+
+	if !errors.Is(err, service.ErrUnauthenticated) {
+		t.Errorf("Expected ErrUnauthenticated, got %v", err)
+	}
+}
+
+func TestAuthService_SubmitVendorApplication_Success(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	apps := &MockVendorApplicationRepo{}
+
+	userID := uuid.New()
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer}, nil
+	}
+	var created *models.VendorApplication
+	apps.CreateFunc = func(ctx context.Context, a *models.VendorApplication) error {
+		created = a
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := service.WithUserID(context.Background(), userID)
+	app, err := authService.SubmitVendorApplication(ctx, &models.VendorApplication{
+		CompanyName: "ООО Ромашка",
+		TaxID:       "7701234567",
+		Status:      models.VendorApplicationApproved, // клиент не может задать статус
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created == nil || app.UserID != userID || app.Status != models.VendorApplicationPending {
+		t.Errorf("Unexpected application: %+v", app)
+	}
+}
+
+func TestAuthService_SubmitVendorApplication_AlreadyPending(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	apps := &MockVendorApplicationRepo{}
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer}, nil
+	}
+	apps.HasPendingByUserFunc = func(ctx context.Context, userID uuid.UUID) (bool, error) {
+		return true, nil
+	}
+	apps.CreateFunc = func(ctx context.Context, a *models.VendorApplication) error {
+		t.Error("Create must not be called while another application is pending")
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	_, err := authService.SubmitVendorApplication(ctx, &models.VendorApplication{CompanyName: "ООО Ромашка", TaxID: "7701234567"})
+	if !errors.Is(err, service.ErrVendorApplicationPending) {
+		t.Errorf("Expected ErrVendorApplicationPending, got %v", err)
+	}
+}
+
+func TestAuthService_ApproveVendorApplication_ChangesRoleAndNotifies(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	apps := &MockVendorApplicationRepo{}
+	revoker := &MockTokenRevoker{}
+	emailProducer := &MockEmailProducer{}
+
+	appID, userID, adminID := uuid.New(), uuid.New(), uuid.New()
+	apps.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+		return &models.VendorApplication{ID: id, UserID: userID, CompanyName: "ООО Ромашка", Status: models.VendorApplicationPending}, nil
+	}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "vendor@example.com", Role: models.RoleCustomer}, nil
+	}
+
+	var newRole models.Role
+	userRepo.UpdateRoleFunc = func(ctx context.Context, id uuid.UUID, role models.Role) error {
+		newRole = role
+		return nil
+	}
+	var revokeReason string
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, reason string) error {
+		revokeReason = reason
+		return nil
+	}
+	apps.ReviewFunc = func(ctx context.Context, id uuid.UUID, status models.VendorApplicationStatus, reviewer *uuid.UUID, reason *string, at time.Time) (bool, error) {
+		if status != models.VendorApplicationApproved || reviewer == nil || *reviewer != adminID {
+			t.Errorf("Unexpected review: status=%s reviewer=%v", status, reviewer)
+		}
+		return true, nil
+	}
+	var sent producer.EmailMessage
+	emailProducer.SendEmailFunc = func(ctx context.Context, to string, message producer.EmailMessage) error {
+		sent = message
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, emailProducer,
+	)
+	authService.SetTokenRevoker(revoker)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := service.WithUserID(context.Background(), adminID)
+	ctx = authz.WithPermissions(ctx, []string{authz.PermVendorReview})
+	app, err := authService.ApproveVendorApplication(ctx, appID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if app.Status != models.VendorApplicationApproved || app.ReviewedAt == nil {
+		t.Errorf("Unexpected application: %+v", app)
+	}
+	if newRole != models.RoleVendor {
+		t.Errorf("Expected role %s, got %q", models.RoleVendor, newRole)
+	}
+	if revokeReason != service.RevokeReasonRoleChange {
+		t.Errorf("Expected access tokens to be revoked, got reason %q", revokeReason)
+	}
+	if sent.Template != "vendor_approved" || sent.To != "vendor@example.com" || sent.Data["CompanyName"] != "ООО Ромашка" {
+		t.Errorf("Unexpected email: %+v", sent)
+	}
+}
+
+func TestAuthService_ApproveVendorApplication_AlreadyReviewed(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	apps := &MockVendorApplicationRepo{}
+
+	apps.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+		return &models.VendorApplication{ID: id, UserID: uuid.New(), Status: models.VendorApplicationRejected}, nil
+	}
+	userRepo.UpdateRoleFunc = func(ctx context.Context, id uuid.UUID, role models.Role) error {
+		t.Error("UpdateRole must not be called for a reviewed application")
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermVendorReview})
+	_, err := authService.ApproveVendorApplication(ctx, uuid.New())
+	if !errors.Is(err, service.ErrVendorApplicationReviewed) {
+		t.Errorf("Expected ErrVendorApplicationReviewed, got %v", err)
+	}
+}
+
+func TestAuthService_RejectVendorApplication_Success(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	apps := &MockVendorApplicationRepo{}
+	emailProducer := &MockEmailProducer{}
+
+	userID := uuid.New()
+	apps.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.VendorApplication, error) {
+		return &models.VendorApplication{ID: id, UserID: userID, CompanyName: "ООО Ромашка", Status: models.VendorApplicationPending}, nil
+	}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "vendor@example.com", Role: models.RoleCustomer}, nil
+	}
+	userRepo.UpdateRoleFunc = func(ctx context.Context, id uuid.UUID, role models.Role) error {
+		t.Error("UpdateRole must not be called on rejection")
+		return nil
+	}
+	var sent producer.EmailMessage
+	emailProducer.SendEmailFunc = func(ctx context.Context, to string, message producer.EmailMessage) error {
+		sent = message
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, emailProducer,
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermVendorReview})
+	app, err := authService.RejectVendorApplication(ctx, uuid.New(), "нет документов")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if app.Status != models.VendorApplicationRejected || app.RejectReason == nil || *app.RejectReason != "нет документов" {
+		t.Errorf("Unexpected application: %+v", app)
+	}
+	if sent.Template != "vendor_rejected" || sent.Data["Reason"] != "нет документов" {
+		t.Errorf("Unexpected email: %+v", sent)
+	}
+}
+
+func TestAuthService_ListVendorApplications_RequiresPermission(t *testing.T) {
+	apps := &MockVendorApplicationRepo{}
+	apps.ListFunc = func(ctx context.Context, status models.VendorApplicationStatus, limit, offset int) ([]models.VendorApplication, int64, error) {
+		t.Error("List must not be called without vendor:review")
+		return nil, 0, nil
+	}
+
+	authService := createTestAuthService(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermProductWrite})
+	_, _, err := authService.ListVendorApplications(ctx, "", 10, 0)
+	if !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_SetUserRole_RequiresUserManage(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	userRepo.UpdateRoleFunc = func(ctx context.Context, id uuid.UUID, role models.Role) error {
+		t.Error("UpdateRole must not be called without user:manage")
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermRBACManage})
+	err := authService.SetUserRole(ctx, uuid.New(), models.RoleVendor)
+	if !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_BlockUser_RevokesEverything(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	refreshRepo := &MockRefreshRepo{}
+	revoker := &MockTokenRevoker{}
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer}, nil
+	}
+	var disabled, refreshRevoked bool
+	userRepo.SetDisabledFunc = func(ctx context.Context, id uuid.UUID, d bool) error {
+		disabled = d
+		return nil
+	}
+	refreshRepo.RevokeAllFunc = func(ctx context.Context, userID uuid.UUID) (int64, error) {
+		refreshRevoked = true
+		return 1, nil
+	}
+	var reason string
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, uid uuid.UUID, at time.Time, r string) error {
+		reason = r
+		return nil
+	}
+
+	// без хранилища сессий блокировка тоже должна работать (nil-интерфейс, а не nil-указатель мока)
+	authService := service.NewAuthService(
+		userRepo, refreshRepo, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+		time.Hour, 24*time.Hour, zap.NewNop(),
+	)
+	authService.SetTokenRevoker(revoker)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermUserManage})
+	if err := authService.BlockUser(ctx, uuid.New()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !disabled || !refreshRevoked || reason != service.RevokeReasonDisabled {
+		t.Errorf("Expected user disabled with all tokens revoked: disabled=%v refresh=%v reason=%q", disabled, refreshRevoked, reason)
+	}
+}
+
+func TestAuthService_BlockUser_Self(t *testing.T) {
+	authService := createTestAuthService(
+		&MockUserRepo{}, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+
+	adminID := uuid.New()
+	ctx := service.WithUserID(context.Background(), adminID)
+	ctx = authz.WithPermissions(ctx, []string{authz.PermUserManage})
+	if err := authService.BlockUser(ctx, adminID); !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func newAPIKeyTestService(userRepo *MockUserRepo, keys *MockAPIKeyRepo, perms *MockPermissionRepo) *service.AuthService {
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAPIKeyRepo(keys)
+	if perms != nil {
+		authService.SetPermissionRepo(perms)
+	}
+	return authService
+}
+
+func TestAuthService_CreateAPIKey_Success(t *testing.T) {
+	keys := &MockAPIKeyRepo{}
+	authService := newAPIKeyTestService(&MockUserRepo{}, keys, nil)
+
+	userID := uuid.New()
+	ctx := service.WithUserID(context.Background(), userID)
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite, authz.PermStockAdjust})
+
+	key, secret, err := authService.CreateAPIKey(ctx, " erp sync ", []string{authz.PermStockAdjust, authz.PermProductWrite}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(secret, "ohk_") || len(secret) < 40 {
+		t.Errorf("Unexpected secret format: %q", secret)
+	}
+	if key.UserID != userID || key.Name != "erp sync" || !strings.HasPrefix(secret, key.Prefix) {
+		t.Errorf("Unexpected key: %+v", key)
+	}
+	if key.KeyHash == "" || strings.Contains(key.KeyHash, secret) {
+		t.Error("Only a hash of the key must be stored")
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].KeyHash != key.KeyHash {
+		t.Fatalf("Expected key to be stored, got %+v", keys.Keys)
+	}
+}
+
+func TestAuthService_CreateAPIKey_ScopeNotGranted(t *testing.T) {
+	authService := newAPIKeyTestService(&MockUserRepo{}, &MockAPIKeyRepo{}, nil)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite})
+
+	_, _, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermUserManage}, nil)
+	if !errors.Is(err, service.ErrScopeNotGranted) {
+		t.Errorf("Expected ErrScopeNotGranted, got %v", err)
+	}
+}
+
+func TestAuthService_CreateAPIKey_PastExpiry(t *testing.T) {
+	authService := newAPIKeyTestService(&MockUserRepo{}, &MockAPIKeyRepo{}, nil)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite})
+	past := time.Now().Add(-time.Minute)
+
+	_, _, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermProductWrite}, &past)
+	if !errors.Is(err, service.ErrInvalidExpiry) {
+		t.Errorf("Expected ErrInvalidExpiry, got %v", err)
+	}
+}
+
+func TestAuthService_CreateAPIKey_NotWithAPIKey(t *testing.T) {
+	authService := newAPIKeyTestService(&MockUserRepo{}, &MockAPIKeyRepo{}, nil)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = service.WithAPIKeyID(ctx, uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite})
+
+	_, _, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermProductWrite}, nil)
+	if !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_ResolveAPIKey_TrimsScopesToRole(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	keys := &MockAPIKeyRepo{}
+	perms := &MockPermissionRepo{}
+
+	userID := uuid.New()
+	role := models.RoleVendor
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: role}, nil
+	}
+	// после выпуска ключа у роли забрали stock:adjust
+	perms.ListByRoleFunc = func(ctx context.Context, r models.Role) ([]string, error) {
+		return []string{authz.PermProductWrite}, nil
+	}
+	authService := newAPIKeyTestService(userRepo, keys, perms)
+
+	ctx := service.WithUserID(context.Background(), userID)
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite, authz.PermStockAdjust})
+	key, secret, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermProductWrite, authz.PermStockAdjust}, nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	id, err := authService.ResolveAPIKey(context.Background(), secret, "203.0.113.7")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if id.UserID != userID || id.Role != role || id.KeyID != key.ID {
+		t.Errorf("Unexpected identity: %+v", id)
+	}
+	if len(id.Scopes) != 1 || id.Scopes[0] != authz.PermProductWrite {
+		t.Errorf("Expected scopes trimmed to role permissions, got %v", id.Scopes)
+	}
+	if ip := keys.Touched[key.ID]; ip == nil || *ip != "203.0.113.7" {
+		t.Errorf("Expected last used ip to be recorded, got %v", ip)
+	}
+}
+
+func TestAuthService_ResolveAPIKey_Invalid(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	keys := &MockAPIKeyRepo{}
+
+	disabled := false
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleVendor, IsDisabled: disabled}, nil
+	}
+	authService := newAPIKeyTestService(userRepo, keys, nil)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite})
+	soon := time.Now().Add(time.Hour)
+	key, secret, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermProductWrite}, &soon)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if _, err := authService.ResolveAPIKey(context.Background(), "ohk_unknown_key_value_123", ""); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Errorf("unknown key: expected ErrInvalidAPIKey, got %v", err)
+	}
+	if _, err := authService.ResolveAPIKey(context.Background(), "not-a-key", ""); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Errorf("foreign format: expected ErrInvalidAPIKey, got %v", err)
+	}
+
+	disabled = true
+	if _, err := authService.ResolveAPIKey(context.Background(), secret, ""); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Errorf("disabled user: expected ErrInvalidAPIKey, got %v", err)
+	}
+	disabled = false
+
+	expired := time.Now().Add(-time.Second)
+	keys.Keys[0].ExpiresAt = &expired
+	if _, err := authService.ResolveAPIKey(context.Background(), secret, ""); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Errorf("expired key: expected ErrInvalidAPIKey, got %v", err)
+	}
+	keys.Keys[0].ExpiresAt = &soon
+
+	if err := authService.RevokeAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	if _, err := authService.ResolveAPIKey(context.Background(), secret, ""); !errors.Is(err, service.ErrInvalidAPIKey) {
+		t.Errorf("revoked key: expected ErrInvalidAPIKey, got %v", err)
+	}
+}
+
+func TestAuthService_RevokeAPIKey_OtherUser(t *testing.T) {
+	keys := &MockAPIKeyRepo{}
+	authService := newAPIKeyTestService(&MockUserRepo{}, keys, nil)
+
+	owner := service.WithUserID(context.Background(), uuid.New())
+	owner = authz.WithPermissions(owner, []string{authz.PermProductWrite})
+	key, _, err := authService.CreateAPIKey(owner, "erp", []string{authz.PermProductWrite}, nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	stranger := service.WithUserID(context.Background(), uuid.New())
+	if err := authService.RevokeAPIKey(stranger, key.ID); !errors.Is(err, service.ErrAPIKeyNotFound) {
+		t.Errorf("Expected ErrAPIKeyNotFound, got %v", err)
+	}
+	if keys.Keys[0].RevokedAt != nil {
+		t.Error("Key of another user must stay active")
+	}
+}
+
+func newImpersonationTestService(userRepo *MockUserRepo, tokens *MockTokenProvider, audit *MockImpersonationRepo) *service.AuthService {
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, tokens, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetImpersonationRepo(audit)
+	return authService
+}
+
+func adminContext(adminID uuid.UUID) context.Context {
+	ctx := service.WithUserID(context.Background(), adminID)
+	return authz.WithPermissions(ctx, []string{authz.PermUserImpersonate})
+}
+
+func TestAuthService_Impersonate_Success(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	tokens := &MockTokenProvider{}
+	audit := &MockImpersonationRepo{}
+	authService := newImpersonationTestService(userRepo, tokens, audit)
+
+	adminID, targetID := uuid.New(), uuid.New()
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer}, nil
+	}
+	var signedFor, signedBy uuid.UUID
+	var signedTTL time.Duration
+	tokens.SignImpersonationFunc = func(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+		signedFor, signedBy, signedTTL = sub, actor, ttl
+		return "impersonation_token", time.Now().Add(ttl), nil
+	}
+
+	token, _, err := authService.Impersonate(adminContext(adminID), targetID, " ticket #42 ", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if token != "impersonation_token" || signedFor != targetID || signedBy != adminID {
+		t.Errorf("Unexpected token %q for %s by %s", token, signedFor, signedBy)
+	}
+	if signedTTL <= 0 || signedTTL > 15*time.Minute {
+		t.Errorf("Impersonation token must be short-lived, got ttl %v", signedTTL)
+	}
+	if len(audit.Events) != 1 {
+		t.Fatalf("Expected one audit event, got %d", len(audit.Events))
+	}
+	ev := audit.Events[0]
+	if ev.ActorID != adminID || ev.TargetID != targetID || ev.Reason != "ticket #42" || ev.IP == nil || *ev.IP != "10.0.0.1" {
+		t.Errorf("Unexpected audit event: %+v", ev)
+	}
+}
+
+func TestAuthService_Impersonate_RequiresPermission(t *testing.T) {
+	authService := newImpersonationTestService(&MockUserRepo{}, &MockTokenProvider{}, &MockImpersonationRepo{})
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermUserManage})
+
+	if _, _, err := authService.Impersonate(ctx, uuid.New(), "ticket", ""); !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_Impersonate_RejectsAdminsAndSelf(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	audit := &MockImpersonationRepo{}
+	authService := newImpersonationTestService(userRepo, &MockTokenProvider{}, audit)
+
+	adminID := uuid.New()
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleAdmin}, nil
+	}
+
+	if _, _, err := authService.Impersonate(adminContext(adminID), uuid.New(), "ticket", ""); !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for admin target, got %v", err)
+	}
+	if _, _, err := authService.Impersonate(adminContext(adminID), adminID, "ticket", ""); !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden for self, got %v", err)
+	}
+	if len(audit.Events) != 0 {
+		t.Error("Rejected attempts must not be recorded as impersonations")
+	}
+}
+
+func TestAuthService_Impersonate_NoTokenWithoutAudit(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	tokens := &MockTokenProvider{}
+	authService := newImpersonationTestService(userRepo, tokens, &MockImpersonationRepo{CreateErr: errors.New("db down")})
+
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer}, nil
+	}
+	tokens.SignImpersonationFunc = func(ctx context.Context, sub uuid.UUID, role string, actor uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+		t.Error("Token must not be issued when the audit record fails")
+		return "", time.Time{}, nil
+	}
+
+	if _, _, err := authService.Impersonate(adminContext(uuid.New()), uuid.New(), "ticket", ""); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestAuthService_WhileImpersonating_SensitiveOpsForbidden(t *testing.T) {
+	authService := createTestAuthService(
+		&MockUserRepo{}, &MockRefreshRepo{}, nil, &MockPasswordHasher{}, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetAccountRepo(&MockAccountRepo{})
+	authService.SetAPIKeyRepo(&MockAPIKeyRepo{})
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	ctx = service.WithActorID(ctx, uuid.New())
+	ctx = authz.WithPermissions(ctx, []string{authz.PermProductWrite})
+
+	if err := authService.DeleteAccount(ctx, "password123"); !errors.Is(err, service.ErrImpersonationForbidden) {
+		t.Errorf("DeleteAccount: expected ErrImpersonationForbidden, got %v", err)
+	}
+	if _, _, err := authService.CreateAPIKey(ctx, "erp", []string{authz.PermProductWrite}, nil); !errors.Is(err, service.ErrImpersonationForbidden) {
+		t.Errorf("CreateAPIKey: expected ErrImpersonationForbidden, got %v", err)
+	}
+	if _, err := authService.LogoutAll(ctx); !errors.Is(err, service.ErrImpersonationForbidden) {
+		t.Errorf("LogoutAll: expected ErrImpersonationForbidden, got %v", err)
+	}
+}
+
+func newDeviceTestService(userID uuid.UUID, emails *[]producer.EmailMessage) (*service.AuthService, *MockTrustedDeviceRepo, *MockSignInAlertRepo) {
+	userRepo := &MockUserRepo{}
+	userRepo.GetByEmailFunc = func(ctx context.Context, email string) (*models.User, error) {
+		return &models.User{ID: userID, Email: email, Password: "hashed_password123", Role: models.RoleCustomer}, nil
+	}
+	tokens := &MockTokenProvider{}
+	tokens.SignAccessFunc = func(ctx context.Context, sub uuid.UUID, role string, ttl time.Duration) (string, time.Time, error) {
+		return "access_token", time.Now().Add(ttl), nil
+	}
+	tokens.NewRefreshFunc = func(ctx context.Context, sub uuid.UUID, ttl time.Duration) (string, string, time.Time, error) {
+		return "refresh_opaque", "refresh_hash", time.Now().Add(ttl), nil
+	}
+	emailProducer := &MockEmailProducer{}
+	emailProducer.SendEmailFunc = func(ctx context.Context, to string, message producer.EmailMessage) error {
+		*emails = append(*emails, message)
+		return nil
+	}
+
+	authService := createTestAuthService(
+		userRepo, &MockRefreshRepo{}, nil, &MockPasswordHasher{}, tokens, &MockSessionRepo{}, nil, nil, nil, emailProducer,
+	)
+	devices, alerts := &MockTrustedDeviceRepo{}, &MockSignInAlertRepo{}
+	authService.SetDeviceRepos(devices, alerts)
+	authService.SetAppURL("https://shop.example/")
+	return authService, devices, alerts
+}
+
+func loginFrom(t *testing.T, authService *service.AuthService, clientID, ip string) {
+	t.Helper()
+	_, _, _, err := authService.Login(context.Background(), "test@example.com", "password123", service.ClientMeta{
+		ClientID:  stringPtr(clientID),
+		IP:        stringPtr(ip),
+		UserAgent: stringPtr("test-agent"),
+	})
+	if err != nil {
+		t.Fatalf("Login from %s/%s: %v", clientID, ip, err)
+	}
+}
+
+func TestAuthService_Login_NewDeviceAlert(t *testing.T) {
+	var emails []producer.EmailMessage
+	authService, devices, alerts := newDeviceTestService(uuid.New(), &emails)
+
+	// первый вход — сравнивать не с чем
+	loginFrom(t, authService, "cid-laptop", "203.0.113.10")
+	// тот же браузер в той же /24 — знакомое устройство
+	loginFrom(t, authService, "cid-laptop", "203.0.113.77")
+	if len(emails) != 0 {
+		t.Fatalf("Expected no alerts for first and known sign-ins, got %d", len(emails))
+	}
+
+	loginFrom(t, authService, "cid-phone", "198.51.100.5")
+	if len(emails) != 1 || emails[0].Template != "new_sign_in" {
+		t.Fatalf("Expected one new_sign_in email, got %+v", emails)
+	}
+	reportURL, _ := emails[0].Data["ReportURL"].(string)
+	if !strings.HasPrefix(reportURL, "https://shop.example/security/not-me?token=") {
+		t.Errorf("Unexpected report URL %q", reportURL)
+	}
+	if len(alerts.Alerts) != 1 || alerts.Alerts[0].ClientID != "cid-phone" {
+		t.Errorf("Expected alert for cid-phone, got %+v", alerts.Alerts)
+	}
+	if len(devices.Devices) != 2 {
+		t.Errorf("Expected 2 trusted devices, got %d", len(devices.Devices))
+	}
+}
+
+func TestAuthService_ReportSignIn_RevokesAndRequiresReset(t *testing.T) {
+	userID := uuid.New()
+	var emails []producer.EmailMessage
+	authService, devices, alerts := newDeviceTestService(userID, &emails)
+	loginFrom(t, authService, "cid-laptop", "203.0.113.10")
+	loginFrom(t, authService, "cid-attacker", "198.51.100.5")
+	if len(emails) != 1 {
+		t.Fatalf("Expected one alert email, got %d", len(emails))
+	}
+	reportURL := emails[0].Data["ReportURL"].(string)
+	token := reportURL[strings.Index(reportURL, "token=")+len("token="):]
+
+	// те же моки, но с наблюдением за отзывом
+	userRepo := &MockUserRepo{}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "test@example.com"}, nil
+	}
+	userRepo.GetByEmailFunc = func(ctx context.Context, email string) (*models.User, error) {
+		return &models.User{ID: userID, Email: email}, nil
+	}
+	var resetRequired bool
+	userRepo.RequirePasswordResetFunc = func(ctx context.Context, id uuid.UUID) error {
+		resetRequired = id == userID
+		return nil
+	}
+	refreshRepo := &MockRefreshRepo{}
+	var refreshRevoked, sessionsRevoked bool
+	refreshRepo.RevokeAllFunc = func(ctx context.Context, id uuid.UUID) (int64, error) {
+		refreshRevoked = true
+		return 2, nil
+	}
+	sessions := &MockSessionRepo{}
+	sessions.RevokeAllByUserFunc = func(ctx context.Context, id uuid.UUID) (int64, error) {
+		sessionsRevoked = true
+		return 2, nil
+	}
+	revoker := &MockTokenRevoker{}
+	var revokeReason string
+	revoker.RevokeIssuedBeforeFunc = func(ctx context.Context, id uuid.UUID, at time.Time, reason string) error {
+		revokeReason = reason
+		return nil
+	}
+	emailProducer := &MockEmailProducer{}
+	var resetSent bool
+	emailProducer.SendEmailFunc = func(ctx context.Context, to string, message producer.EmailMessage) error {
+		resetSent = true
+		return nil
+	}
+	reportService := createTestAuthService(
+		userRepo, refreshRepo, nil, nil, nil, sessions, &MockPasswordResetRepo{}, nil, &MockCacheClient{}, emailProducer,
+	)
+	reportService.SetDeviceRepos(devices, alerts)
+	reportService.SetTokenRevoker(revoker)
+
+	if err := reportService.ReportSignIn(context.Background(), token); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !resetRequired || !refreshRevoked || !sessionsRevoked || revokeReason != service.RevokeReasonCompromised {
+		t.Errorf("Expected full revocation: reset=%v refresh=%v sessions=%v reason=%q", resetRequired, refreshRevoked, sessionsRevoked, revokeReason)
+	}
+	if !resetSent {
+		t.Error("Expected password reset email to be sent")
+	}
+	for _, d := range devices.Devices {
+		if d.ClientID == "cid-attacker" {
+			t.Error("Reported device must be forgotten")
+		}
+	}
+
+	// ссылка одноразовая
+	if err := reportService.ReportSignIn(context.Background(), token); !errors.Is(err, service.ErrInvalidAlertToken) {
+		t.Errorf("Expected ErrInvalidAlertToken on reuse, got %v", err)
+	}
+}
+
+func TestAuthService_Login_PasswordResetRequired(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	userRepo.GetByEmailFunc = func(ctx context.Context, email string) (*models.User, error) {
+		return &models.User{ID: uuid.New(), Email: email, Password: "hashed_password123", MustResetPassword: true}, nil
+	}
+	authService := createTestAuthService(
+		userRepo, nil, nil, &MockPasswordHasher{}, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+
+	_, _, _, err := authService.Login(context.Background(), "test@example.com", "password123", service.ClientMeta{})
+	if !errors.Is(err, service.ErrPasswordResetRequired) {
+		t.Errorf("Expected ErrPasswordResetRequired, got %v", err)
+	}
+}
+
+func TestIPNetwork(t *testing.T) {
+	cases := map[string]string{
+		"203.0.113.77":         "203.0.113.0/24",
+		"::ffff:203.0.113.77":  "203.0.113.0/24",
+		"2001:db8:1:2:3:4:5:6": "2001:db8:1:2::/64",
+		"not-an-ip":            "",
+	}
+	for in, want := range cases {
+		if got := service.IPNetwork(in); got != want {
+			t.Errorf("IPNetwork(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func testPasswordPolicy() *password.Policy {
+	return &password.Policy{
+		MinLength:       8,
+		RequiredClasses: []string{password.ClassLetter, password.ClassDigit},
+		ForbidEmail:     true,
+		History:         3,
+	}
+}
+
+func TestAuthService_Register_PasswordPolicy(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	userRepo.CreateFunc = func(ctx context.Context, u *models.User) error {
+		t.Error("Create must not be called for a weak password")
+		return nil
+	}
+	authService := createTestAuthService(
+		userRepo, nil, nil, &MockPasswordHasher{}, nil, nil, nil, &MockEmailVerificationRepo{}, nil, &MockEmailProducer{},
+	)
+	authService.SetPasswordPolicy(testPasswordPolicy(), &MockPasswordHistoryRepo{})
+
+	_, err := authService.Register(context.Background(), "alice@example.com", "alice-password", "ROLE_CUSTOMER")
+	if !errors.Is(err, service.ErrWeakPassword) {
+		t.Fatalf("Expected ErrWeakPassword, got %v", err)
+	}
+	var perr *service.PasswordPolicyError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected PasswordPolicyError, got %T", err)
+	}
+	var got []string
+	for _, v := range perr.Violations {
+		got = append(got, v.Code)
+	}
+	if strings.Join(got, ",") != password.CodeCharClass+","+password.CodeContainsEmail {
+		t.Errorf("Unexpected violations %v", got)
+	}
+}
+
+func TestAuthService_Register_SavesPasswordHistory(t *testing.T) {
+	history := &MockPasswordHistoryRepo{}
+	authService := createTestAuthService(
+		&MockUserRepo{}, nil, nil, &MockPasswordHasher{}, nil, nil, nil, &MockEmailVerificationRepo{}, nil, &MockEmailProducer{},
+	)
+	authService.SetPasswordPolicy(testPasswordPolicy(), history)
+
+	u, err := authService.Register(context.Background(), "bob@example.com", "tr0ub4dor-x", "ROLE_CUSTOMER")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if h := history.Hashes[u.ID]; len(h) != 1 || h[0] != "hashed_tr0ub4dor-x" {
+		t.Errorf("Expected password in history, got %v", h)
+	}
+}
+
+func TestAuthService_ConfirmPasswordReset_RejectsRecentPassword(t *testing.T) {
+	userID := uuid.New()
+	passwordResetRepo := &MockPasswordResetRepo{}
+	passwordResetRepo.GetValidByHashFunc = func(ctx context.Context, codeHash string, now time.Time) (*models.PasswordResetToken, error) {
+		return &models.PasswordResetToken{ID: uuid.New(), UserID: userID, ExpiresAt: time.Now().Add(time.Hour)}, nil
+	}
+	userRepo := &MockUserRepo{}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: userID, Email: "test@example.com", Password: "hashed_current-pass1"}, nil
+	}
+	var updated string
+	userRepo.UpdatePasswordFunc = func(ctx context.Context, user *models.User) error {
+		updated = user.Password
+		return nil
+	}
+	history := &MockPasswordHistoryRepo{Hashes: map[uuid.UUID][]string{
+		userID: {"hashed_current-pass1", "hashed_older-pass2"},
+	}}
+	authService := createTestAuthService(
+		userRepo, &MockRefreshRepo{}, nil, &MockPasswordHasher{}, nil, &MockSessionRepo{}, passwordResetRepo, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetPasswordPolicy(testPasswordPolicy(), history)
+
+	for _, reused := range []string{"current-pass1", "older-pass2"} {
+		err := authService.ConfirmPasswordReset(context.Background(), "123456", reused)
+		var perr *service.PasswordPolicyError
+		if !errors.As(err, &perr) || perr.Violations[0].Code != password.CodeReused {
+			t.Errorf("%s: expected reuse violation, got %v", reused, err)
+		}
+	}
+	if updated != "" {
+		t.Fatalf("Password must not be updated on violation")
+	}
+
+	if err := authService.ConfirmPasswordReset(context.Background(), "123456", "brand-new-pass3"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if h := history.Hashes[userID]; len(h) != 3 || h[0] != "hashed_brand-new-pass3" {
+		t.Errorf("Expected new password at the head of history, got %v", h)
+	}
+}
+
+func newGuestTestService(userRepo *MockUserRepo, cache *MockCacheClient, emailProducer *MockEmailProducer) *service.AuthService {
+	tokens := &MockTokenProvider{}
+	tokens.SignAccessFunc = func(ctx context.Context, sub uuid.UUID, role string, ttl time.Duration) (string, time.Time, error) {
+		return "access_token", time.Now().Add(ttl), nil
+	}
+	tokens.NewRefreshFunc = func(ctx context.Context, sub uuid.UUID, ttl time.Duration) (string, string, time.Time, error) {
+		return "refresh_opaque", "refresh_hash", time.Now().Add(ttl), nil
+	}
+	return createTestAuthService(
+		userRepo, &MockRefreshRepo{}, nil, &MockPasswordHasher{}, tokens, &MockSessionRepo{}, nil, &MockEmailVerificationRepo{}, cache, emailProducer,
+	)
+}
+
+func TestAuthService_CreateGuest_IssuesTokens(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	var created *models.User
+	userRepo.CreateFunc = func(ctx context.Context, u *models.User) error {
+		created = u
+		return nil
+	}
+	authService := newGuestTestService(userRepo, &MockCacheClient{}, &MockEmailProducer{})
+
+	id, pair, err := authService.CreateGuest(context.Background(), service.ClientMeta{IP: stringPtr("203.0.113.10")})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created == nil || !created.IsGuest || created.ID != id || created.Role != models.RoleCustomer {
+		t.Fatalf("Unexpected guest row: %+v", created)
+	}
+	if created.Password != "" || !strings.HasSuffix(created.Email, "@guest.invalid") {
+		t.Errorf("Guest must have no password and a placeholder email, got %+v", created)
+	}
+	if pair.AccessToken != "access_token" || pair.RefreshOpaque != "refresh_opaque" {
+		t.Errorf("Unexpected tokens: %+v", pair)
+	}
+}
+
+func TestAuthService_CreateGuest_RateLimited(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	userRepo.CreateFunc = func(ctx context.Context, u *models.User) error {
+		t.Error("Create must not be called when rate limited")
+		return nil
+	}
+	cache := &MockCacheClient{}
+	cache.CheckRateLimitFunc = func(ctx context.Context, key string) (bool, error) {
+		return key == "guest:203.0.113.10", nil
+	}
+	authService := newGuestTestService(userRepo, cache, &MockEmailProducer{})
+
+	_, _, err := authService.CreateGuest(context.Background(), service.ClientMeta{IP: stringPtr("203.0.113.10")})
+	if !errors.Is(err, service.ErrTooManyRequests) {
+		t.Fatalf("Expected ErrTooManyRequests, got %v", err)
+	}
+}
+
+func TestAuthService_UpgradeGuest_KeepsUserID(t *testing.T) {
+	guestID := uuid.New()
+	userRepo := &MockUserRepo{}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "guest-x@guest.invalid", Role: models.RoleCustomer, IsGuest: true}, nil
+	}
+	var upgradedID uuid.UUID
+	var upgradedEmail, upgradedHash string
+	userRepo.UpgradeGuestFunc = func(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error) {
+		upgradedID, upgradedEmail, upgradedHash = id, email, passwordHash
+		return true, nil
+	}
+	var sent []producer.EmailMessage
+	emailProducer := &MockEmailProducer{}
+	emailProducer.SendEmailFunc = func(ctx context.Context, to string, message producer.EmailMessage) error {
+		sent = append(sent, message)
+		return nil
+	}
+	authService := newGuestTestService(userRepo, &MockCacheClient{}, emailProducer)
+
+	ctx := service.WithUserID(context.Background(), guestID)
+	u, err := authService.UpgradeGuest(ctx, " carol@example.com ", "s3cure-pass")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if u.ID != guestID || u.IsGuest || u.Email != "carol@example.com" {
+		t.Errorf("Unexpected user: %+v", u)
+	}
+	if upgradedID != guestID || upgradedEmail != "carol@example.com" || upgradedHash != "hashed_s3cure-pass" {
+		t.Errorf("Unexpected upgrade call: %s %s %s", upgradedID, upgradedEmail, upgradedHash)
+	}
+	if len(sent) != 1 || sent[0].To != "carol@example.com" {
+		t.Errorf("Expected verification email to the new address, got %+v", sent)
+	}
+}
+
+func TestAuthService_UpgradeGuest_Rejections(t *testing.T) {
+	guestID := uuid.New()
+	userRepo := &MockUserRepo{}
+	isGuest := false
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Email: "dave@example.com", IsGuest: isGuest}, nil
+	}
+	userRepo.ExistsByEmailFunc = func(ctx context.Context, email string) (bool, error) {
+		return email == "taken@example.com", nil
+	}
+	userRepo.UpgradeGuestFunc = func(ctx context.Context, id uuid.UUID, email, passwordHash string) (bool, error) {
+		t.Error("UpgradeGuest must not be called")
+		return false, nil
+	}
+	authService := newGuestTestService(userRepo, &MockCacheClient{}, &MockEmailProducer{})
+	ctx := service.WithUserID(context.Background(), guestID)
+
+	if _, err := authService.UpgradeGuest(ctx, "new@example.com", "s3cure-pass"); !errors.Is(err, service.ErrNotGuest) {
+		t.Errorf("Registered user: expected ErrNotGuest, got %v", err)
+	}
+
+	isGuest = true
+	if _, err := authService.UpgradeGuest(ctx, "taken@example.com", "s3cure-pass"); !errors.Is(err, service.ErrEmailExists) {
+		t.Errorf("Taken email: expected ErrEmailExists, got %v", err)
+	}
+	if _, err := authService.UpgradeGuest(context.Background(), "new@example.com", "s3cure-pass"); !errors.Is(err, service.ErrUnauthenticated) {
+		t.Errorf("No user: expected ErrUnauthenticated, got %v", err)
+	}
+	impersonated := service.WithActorID(ctx, uuid.New())
+	if _, err := authService.UpgradeGuest(impersonated, "new@example.com", "s3cure-pass"); !errors.Is(err, service.ErrImpersonationForbidden) {
+		t.Errorf("Impersonation: expected ErrImpersonationForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_SubmitVendorApplication_GuestRejected(t *testing.T) {
+	userRepo := &MockUserRepo{}
+	userRepo.GetByIDFunc = func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+		return &models.User{ID: id, Role: models.RoleCustomer, IsGuest: true}, nil
+	}
+	apps := &MockVendorApplicationRepo{}
+	apps.CreateFunc = func(ctx context.Context, a *models.VendorApplication) error {
+		t.Error("Create must not be called for a guest")
+		return nil
+	}
+	authService := createTestAuthService(
+		userRepo, nil, nil, nil, nil, nil, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetVendorApplicationRepo(apps)
+
+	ctx := service.WithUserID(context.Background(), uuid.New())
+	_, err := authService.SubmitVendorApplication(ctx, &models.VendorApplication{CompanyName: "ООО Ромашка", TaxID: "7701234567"})
+	if !errors.Is(err, service.ErrGuestAccount) {
+		t.Fatalf("Expected ErrGuestAccount, got %v", err)
+	}
+}
+
+// newOAuthTestService — сервис с одним зарегистрированным resource server'ом
+func newOAuthTestService(t *testing.T, userRepo *MockUserRepo, refreshRepo *MockRefreshRepo, tokens service.TokenProvider) (*service.AuthService, *MockOAuthClientRepo, string, string) {
+	t.Helper()
+	authService := service.NewAuthService(userRepo, refreshRepo, &MockJWKRepo{}, &MockPasswordHasher{}, tokens,
+		&MockSessionRepo{}, &MockPasswordResetRepo{}, &MockEmailVerificationRepo{}, &MockCacheClient{}, &MockEmailProducer{},
+		time.Hour, 24*time.Hour, zap.NewNop())
+	clients := &MockOAuthClientRepo{}
+	authService.SetOAuthClients(clients, "orderhub-web")
+
+	ctx := authz.WithPermissions(context.Background(), []string{authz.PermOAuthClientManage})
+	client, secret, err := authService.CreateOAuthClient(ctx, "reports")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return authService, clients, client.ClientID, secret
+}
+
+func TestAuthService_CreateOAuthClient_RequiresPermission(t *testing.T) {
+	authService := createTestAuthService(&MockUserRepo{}, &MockRefreshRepo{}, nil, nil, nil, nil, nil, nil, nil, nil)
+	authService.SetOAuthClients(&MockOAuthClientRepo{}, "orderhub-web")
+
+	if _, _, err := authService.CreateOAuthClient(context.Background(), "reports"); !errors.Is(err, service.ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthService_IntrospectToken_InvalidClient(t *testing.T) {
+	authService, clients, clientID, _ := newOAuthTestService(t, &MockUserRepo{}, &MockRefreshRepo{}, &MockTokenProvider{})
+
+	for _, tc := range []struct{ id, secret string }{{clientID, "wrong"}, {"rs_unknown", "secret"}} {
+		if _, err := authService.IntrospectToken(context.Background(), tc.id, tc.secret, "a.b.c"); !errors.Is(err, service.ErrInvalidClient) {
+			t.Errorf("%s: expected ErrInvalidClient, got %v", tc.id, err)
+		}
+	}
+	if len(clients.Touched) != 0 {
+		t.Errorf("last_used_at must not change on failed authentication")
+	}
+}
+
+func TestAuthService_IntrospectToken_Access(t *testing.T) {
+	userID := uuid.New()
+	issued := time.Now().Add(-time.Minute).Truncate(time.Second)
+	tokens := &MockTokenProvider{
+		ParseAndValidateAccessFunc: func(ctx context.Context, token string) (*service.Claims, error) {
+			if token != "header.payload.sig" {
+				return nil, errors.New("invalid token")
+			}
+			return &service.Claims{UserID: userID, Perms: []string{"order:read"}, ID: "jti-1", IssuedAt: issued, Exp: issued.Add(time.Hour)}, nil
+		},
+	}
+	authService, clients, clientID, secret := newOAuthTestService(t, &MockUserRepo{}, &MockRefreshRepo{}, tokens)
+
+	info, err := authService.IntrospectToken(context.Background(), clientID, secret, "header.payload.sig")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !info.Active || info.TokenType != service.TokenTypeAccess || info.Subject != userID.String() ||
+		info.JTI != "jti-1" || info.ClientID != "orderhub-web" || !info.IssuedAt.Equal(issued) || len(info.Scope) != 1 {
+		t.Errorf("Unexpected introspection: %+v", info)
+	}
+	if len(clients.Touched) != 1 {
+		t.Errorf("Expected last_used_at update, got %d", len(clients.Touched))
+	}
+
+	info, err = authService.IntrospectToken(context.Background(), clientID, secret, "forged.payload.sig")
+	if err != nil || info.Active {
+		t.Errorf("Invalid access token must be inactive without error, got %+v, %v", info, err)
+	}
+}
+
+func TestAuthService_IntrospectToken_Refresh(t *testing.T) {
+	user := &models.User{ID: uuid.New(), Role: models.RoleCustomer}
+	rt := &models.RefreshToken{ID: uuid.New(), UserID: user.ID, CreatedAt: time.Now().Add(-time.Hour), ExpiresAt: time.Now().Add(time.Hour)}
+	userRepo := &MockUserRepo{GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.User, error) { return user, nil }}
+	refreshRepo := &MockRefreshRepo{GetByHashOnlyFunc: func(ctx context.Context, hash string) (*models.RefreshToken, error) { return rt, nil }}
+	authService, _, clientID, secret := newOAuthTestService(t, userRepo, refreshRepo, &MockTokenProvider{})
+
+	info, err := authService.IntrospectToken(context.Background(), clientID, secret, "opaque")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !info.Active || info.TokenType != service.TokenTypeRefresh || info.JTI != rt.ID.String() || info.Subject != user.ID.String() {
+		t.Errorf("Unexpected introspection: %+v", info)
+	}
+
+	rt.Revoked = true
+	if info, _ := authService.IntrospectToken(context.Background(), clientID, secret, "opaque"); info.Active {
+		t.Error("Revoked refresh token must be inactive")
+	}
+	rt.Revoked = false
+	user.IsDisabled = true
+	if info, _ := authService.IntrospectToken(context.Background(), clientID, secret, "opaque"); info.Active {
+		t.Error("Refresh token of a disabled user must be inactive")
+	}
+}
+
+func TestAuthService_RevokeToken_Access(t *testing.T) {
+	userID := uuid.New()
+	mock := &MockTokenProvider{
+		ParseAndValidateAccessFunc: func(ctx context.Context, token string) (*service.Claims, error) {
+			return &service.Claims{UserID: userID, Exp: time.Now().Add(time.Hour)}, nil
+		},
+	}
+
+	t.Run("blacklist", func(t *testing.T) {
+		tokens := &blacklistingTokenProvider{MockTokenProvider: mock, enabled: true}
+		authService, _, clientID, secret := newOAuthTestService(t, &MockUserRepo{}, &MockRefreshRepo{}, tokens)
+		revoker := &MockTokenRevoker{RevokeIssuedBeforeFunc: func(ctx context.Context, id uuid.UUID, at time.Time, reason string) error {
+			t.Error("watermark must not move when blacklist is available")
+			return nil
+		}}
+		authService.SetTokenRevoker(revoker)
+
+		if err := authService.RevokeToken(context.Background(), clientID, secret, "a.b.c"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(tokens.blacklisted) != 1 || tokens.blacklisted[0] != "a.b.c" {
+			t.Errorf("Expected token in blacklist, got %v", tokens.blacklisted)
+		}
+	})
+
+	t.Run("watermark without redis", func(t *testing.T) {
+		tokens := &blacklistingTokenProvider{MockTokenProvider: mock}
+		authService, _, clientID, secret := newOAuthTestService(t, &MockUserRepo{}, &MockRefreshRepo{}, tokens)
+		var reason string
+		authService.SetTokenRevoker(&MockTokenRevoker{RevokeIssuedBeforeFunc: func(ctx context.Context, id uuid.UUID, at time.Time, r string) error {
+			if id != userID {
+				t.Errorf("Unexpected user %s", id)
+			}
+			reason = r
+			return nil
+		}})
+
+		if err := authService.RevokeToken(context.Background(), clientID, secret, "a.b.c"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if reason != service.RevokeReasonTokenRevoked || len(tokens.blacklisted) != 0 {
+			t.Errorf("Expected watermark revocation, got reason %q, blacklist %v", reason, tokens.blacklisted)
+		}
+	})
+}
+
+func TestAuthService_RevokeToken_Refresh(t *testing.T) {
+	rt := &models.RefreshToken{ID: uuid.New(), UserID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+	revoked := false
+	refreshRepo := &MockRefreshRepo{
+		GetByHashOnlyFunc: func(ctx context.Context, hash string) (*models.RefreshToken, error) { return rt, nil },
+		RevokeByHashOnlyFunc: func(ctx context.Context, hash string) (bool, error) {
+			if revoked {
+				return false, nil
+			}
+			revoked = true
+			return true, nil
+		},
+	}
+	authService, _, clientID, secret := newOAuthTestService(t, &MockUserRepo{}, refreshRepo, &MockTokenProvider{})
+
+	if err := authService.RevokeToken(context.Background(), clientID, secret, "opaque"); err != nil || !revoked {
+		t.Fatalf("Expected refresh token revoked, got %v", err)
+	}
+	// Повторный отзыв — не ошибка (RFC 7009)
+	if err := authService.RevokeToken(context.Background(), clientID, secret, "opaque"); err != nil {
+		t.Errorf("Expected no error on repeated revoke, got %v", err)
+	}
+	if err := authService.RevokeToken(context.Background(), clientID, "wrong", "opaque"); !errors.Is(err, service.ErrInvalidClient) {
+		t.Errorf("Expected ErrInvalidClient, got %v", err)
+	}
+}
+
+func TestAuthService_UpdateMe_PartialUpdate(t *testing.T) {
+	userID := uuid.New()
+	name := "Old Name"
+	user := &models.User{ID: userID, Email: "test@example.com", DisplayName: &name, Locale: "ru", TimeZone: "UTC"}
+	var saved *models.User
+	userRepo := &MockUserRepo{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.User, error) { return user, nil },
+		UpdateProfileFunc: func(ctx context.Context, u *models.User) error {
+			saved = u
+			return nil
+		},
+	}
+	authService := createTestAuthService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := service.WithUserID(context.Background(), userID)
+
+	locale, tz, phone, consent := "en-US", "Europe/Moscow", "+79991234567", true
+	got, err := authService.UpdateMe(ctx, service.ProfileUpdate{Locale: &locale, TimeZone: &tz, Phone: &phone, MarketingConsent: &consent})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if saved == nil || got.Locale != "en-US" || got.TimeZone != "Europe/Moscow" || got.Phone == nil || *got.Phone != phone {
+		t.Errorf("Unexpected profile: %+v", got)
+	}
+	if got.DisplayName == nil || *got.DisplayName != "Old Name" {
+		t.Errorf("Fields not passed must stay unchanged, got %v", got.DisplayName)
+	}
+	if !got.MarketingConsent || got.MarketingConsentAt == nil {
+		t.Errorf("Consent change must be timestamped, got %+v", got)
+	}
+
+	empty := " "
+	got, err = authService.UpdateMe(ctx, service.ProfileUpdate{DisplayName: &empty})
+	if err != nil || got.DisplayName != nil {
+		t.Errorf("Empty display name must clear it, got %v, %v", got.DisplayName, err)
+	}
+}
+
+func TestAuthService_UpdateMe_Rejections(t *testing.T) {
+	userID := uuid.New()
+	userRepo := &MockUserRepo{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+			return &models.User{ID: userID, Locale: "ru", TimeZone: "UTC"}, nil
+		},
+		UpdateProfileFunc: func(ctx context.Context, u *models.User) error {
+			t.Error("Rejected update must not be saved")
+			return nil
+		},
+	}
+	authService := createTestAuthService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := service.WithUserID(context.Background(), userID)
+
+	for _, tz := range []string{"Mars/Olympus", "Local", ""} {
+		if _, err := authService.UpdateMe(ctx, service.ProfileUpdate{TimeZone: &tz}); !errors.Is(err, service.ErrInvalidTimeZone) {
+			t.Errorf("%q: expected ErrInvalidTimeZone, got %v", tz, err)
+		}
+	}
+
+	consent := true
+	impersonated := service.WithActorID(ctx, uuid.New())
+	if _, err := authService.UpdateMe(impersonated, service.ProfileUpdate{MarketingConsent: &consent}); !errors.Is(err, service.ErrImpersonationForbidden) {
+		t.Errorf("Expected ErrImpersonationForbidden, got %v", err)
+	}
+
+	if _, err := authService.UpdateMe(context.Background(), service.ProfileUpdate{}); !errors.Is(err, service.ErrUnauthenticated) {
+		t.Errorf("Expected ErrUnauthenticated, got %v", err)
+	}
+}
+
+func TestAuthService_Emails_CarryLocaleAndName(t *testing.T) {
+	name := "Анна"
+	userRepo := &MockUserRepo{
+		GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+			return &models.User{ID: uuid.New(), Email: email, Locale: "en", DisplayName: &name}, nil
+		},
+	}
+	var sent producer.EmailMessage
+	emailProducer := &MockEmailProducer{SendEmailFunc: func(ctx context.Context, to string, msg producer.EmailMessage) error {
+		sent = msg
+		return nil
+	}}
+	authService := createTestAuthService(userRepo, nil, nil, nil, nil, nil, &MockPasswordResetRepo{}, nil, &MockCacheClient{}, emailProducer)
+
+	if err := authService.RequestPasswordReset(context.Background(), "anna@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sent.Locale != "en" || sent.Data["Name"] != name {
+		t.Errorf("Expected locale and name in email, got %+v", sent)
+	}
+
+	userRepo.GetByEmailFunc = func(ctx context.Context, email string) (*models.User, error) {
+		return &models.User{ID: uuid.New(), Email: email}, nil
+	}
+	if err := authService.RequestPasswordReset(context.Background(), "bob@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sent.Locale != service.DefaultLocale {
+		t.Errorf("Expected default locale, got %q", sent.Locale)
+	}
+	if _, ok := sent.Data["Name"]; ok {
+		t.Error("Name must be absent when display name is not set")
+	}
+}
+
+func newPhoneTestService(user *models.User) (*service.AuthService, *MockUserRepo, *MockPhoneVerificationRepo, *MockSMSProducer) {
+	userRepo := &MockUserRepo{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.User, error) { return user, nil },
+		UpdateProfileFunc: func(ctx context.Context, u *models.User) error {
+			*user = *u
+			return nil
+		},
+	}
+	userRepo.SetPhoneVerifiedFunc = func(ctx context.Context, id uuid.UUID, phone string) (bool, error) {
+		if user.Phone == nil || *user.Phone != phone {
+			return false, nil
+		}
+		user.IsPhoneVerified = true
+		return true, nil
+	}
+	repo := &MockPhoneVerificationRepo{}
+	sms := &MockSMSProducer{}
+	authService := createTestAuthService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	authService.SetPhoneVerification(repo, sms)
+	return authService, userRepo, repo, sms
+}
+
+func TestAuthService_PhoneVerification_Success(t *testing.T) {
+	phone := "+79991234567"
+	user := &models.User{ID: uuid.New(), Phone: &phone, Locale: "en"}
+	authService, _, repo, sms := newPhoneTestService(user)
+	ctx := service.WithUserID(context.Background(), user.ID)
+
+	expiresAt, err := authService.RequestPhoneVerification(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !expiresAt.After(time.Now()) {
+		t.Errorf("Expected expiry in the future, got %v", expiresAt)
+	}
+	if len(sms.Sent) != 1 || sms.Sent[0].To != phone || sms.Sent[0].Template != "verify_phone" || sms.Sent[0].Locale != "en" {
+		t.Fatalf("Unexpected sms: %+v", sms.Sent)
+	}
+	code, _ := sms.Sent[0].Data["Code"].(string)
+	if len(code) != 6 {
+		t.Fatalf("Expected 6-digit code, got %q", code)
+	}
+	if repo.Items[0].CodeHash == code {
+		t.Error("Code must be stored hashed")
+	}
+
+	if _, err := authService.RequestPhoneVerification(ctx); !errors.Is(err, service.ErrTooManyRequests) {
+		t.Errorf("Expected cooldown, got %v", err)
+	}
+
+	if err := authService.ConfirmPhoneVerification(ctx, code); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !user.IsPhoneVerified {
+		t.Error("Phone must be verified")
+	}
+	if err := authService.ConfirmPhoneVerification(ctx, code); !errors.Is(err, service.ErrPhoneAlreadyVerified) {
+		t.Errorf("Expected ErrPhoneAlreadyVerified, got %v", err)
+	}
+
+	other := "+79990000000"
+	if _, err := authService.UpdateMe(ctx, service.ProfileUpdate{Phone: &other}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if user.IsPhoneVerified {
+		t.Error("Changing phone must reset verification")
+	}
+}
+
+func TestAuthService_PhoneVerification_AttemptsLimit(t *testing.T) {
+	phone := "+79991234567"
+	user := &models.User{ID: uuid.New(), Phone: &phone}
+	authService, _, _, sms := newPhoneTestService(user)
+	ctx := service.WithUserID(context.Background(), user.ID)
+
+	if _, err := authService.RequestPhoneVerification(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	code := sms.Sent[0].Data["Code"].(string)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	for i := 0; i < service.PhoneCodeMaxAttempts; i++ {
+		if err := authService.ConfirmPhoneVerification(ctx, wrong); !errors.Is(err, service.ErrInvalidOrExpiredCode) {
+			t.Fatalf("Attempt %d: expected ErrInvalidOrExpiredCode, got %v", i+1, err)
+		}
+	}
+	if err := authService.ConfirmPhoneVerification(ctx, code); !errors.Is(err, service.ErrVerificationAttemptsExceeded) {
+		t.Errorf("Expected ErrVerificationAttemptsExceeded even for the right code, got %v", err)
+	}
+	if user.IsPhoneVerified {
+		t.Error("Phone must not be verified after exhausted attempts")
+	}
+}
+
+func TestAuthService_PhoneVerification_Rejections(t *testing.T) {
+	user := &models.User{ID: uuid.New()}
+	authService, _, repo, sms := newPhoneTestService(user)
+	ctx := service.WithUserID(context.Background(), user.ID)
+
+	if _, err := authService.RequestPhoneVerification(ctx); !errors.Is(err, service.ErrPhoneNotSet) {
+		t.Errorf("Expected ErrPhoneNotSet, got %v", err)
+	}
+
+	phone := "+79991234567"
+	user.Phone = &phone
+	if _, err := authService.RequestPhoneVerification(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	code := sms.Sent[0].Data["Code"].(string)
+
+	// код, отправленный на прежний номер, не подходит для нового
+	other := "+79990000000"
+	user.Phone = &other
+	if err := authService.ConfirmPhoneVerification(ctx, code); !errors.Is(err, service.ErrInvalidOrExpiredCode) {
+		t.Errorf("Expected ErrInvalidOrExpiredCode for changed phone, got %v", err)
+	}
+
+	user.Phone = &phone
+	repo.Items[0].ExpiresAt = time.Now().Add(-time.Second)
+	if err := authService.ConfirmPhoneVerification(ctx, code); !errors.Is(err, service.ErrInvalidOrExpiredCode) {
+		t.Errorf("Expected ErrInvalidOrExpiredCode for expired code, got %v", err)
+	}
+
+	disabled := createTestAuthService(&MockUserRepo{}, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	if _, err := disabled.RequestPhoneVerification(ctx); !errors.Is(err, service.ErrPhoneVerificationDisabled) {
+		t.Errorf("Expected ErrPhoneVerificationDisabled, got %v", err)
+	}
+}
+
+func newDPoPKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	jkt, err := dpop.Thumbprint(key.Public())
+	if err != nil {
+		t.Fatalf("thumbprint: %v", err)
+	}
+	return key, jkt
+}
+
+func newDPoPProof(t *testing.T, key *ecdsa.PrivateKey, url, nonce, accessToken string) *service.DPoPProof {
+	t.Helper()
+	proof, err := dpop.NewProof(key, "POST", url, nonce, accessToken, time.Now())
+	if err != nil {
+		t.Fatalf("new proof: %v", err)
+	}
+	return &service.DPoPProof{Proof: proof, Method: "POST", URL: url}
+}
+
+func TestAuthService_Login_DPoPBindsTokens(t *testing.T) {
+	userID := uuid.New()
+	userRepo := &MockUserRepo{
+		GetByEmailFunc: func(ctx context.Context, email string) (*models.User, error) {
+			return &models.User{ID: userID, Email: email, Password: "hashed_password123", Role: "ROLE_CUSTOMER"}, nil
+		},
+	}
+	var stored *models.RefreshToken
+	refreshRepo := &MockRefreshRepo{
+		CreateFunc: func(ctx context.Context, token *models.RefreshToken) error {
+			stored = token
+			return nil
+		},
+	}
+	authService := createTestAuthService(
+		userRepo, refreshRepo, nil, &MockPasswordHasher{}, &MockTokenProvider{}, &MockSessionRepo{}, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetDPoP(cache.NewMemoryStore(), true)
+
+	ctx := context.Background()
+	key, jkt := newDPoPKey(t)
+	const url = "https://api.example.com/api/v1/auth/login"
+
+	// без nonce сервера — просим повторить
+	meta := service.ClientMeta{DPoP: newDPoPProof(t, key, url, "", "")}
+	if _, _, _, err := authService.Login(ctx, "a@example.com", "password123", meta); !errors.Is(err, service.ErrUseDPoPNonce) {
+		t.Fatalf("expected ErrUseDPoPNonce, got %v", err)
+	}
+
+	nonce, err := authService.NewDPoPNonce(ctx)
+	if err != nil || nonce == "" {
+		t.Fatalf("NewDPoPNonce: %q, %v", nonce, err)
+	}
+	meta = service.ClientMeta{DPoP: newDPoPProof(t, key, url, nonce, "")}
+	_, _, pair, err := authService.Login(ctx, "a@example.com", "password123", meta)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if pair.TokenType != service.TokenTypeDPoP || pair.AccessToken != "bound_access_token:"+jkt {
+		t.Errorf("unexpected pair: type=%q access=%q", pair.TokenType, pair.AccessToken)
+	}
+	if stored == nil || stored.JKT == nil || *stored.JKT != jkt {
+		t.Errorf("refresh token is not bound to the key: %+v", stored)
+	}
+
+	// то же доказательство повторно не принимается
+	if _, _, _, err := authService.Login(ctx, "a@example.com", "password123", meta); !errors.Is(err, service.ErrInvalidDPoPProof) {
+		t.Errorf("expected ErrInvalidDPoPProof on replay, got %v", err)
+	}
+}
+
+func TestAuthService_Refresh_DPoPBoundToken(t *testing.T) {
+	userID := uuid.New()
+	key, jkt := newDPoPKey(t)
+	otherKey, _ := newDPoPKey(t)
+
+	userRepo := &MockUserRepo{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.User, error) {
+			return &models.User{ID: userID, Role: "ROLE_CUSTOMER"}, nil
+		},
+	}
+	var created *models.RefreshToken
+	refreshRepo := &MockRefreshRepo{
+		IsActiveByHashFunc: func(ctx context.Context, hash string, now time.Time) (bool, error) { return true, nil },
+		GetByHashOnlyFunc: func(ctx context.Context, hash string) (*models.RefreshToken, error) {
+			return &models.RefreshToken{ID: uuid.New(), UserID: userID, TokenHash: hash, JKT: &jkt, ExpiresAt: time.Now().Add(time.Hour)}, nil
+		},
+		RevokeByHashOnlyFunc: func(ctx context.Context, hash string) (bool, error) { return true, nil },
+		CreateFunc: func(ctx context.Context, token *models.RefreshToken) error {
+			created = token
+			return nil
+		},
+	}
+	authService := createTestAuthService(
+		userRepo, refreshRepo, nil, nil, &MockTokenProvider{}, &MockSessionRepo{}, nil, nil, nil, &MockEmailProducer{},
+	)
+	authService.SetDPoP(cache.NewMemoryStore(), false)
+
+	ctx := context.Background()
+	const url = "https://api.example.com/api/v1/auth/refresh"
+
+	if _, err := authService.Refresh(ctx, "refresh", service.ClientMeta{}); !errors.Is(err, service.ErrInvalidDPoPProof) {
+		t.Errorf("expected ErrInvalidDPoPProof without proof, got %v", err)
+	}
+	meta := service.ClientMeta{DPoP: newDPoPProof(t, otherKey, url, "", "")}
+	if _, err := authService.Refresh(ctx, "refresh", meta); !errors.Is(err, service.ErrInvalidDPoPProof) {
+		t.Errorf("expected ErrInvalidDPoPProof for another key, got %v", err)
+	}
+	if created != nil {
+		t.Fatal("refresh token must not be rotated on rejected proof")
+	}
+
+	meta = service.ClientMeta{DPoP: newDPoPProof(t, key, url, "", "")}
+	pair, err := authService.Refresh(ctx, "refresh", meta)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if pair.TokenType != service.TokenTypeDPoP {
+		t.Errorf("expected DPoP token type, got %q", pair.TokenType)
+	}
+	if created == nil || created.JKT == nil || *created.JKT != jkt {
+		t.Errorf("rotated refresh token lost the binding: %+v", created)
+	}
+}
+
+func TestAuthService_IntrospectDPoP(t *testing.T) {
+	key, jkt := newDPoPKey(t)
+	otherKey, _ := newDPoPKey(t)
+	userID := uuid.New()
+
+	tokens := &MockTokenProvider{
+		ParseAndValidateAccessFunc: func(ctx context.Context, token string) (*service.Claims, error) {
+			return &service.Claims{UserID: userID, Role: "ROLE_CUSTOMER", JKT: jkt, Exp: time.Now().Add(time.Hour)}, nil
+		},
+	}
+	authService := createTestAuthService(nil, nil, nil, nil, tokens, nil, nil, nil, nil, &MockEmailProducer{})
+	authService.SetDPoP(cache.NewMemoryStore(), false)
+
+	ctx := context.Background()
+	const url = "https://api.example.com/api/v1/orders"
+	access := "bound_access_token"
+
+	active, claims, err := authService.IntrospectDPoP(ctx, access, newDPoPProof(t, key, url, "", access))
+	if err != nil || !active || claims.UserID != userID {
+		t.Fatalf("expected active token, got active=%v claims=%+v err=%v", active, claims, err)
+	}
+
+	// ключ другого клиента
+	if active, _, err := authService.IntrospectDPoP(ctx, access, newDPoPProof(t, otherKey, url, "", access)); err != nil || active {
+		t.Errorf("expected inactive for foreign key, got active=%v err=%v", active, err)
+	}
+	// доказательство без ath для этого токена
+	if active, _, err := authService.IntrospectDPoP(ctx, access, newDPoPProof(t, key, url, "", "")); err != nil || active {
+		t.Errorf("expected inactive without ath, got active=%v err=%v", active, err)
+	}
+}
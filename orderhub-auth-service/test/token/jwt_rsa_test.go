@@ -0,0 +1,154 @@
+package token_test
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/service"
+	"auth-service/internal/token"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// memJWKStore — хранилище ключей подписи в памяти
+type memJWKStore struct {
+	keys   map[string]*models.JwkKey
+	active string
+}
+
+func newMemJWKStore() *memJWKStore {
+	return &memJWKStore{keys: map[string]*models.JwkKey{}}
+}
+
+func (s *memJWKStore) GetActive(ctx context.Context) (*models.JwkKey, error) {
+	return s.keys[s.active], nil
+}
+
+func (s *memJWKStore) GetByKID(ctx context.Context, kid string) (*models.JwkKey, error) {
+	return s.keys[kid], nil
+}
+
+func (s *memJWKStore) Create(ctx context.Context, rec *models.JwkKey) error {
+	s.keys[rec.KID] = rec
+	return nil
+}
+
+func (s *memJWKStore) SetActive(ctx context.Context, kid string) error {
+	s.active = kid
+	return nil
+}
+
+func (s *memJWKStore) ListPublic(ctx context.Context) ([]service.PublicJWK, error) {
+	return nil, nil
+}
+
+// countingPerms считает обращения к правам роли
+type countingPerms struct {
+	perms map[models.Role][]string
+	calls int
+}
+
+func (p *countingPerms) ListByRole(ctx context.Context, role models.Role) ([]string, error) {
+	p.calls++
+	return p.perms[role], nil
+}
+
+func TestRSAProvider_EmptyPermsClaim_NoFallback(t *testing.T) {
+	ctx := context.Background()
+	perms := &countingPerms{perms: map[models.Role][]string{}} // у роли нет прав
+	p := token.NewRSAProvider(newMemJWKStore(), "orderhub", "orderhub-api")
+	p.SetPermissions(perms)
+
+	access, _, err := p.SignAccess(ctx, uuid.New(), string(models.RoleCustomer), time.Minute)
+	if err != nil {
+		t.Fatalf("SignAccess: %v", err)
+	}
+	calls := perms.calls
+
+	claims, err := p.ParseAndValidateAccess(ctx, access)
+	if err != nil {
+		t.Fatalf("ParseAndValidateAccess: %v", err)
+	}
+	if len(claims.Perms) != 0 {
+		t.Errorf("Expected no perms, got %v", claims.Perms)
+	}
+	if perms.calls != calls {
+		t.Error("Token with an empty perms claim must not fall back to the database")
+	}
+}
+
+func TestRSAProvider_LegacyToken_FallsBackToRolePerms(t *testing.T) {
+	ctx := context.Background()
+	store := newMemJWKStore()
+
+	// токен выпущен без RBAC — claim perms отсутствует
+	legacy := token.NewRSAProvider(store, "orderhub", "orderhub-api")
+	access, _, err := legacy.SignAccess(ctx, uuid.New(), string(models.RoleVendor), time.Minute)
+	if err != nil {
+		t.Fatalf("SignAccess: %v", err)
+	}
+
+	perms := &countingPerms{perms: map[models.Role][]string{models.RoleVendor: {"product:write"}}}
+	p := token.NewRSAProvider(store, "orderhub", "orderhub-api")
+	p.SetPermissions(perms)
+
+	claims, err := p.ParseAndValidateAccess(ctx, access)
+	if err != nil {
+		t.Fatalf("ParseAndValidateAccess: %v", err)
+	}
+	if perms.calls != 1 || len(claims.Perms) != 1 || claims.Perms[0] != "product:write" {
+		t.Errorf("Expected role perms from the database, got %v (calls=%d)", claims.Perms, perms.calls)
+	}
+}
+
+func TestRSAProvider_Impersonation_ActClaim(t *testing.T) {
+	ctx := context.Background()
+	p := token.NewRSAProvider(newMemJWKStore(), "orderhub", "orderhub-api")
+
+	userID, adminID := uuid.New(), uuid.New()
+	access, _, err := p.SignImpersonation(ctx, userID, string(models.RoleCustomer), adminID, time.Minute)
+	if err != nil {
+		t.Fatalf("SignImpersonation: %v", err)
+	}
+	claims, err := p.ParseAndValidateAccess(ctx, access)
+	if err != nil {
+		t.Fatalf("ParseAndValidateAccess: %v", err)
+	}
+	if claims.UserID != userID || claims.Actor != adminID {
+		t.Errorf("Expected sub %s act %s, got sub %s act %s", userID, adminID, claims.UserID, claims.Actor)
+	}
+
+	plain, _, err := p.SignAccess(ctx, userID, string(models.RoleCustomer), time.Minute)
+	if err != nil {
+		t.Fatalf("SignAccess: %v", err)
+	}
+	if claims, err = p.ParseAndValidateAccess(ctx, plain); err != nil || claims.Actor != uuid.Nil {
+		t.Errorf("Regular token must have no actor, got %v (err %v)", claims, err)
+	}
+}
+
+func TestRSAProvider_BoundToken_CnfClaim(t *testing.T) {
+	ctx := context.Background()
+	p := token.NewRSAProvider(newMemJWKStore(), "orderhub", "orderhub-api")
+
+	access, _, err := p.SignAccessBound(ctx, uuid.New(), string(models.RoleCustomer), "thumbprint", time.Minute)
+	if err != nil {
+		t.Fatalf("SignAccessBound: %v", err)
+	}
+	claims, err := p.ParseAndValidateAccess(ctx, access)
+	if err != nil {
+		t.Fatalf("ParseAndValidateAccess: %v", err)
+	}
+	if claims.JKT != "thumbprint" {
+		t.Errorf("Expected cnf.jkt thumbprint, got %q", claims.JKT)
+	}
+
+	plain, _, err := p.SignAccess(ctx, uuid.New(), string(models.RoleCustomer), time.Minute)
+	if err != nil {
+		t.Fatalf("SignAccess: %v", err)
+	}
+	if claims, err = p.ParseAndValidateAccess(ctx, plain); err != nil || claims.JKT != "" {
+		t.Errorf("Regular token must not be bound, got %v (err %v)", claims, err)
+	}
+}
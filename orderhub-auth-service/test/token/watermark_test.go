@@ -0,0 +1,197 @@
+package token_test
+
+import (
+	"auth-service/internal/models"
+	"auth-service/internal/token"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// memWatermarkStore — хранилище водяных знаков в памяти
+type memWatermarkStore struct {
+	marks  map[uuid.UUID]time.Time
+	getErr error
+}
+
+func newMemWatermarkStore() *memWatermarkStore {
+	return &memWatermarkStore{marks: map[uuid.UUID]time.Time{}}
+}
+
+func (s *memWatermarkStore) Bump(ctx context.Context, userID uuid.UUID, at time.Time, reason string) error {
+	// как в БД: timestamptz хранит микросекунды, знак только растёт
+	at = at.Round(time.Microsecond)
+	if cur, ok := s.marks[userID]; !ok || at.After(cur) {
+		s.marks[userID] = at
+	}
+	return nil
+}
+
+func (s *memWatermarkStore) Get(ctx context.Context, userID uuid.UUID) (*models.TokenWatermark, error) {
+	if s.getErr != nil {
+		return nil, s.getErr
+	}
+	at, ok := s.marks[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &models.TokenWatermark{UserID: userID, RevokedBefore: at}, nil
+}
+
+// memCache — кэш в памяти с управляемыми ошибками
+type memCache struct {
+	data   map[string]string
+	setErr error
+	delErr error
+}
+
+func newMemCache() *memCache {
+	return &memCache{data: map[string]string{}}
+}
+
+func (c *memCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if c.setErr != nil {
+		return c.setErr
+	}
+	c.data[key] = value.(string)
+	return nil
+}
+
+func (c *memCache) Get(ctx context.Context, key string) (string, error) {
+	v, ok := c.data[key]
+	if !ok {
+		return "", errors.New("cache miss")
+	}
+	return v, nil
+}
+
+func (c *memCache) Del(ctx context.Context, keys ...string) error {
+	if c.delErr != nil {
+		return c.delErr
+	}
+	for _, k := range keys {
+		delete(c.data, k)
+	}
+	return nil
+}
+
+func TestWatermarks_IsRevoked(t *testing.T) {
+	ctx := context.Background()
+	store := newMemWatermarkStore()
+	w := token.NewWatermarks(store, time.Minute)
+	userID := uuid.New()
+
+	revokedAt := time.Date(2026, 1, 1, 12, 0, 0, 300_000_000, time.UTC)
+
+	if revoked, err := w.IsRevoked(ctx, userID, revokedAt.Add(-time.Hour)); err != nil || revoked {
+		t.Fatalf("Expected no revocation without watermark, got %v %v", revoked, err)
+	}
+
+	if err := w.RevokeIssuedBefore(ctx, userID, revokedAt, "logout_all"); err != nil {
+		t.Fatalf("RevokeIssuedBefore: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		issuedAt time.Time
+		want     bool
+	}{
+		{"earlier second", revokedAt.Add(-time.Second), true},
+		// iat в JWT — целые секунды: токены той же секунды не отзываются
+		{"same second, before revocation", revokedAt.Add(-100 * time.Millisecond), false},
+		{"same second, after revocation", revokedAt.Add(100 * time.Millisecond), false},
+		{"later second", revokedAt.Add(time.Second), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			revoked, err := w.IsRevoked(ctx, userID, tc.issuedAt)
+			if err != nil {
+				t.Fatalf("IsRevoked: %v", err)
+			}
+			if revoked != tc.want {
+				t.Errorf("Expected revoked=%v, got %v", tc.want, revoked)
+			}
+		})
+	}
+}
+
+func TestWatermarks_IsRevoked_StoreError(t *testing.T) {
+	store := newMemWatermarkStore()
+	store.getErr = errors.New("db is down")
+	w := token.NewWatermarks(store, time.Minute)
+
+	if _, err := w.IsRevoked(context.Background(), uuid.New(), time.Now()); err == nil {
+		t.Fatal("Expected error when the store is unavailable")
+	}
+}
+
+// iat в JWT сериализуется в целых секундах: знак, выставленный в середине секунды,
+// не должен отзывать токен, выданный в эту же секунду после него.
+func TestWatermarks_IsRevoked_JWTSecondPrecision(t *testing.T) {
+	ctx := context.Background()
+	w := token.NewWatermarks(newMemWatermarkStore(), time.Minute)
+	userID := uuid.New()
+
+	revokedAt := time.Date(2026, 1, 1, 12, 0, 0, 400_123_000, time.UTC)
+	if err := w.RevokeIssuedBefore(ctx, userID, revokedAt, "password_changed"); err != nil {
+		t.Fatalf("RevokeIssuedBefore: %v", err)
+	}
+
+	raw, err := json.Marshal(jwt.NewNumericDate(revokedAt.Add(time.Millisecond)))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var iat jwt.NumericDate
+	if err := json.Unmarshal(raw, &iat); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if revoked, err := w.IsRevoked(ctx, userID, iat.Time); err != nil || revoked {
+		t.Errorf("Expected token issued after revocation to stay valid, got %v %v (raw %s)", revoked, err, raw)
+	}
+}
+
+func TestWatermarks_RevokeIssuedBefore_CacheSetFails(t *testing.T) {
+	ctx := context.Background()
+	store := newMemWatermarkStore()
+	cache := newMemCache()
+	w := token.NewWatermarks(store, time.Minute)
+	w.SetCache(cache)
+	userID := uuid.New()
+
+	// отрицательный ответ («знака нет») уже закэширован
+	if revoked, err := w.IsRevoked(ctx, userID, time.Now()); err != nil || revoked {
+		t.Fatalf("Expected no revocation, got %v %v", revoked, err)
+	}
+
+	cache.setErr = errors.New("redis write failed")
+	issuedAt := time.Now().Add(-time.Minute)
+	if err := w.RevokeIssuedBefore(ctx, userID, time.Now(), "logout_all"); err != nil {
+		t.Fatalf("RevokeIssuedBefore: %v", err)
+	}
+	cache.setErr = nil
+
+	revoked, err := w.IsRevoked(ctx, userID, issuedAt)
+	if err != nil {
+		t.Fatalf("IsRevoked: %v", err)
+	}
+	if !revoked {
+		t.Error("Stale cached watermark must not keep revoked tokens alive")
+	}
+}
+
+func TestWatermarks_RevokeIssuedBefore_CacheUnavailable(t *testing.T) {
+	cache := newMemCache()
+	cache.setErr = errors.New("redis write failed")
+	cache.delErr = errors.New("redis del failed")
+	w := token.NewWatermarks(newMemWatermarkStore(), time.Minute)
+	w.SetCache(cache)
+
+	if err := w.RevokeIssuedBefore(context.Background(), uuid.New(), time.Now(), "logout_all"); err == nil {
+		t.Fatal("Expected error when the cache cannot be invalidated")
+	}
+}
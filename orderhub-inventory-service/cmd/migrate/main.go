@@ -2,15 +2,18 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"inventory-service/config"
 	"inventory-service/internal/migrate"
 	"os"
+	"strings"
 
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
-	"go.uber.org/zap"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/migrator"
 
 	"github.com/joho/godotenv"
+	"go.uber.org/zap"
 )
 
 func main() {
@@ -24,16 +27,34 @@ func main() {
 
 	log := logger.L()
 
+	args := os.Args[1:]
+
+	// create работает с исходниками и не требует подключения к БД
+	if len(args) > 0 && args[0] == "create" {
+		if len(args) < 2 {
+			fmt.Fprintln(os.Stderr, migrator.Usage)
+			os.Exit(2)
+		}
+		up, down, err := migrator.Create(migrate.Dir, strings.Join(args[1:], "_"))
+		if err != nil {
+			log.Fatal("Не удалось создать миграцию", zap.Error(err))
+		}
+		fmt.Println(up)
+		fmt.Println(down)
+		return
+	}
+
 	cfg := config.Load(log)
 
 	db := database.ConnectDBForMigration(&cfg.DB.Config, log)
 	defer database.CloseDB(db, log)
 
-	ctx := context.Background()
-
-	opts := migrate.DefaultMigrateOptions()
+	m, err := migrate.New(db, log)
+	if err != nil {
+		log.Fatal("Не удалось загрузить миграции", zap.Error(err))
+	}
 
-	if err := migrate.MigrateInventoryDB(ctx, db, log, opts); err != nil {
+	if err := migrator.Run(context.Background(), m, args, os.Stdout); err != nil {
 		log.Fatal("Ошибка при выполнении миграции", zap.Error(err))
 	}
 
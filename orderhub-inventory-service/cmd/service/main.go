@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"inventory-service/config"
+	"inventory-service/internal/consumer"
+	invmetrics "inventory-service/internal/metrics"
 	"inventory-service/internal/repository"
 	"inventory-service/internal/service"
 	gtransport "inventory-service/internal/transport/grpc"
@@ -10,11 +13,18 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation/interceptor"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/metrics"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness/kafkaready"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
 	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -34,6 +44,12 @@ func main() {
 
 	log := logger.L()
 
+	shutdownTracing, err := telemetry.Setup(context.Background(), "inventory-service", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg := config.Load(log)
 	log.Info("cfg: ", zap.Any("config", cfg))
 	db := database.ConnectDB(&cfg.DB.Config, log)
@@ -41,7 +57,8 @@ func main() {
 
 	repos := repository.New(db)
 
-	authConn, err := grpc.Dial(cfg.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authConn, err := grpc.Dial(cfg.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), telemetry.DialOption(),
+		grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("auth-service", cfg.AuthRPC, log)))
 	if err != nil {
 		log.Fatal("failed to connect to auth service", zap.Error(err))
 	}
@@ -49,6 +66,22 @@ func main() {
 	authClient := authv1.NewAuthServiceClient(authConn)
 
 	svc := service.NewInventoryService(repos)
+	prometheus.MustRegister(invmetrics.NewStockCollector(repos.Inventories, log))
+
+	// account_deleted из auth-service: товары удалённого продавца снимаются с продажи.
+	// Без KAFKA_BROKERS consumer не запускается.
+	consumerCtx, consumerCancel := context.WithCancel(context.Background())
+	defer consumerCancel()
+	var userEvents *consumer.UserEventConsumer
+	if len(cfg.KafkaBrokers) > 0 {
+		userEvents = consumer.NewUserEventConsumer(cfg.KafkaBrokers, cfg.KafkaGroupID, cfg.KafkaUserEventsTopic, svc, log)
+		invmetrics.RegisterConsumerLag(cfg.KafkaUserEventsTopic, userEvents.Lag)
+		go func() {
+			if err := userEvents.Run(consumerCtx); err != nil {
+				log.Error("user events consumer stopped", zap.Error(err))
+			}
+		}()
+	}
 
 	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
@@ -58,20 +91,35 @@ func main() {
 	authInterceptor := gtransport.NewAuthUnaryServerInterceptor(authClient)
 
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(authInterceptor),
+		telemetry.ServerOption(),
+		grpc.ChainUnaryInterceptor(interceptor.UnaryServer(log), metrics.UnaryServerInterceptor(), telemetry.InterceptorSpan("authenticate", authInterceptor), gtransport.NewImpersonationAuditInterceptor(log)),
 	)
 
-	// Health server
+	// Health server: "" — готовность по зависимостям, "liveness" — процесс жив
 	healthSrv := health.NewServer()
-	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthSrv)
 
+	ready := readiness.New(log)
+	ready.Add("postgres", database.Ping(db))
+	ready.Add("auth", readiness.GRPC(authConn, ""))
+	if len(cfg.KafkaBrokers) > 0 {
+		ready.Add("kafka", kafkaready.Check(cfg.KafkaBrokers))
+	}
+	readyCtx, readyCancel := context.WithCancel(context.Background())
+	defer readyCancel()
+	go ready.Watch(readyCtx, healthSrv, readiness.DefaultInterval)
+
 	// Reflection for local debugging
 	reflection.Register(grpcServer)
 
 	invServer := gtransport.NewHandler(svc)
 	inventoryv1.RegisterInventoryServiceServer(grpcServer, invServer)
 
+	metricsSrv := metrics.Serve(cfg.MetricsAddr, log,
+		metrics.Route{Pattern: "/readyz", Handler: ready.Handler()},
+		metrics.Route{Pattern: "/livez", Handler: readiness.LiveHandler()},
+	)
+
 	go func() {
 		log.Info("Starting Inventory gRPC server", zap.String("addr", cfg.Port))
 		if err := grpcServer.Serve(lis); err != nil {
@@ -84,5 +132,13 @@ func main() {
 
 	<-quit
 	log.Info("Shutting down Inventory gRPC server...")
+	healthSrv.Shutdown()
+	readyCancel()
+	consumerCancel()
+	if userEvents != nil {
+		_ = userEvents.Close()
+	}
+	_ = metrics.Shutdown(context.Background(), metricsSrv)
+	grpcServer.GracefulStop()
 	log.Info("Inventory gRPC server stopped gracefully")
 }
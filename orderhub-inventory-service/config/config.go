@@ -2,8 +2,10 @@ package config
 
 import (
 	"os"
+	"strings"
 
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
 
 	"go.uber.org/zap"
 )
@@ -12,10 +14,14 @@ type Config struct {
 	Port     string
 	DB       DB
 	AuthAddr string
+	AuthRPC  resilience.Config // дедлайны, ретраи и breaker вызовов auth-service (AUTH_RPC_*)
+
+	MetricsAddr string // адрес HTTP-листенера /metrics; "off" — отключить
 	// 	Redis Redis
 
-	// 	KafkaBrokers []string
-	// 	KafkaTopic   string
+	KafkaBrokers         []string // пусто — события пользователей не читаются
+	KafkaUserEventsTopic string   // события пользователя из auth-service (account_deleted)
+	KafkaGroupID         string
 }
 
 type DB struct {
@@ -31,9 +37,16 @@ type DB struct {
 // }
 
 func Load(log *zap.Logger) *Config {
+	authRPC, err := resilience.FromEnv("AUTH", resilience.AuthDefaults())
+	if err != nil {
+		log.Fatal("invalid auth rpc settings", zap.Error(err))
+	}
 	return &Config{
 		Port:     getEnv("APP_PORT", log),
 		AuthAddr: getEnv("AUTH_ADDR", log),
+		AuthRPC:  authRPC,
+
+		MetricsAddr: envDefault("METRICS_ADDR", ":9103"),
 		DB: DB{
 			Config: database.Config{
 				Host:     getEnv("DB_HOST", log),
@@ -51,8 +64,9 @@ func Load(log *zap.Logger) *Config {
 		// 	DB:         atoiDefault(getEnv("REDIS_DB", log), 0),
 		// 	TTLSeconds: atoiDefault(getEnv("CACHE_TTL_SECONDS", log), 60),
 		// },
-		// KafkaBrokers: splitAndTrim(os.Getenv("KAFKA_BROKERS")),
-		// KafkaTopic:   getEnv("KAFKA_TOPIC_EMAIL", log),
+		KafkaBrokers:         splitAndTrim(os.Getenv("KAFKA_BROKERS")),
+		KafkaUserEventsTopic: envDefault("KAFKA_TOPIC_USER_EVENTS", "users.events"),
+		KafkaGroupID:         envDefault("KAFKA_GROUP_ID", "inventory-service"),
 	}
 }
 
@@ -64,6 +78,13 @@ func getEnv(key string, log *zap.Logger) string {
 	panic("missing required environment variable: " + key)
 }
 
+func envDefault(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return def
+}
+
 // func atoiDefault(s string, def int) int {
 // 	n, err := strconv.Atoi(s)
 // 	if err != nil {
@@ -72,16 +93,16 @@ func getEnv(key string, log *zap.Logger) string {
 // 	return n
 // }
 
-// func splitAndTrim(s string) []string {
-// 	if s == "" {
-// 		return nil
-// 	}
-// 	parts := []string{}
-// 	for _, p := range strings.Split(s, ",") {
-// 		pt := strings.TrimSpace(p)
-// 		if pt != "" {
-// 			parts = append(parts, pt)
-// 		}
-// 	}
-// 	return parts
-// }
+func splitAndTrim(s string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := []string{}
+	for _, p := range strings.Split(s, ",") {
+		pt := strings.TrimSpace(p)
+		if pt != "" {
+			parts = append(parts, pt)
+		}
+	}
+	return parts
+}
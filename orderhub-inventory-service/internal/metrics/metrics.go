@@ -0,0 +1,81 @@
+// Package metrics — доменные метрики inventory-service; /metrics отдаёт листенер из pkg/metrics
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"inventory-service/internal/repository"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	// Reservations — вызовы Reserve по итогу: reserved (все позиции), partial, failed
+	Reservations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_reservations_total",
+		Help: "Reserve calls by result.",
+	}, []string{"result"})
+
+	// ReserveItems — позиции резерва по исходу: reserved, not_found, inactive, out_of_stock
+	ReserveItems = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_reserve_items_total",
+		Help: "Reserved order items by outcome.",
+	}, []string{"outcome"})
+)
+
+// RegisterConsumerLag публикует отставание consumer'а топика (сообщений до конца партиции);
+// lag вызывается при каждом scrape
+func RegisterConsumerLag(topic string, lag func() int64) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "kafka_consumer_lag",
+		Help:        "Messages between the consumer position and the end of the partition.",
+		ConstLabels: prometheus.Labels{"topic": topic},
+	}, func() float64 { return float64(lag()) }))
+}
+
+const stockScrapeTimeout = 5 * time.Second
+
+// StockSource — источник сводных остатков (repository.InventoryRepo)
+type StockSource interface {
+	Totals(ctx context.Context) (repository.StockTotals, error)
+}
+
+// StockCollector читает остатки склада из БД при каждом scrape
+type StockCollector struct {
+	src        StockSource
+	log        *zap.Logger
+	units      *prometheus.Desc
+	outOfStock *prometheus.Desc
+}
+
+func NewStockCollector(src StockSource, log *zap.Logger) *StockCollector {
+	return &StockCollector{
+		src: src,
+		log: log,
+		units: prometheus.NewDesc("inventory_stock_units",
+			"Stock units of active products by state (available, reserved).", []string{"state"}, nil),
+		outOfStock: prometheus.NewDesc("inventory_products_out_of_stock",
+			"Active products with no available stock.", nil, nil),
+	}
+}
+
+func (c *StockCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.units
+	ch <- c.outOfStock
+}
+
+func (c *StockCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), stockScrapeTimeout)
+	defer cancel()
+	t, err := c.src.Totals(ctx)
+	if err != nil {
+		c.log.Warn("failed to collect stock metrics", zap.Error(err))
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.units, prometheus.GaugeValue, float64(t.Available), "available")
+	ch <- prometheus.MustNewConstMetric(c.units, prometheus.GaugeValue, float64(t.Reserved), "reserved")
+	ch <- prometheus.MustNewConstMetric(c.outOfStock, prometheus.GaugeValue, float64(t.OutOfStock))
+}
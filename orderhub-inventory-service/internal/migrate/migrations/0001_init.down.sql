@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS reservations;
+DROP TABLE IF EXISTS inventories;
+DROP TABLE IF EXISTS products;
+DROP FUNCTION IF EXISTS set_updated_at();
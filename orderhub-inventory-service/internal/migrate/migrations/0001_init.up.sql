@@ -0,0 +1,100 @@
+-- Базовая схема inventory-service. Написана идемпотентно (IF NOT EXISTS), чтобы
+-- применяться и к базам, созданным прежним AutoMigrate.
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS products (
+  id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  vendor_id     uuid NOT NULL,
+  sku           text NOT NULL,
+  name          text NOT NULL,
+  description   text,
+  price_cents   bigint NOT NULL DEFAULT 0,
+  currency_code char(3) NOT NULL DEFAULT 'RUB',
+  is_active     boolean NOT NULL DEFAULT true,
+  created_at    timestamptz NOT NULL DEFAULT now(),
+  updated_at    timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_products_vendor_id ON products (vendor_id);
+CREATE INDEX IF NOT EXISTS idx_products_created_at ON products (created_at);
+
+CREATE TABLE IF NOT EXISTS inventories (
+  product_id uuid PRIMARY KEY,
+  available  integer NOT NULL DEFAULT 0,
+  reserved   integer NOT NULL DEFAULT 0,
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS reservations (
+  id         uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  order_id   uuid NOT NULL,
+  product_id uuid NOT NULL,
+  quantity   integer NOT NULL,
+  status     text NOT NULL DEFAULT 'PENDING',
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_reservations_order_id ON reservations (order_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_product_id ON reservations (product_id);
+CREATE INDEX IF NOT EXISTS idx_reservations_status ON reservations (status);
+CREATE INDEX IF NOT EXISTS idx_reservations_created_at ON reservations (created_at);
+
+-- updated_at
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN NEW.updated_at = now(); RETURN NEW; END; $$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_products_updated ON products;
+CREATE TRIGGER trg_products_updated BEFORE UPDATE ON products
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+DROP TRIGGER IF EXISTS trg_inventories_updated ON inventories;
+CREATE TRIGGER trg_inventories_updated BEFORE UPDATE ON inventories
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- CHECK-и
+ALTER TABLE products
+  DROP CONSTRAINT IF EXISTS chk_products_currency_code_rub,
+  ADD CONSTRAINT chk_products_currency_code_rub
+  CHECK (currency_code = 'RUB' AND char_length(currency_code) = 3);
+
+ALTER TABLE products
+  DROP CONSTRAINT IF EXISTS chk_products_price_non_negative,
+  ADD CONSTRAINT chk_products_price_non_negative
+  CHECK (price_cents >= 0);
+
+ALTER TABLE inventories
+  DROP CONSTRAINT IF EXISTS chk_inventories_non_negative,
+  ADD CONSTRAINT chk_inventories_non_negative
+  CHECK (available >= 0 AND reserved >= 0);
+
+ALTER TABLE reservations
+  DROP CONSTRAINT IF EXISTS chk_reservations_quantity_gt_zero,
+  ADD CONSTRAINT chk_reservations_quantity_gt_zero
+  CHECK (quantity > 0);
+
+ALTER TABLE reservations
+  DROP CONSTRAINT IF EXISTS chk_reservations_status_allowed,
+  ADD CONSTRAINT chk_reservations_status_allowed
+  CHECK (status IN ('PENDING','RESERVED','RELEASED','FAILED'));
+
+-- индексы и уникальности
+CREATE UNIQUE INDEX IF NOT EXISTS ux_products_vendor_sku ON products (vendor_id, lower(sku));
+CREATE UNIQUE INDEX IF NOT EXISTS ux_reservations_order_product ON reservations (order_id, product_id);
+CREATE INDEX IF NOT EXISTS ix_products_vendor_created ON products (vendor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS ix_products_active_created ON products (is_active, created_at DESC);
+
+-- поиск по name/sku
+CREATE INDEX IF NOT EXISTS gin_products_name_trgm ON products USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS gin_products_sku_trgm ON products USING gin (sku gin_trgm_ops);
+
+-- внешние ключи
+ALTER TABLE inventories
+  DROP CONSTRAINT IF EXISTS fk_inventories_product,
+  ADD CONSTRAINT fk_inventories_product
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE;
+
+ALTER TABLE reservations
+  DROP CONSTRAINT IF EXISTS fk_reservations_product,
+  ADD CONSTRAINT fk_reservations_product
+    FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE RESTRICT;
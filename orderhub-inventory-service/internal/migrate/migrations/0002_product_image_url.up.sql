@@ -0,0 +1,2 @@
+-- image_url: главное изображение товара для карточки и страницы заказа
+ALTER TABLE products ADD COLUMN IF NOT EXISTS image_url text NOT NULL DEFAULT '';
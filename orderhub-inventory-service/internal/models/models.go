@@ -15,6 +15,7 @@ type Product struct {
 	PriceCents   int64     `gorm:"not null;default:0"`
 	CurrencyCode string    `gorm:"type:char(3);not null;default:'RUB'"` // всегда RUB
 	IsActive     bool      `gorm:"not null;default:true"`
+	ImageURL     string    `gorm:"type:text;not null;default:''"` // пусто — изображения нет
 
 	CreatedAt time.Time `gorm:"not null;default:now();index"`
 	UpdatedAt time.Time `gorm:"not null;default:now()"`
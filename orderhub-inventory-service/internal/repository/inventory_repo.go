@@ -11,6 +11,8 @@ import (
 
 type InventoryRepo interface {
 	Get(ctx context.Context, productID uuid.UUID) (*models.Inventory, error)
+	// BatchGet — остатки нескольких товаров; товары без строки в inventories пропускаются
+	BatchGet(ctx context.Context, productIDs []uuid.UUID) ([]models.Inventory, error)
 	SetAvailable(ctx context.Context, productID uuid.UUID, available int32) error
 	AdjustAvailable(ctx context.Context, productID uuid.UUID, delta int32) (bool, error)
 
@@ -21,6 +23,15 @@ type InventoryRepo interface {
 	Release(ctx context.Context, productID uuid.UUID, qty int32) (bool, error)
 	// Confirm: reserved -= qty (списываем резерв окончательно)
 	Confirm(ctx context.Context, productID uuid.UUID, qty int32) (bool, error)
+
+	// Totals — суммарные остатки по активным товарам (для метрик склада)
+	Totals(ctx context.Context) (StockTotals, error)
+}
+
+type StockTotals struct {
+	Available  int64
+	Reserved   int64
+	OutOfStock int64 // активные товары с available = 0
 }
 
 type inventoryRepo struct{ db *gorm.DB }
@@ -36,6 +47,16 @@ func (r *inventoryRepo) Get(ctx context.Context, productID uuid.UUID) (*models.I
 	return &inv, err
 }
 
+func (r *inventoryRepo) BatchGet(ctx context.Context, productIDs []uuid.UUID) ([]models.Inventory, error) {
+	if len(productIDs) == 0 {
+		return []models.Inventory{}, nil
+	}
+
+	var list []models.Inventory
+	err := r.db.WithContext(ctx).Where("product_id IN ?", productIDs).Find(&list).Error
+	return list, err
+}
+
 func (r *inventoryRepo) SetAvailable(ctx context.Context, productID uuid.UUID, available int32) error {
 	return r.db.WithContext(ctx).Model(&models.Inventory{}).Where("product_id = ?", productID).Update("available", available).Error
 }
@@ -100,3 +121,16 @@ WHERE product_id = @pid
 	})
 	return tx.RowsAffected > 0, tx.Error
 }
+
+func (r *inventoryRepo) Totals(ctx context.Context) (StockTotals, error) {
+	var t StockTotals
+	err := r.db.WithContext(ctx).Raw(`
+SELECT COALESCE(SUM(i.available), 0) AS available,
+       COALESCE(SUM(i.reserved), 0)  AS reserved,
+       COUNT(*) FILTER (WHERE i.available <= 0) AS out_of_stock
+FROM inventories i
+JOIN products p ON p.id = i.product_id
+WHERE p.is_active
+`).Scan(&t).Error
+	return t, err
+}
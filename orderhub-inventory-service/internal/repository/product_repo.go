@@ -28,6 +28,7 @@ type ProductRepo interface {
 	Delete(ctx context.Context, id uuid.UUID) (bool, error)
 	BatchGetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Product, error)
 	EnsureInventoryRow(ctx context.Context, productID uuid.UUID) error
+	DeactivateByVendor(ctx context.Context, vendorID uuid.UUID) (int64, error)
 }
 
 type productRepo struct {
@@ -114,6 +115,14 @@ func (r *productRepo) BatchGetByIDs(ctx context.Context, ids []uuid.UUID) ([]mod
 	return list, err
 }
 
+// DeactivateByVendor снимает с продажи все активные товары продавца
+func (r *productRepo) DeactivateByVendor(ctx context.Context, vendorID uuid.UUID) (int64, error) {
+	tx := r.db.WithContext(ctx).Model(&models.Product{}).
+		Where("vendor_id = ? AND is_active", vendorID).
+		Updates(map[string]any{"is_active": false, "updated_at": gorm.Expr("now()")})
+	return tx.RowsAffected, tx.Error
+}
+
 func (r *productRepo) EnsureInventoryRow(ctx context.Context, productID uuid.UUID) error {
 	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&models.Inventory{ProductID: productID}).Error
 }
@@ -1,10 +1,16 @@
 package service
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+)
 
 var (
 	ErrUnauthorized = errors.New("unauthorized")
-	ErrForbidden    = errors.New("forbidden")
+	ErrForbidden    = authz.ErrForbidden
+
+	ErrVendorNotApproved = errors.New("vendor is not approved")
 
 	ErrProductNotFound                     = errors.New("product not found")
 	ErrInventoryNotFound                   = errors.New("inventory not found")
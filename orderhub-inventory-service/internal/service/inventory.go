@@ -18,6 +18,7 @@ type ProductInput struct {
 	PriceCents   int64
 	CurrencyCode string // ожидаем "RUB"
 	IsActive     bool
+	ImageURL     string
 }
 
 type ProductPatch struct {
@@ -27,6 +28,7 @@ type ProductPatch struct {
 	PriceCents   *int64
 	CurrencyCode *string // если передали — должен быть "RUB"
 	IsActive     *bool
+	ImageURL     *string // "" — убрать изображение
 }
 
 type ProductListFilter struct {
@@ -63,9 +65,13 @@ type InventoryService interface {
 	ListProducts(ctx context.Context, f ProductListFilter) ([]models.Product, int64, error)
 	DeleteProduct(ctx context.Context, productID uuid.UUID) (bool, error)
 	BatchGetProducts(ctx context.Context, ids []uuid.UUID) ([]models.Product, error)
+	// DeactivateVendorProducts снимает с продажи товары удалённого продавца без проверки прав
+	// вызывающего: вызывается consumer'ом событий auth-service, а не из gRPC.
+	DeactivateVendorProducts(ctx context.Context, vendorID uuid.UUID) (int64, error)
 
 	// stock
 	GetStock(ctx context.Context, productID uuid.UUID) (*models.Inventory, error)
+	BatchGetStock(ctx context.Context, productIDs []uuid.UUID) ([]models.Inventory, error)
 	SetStock(ctx context.Context, productID uuid.UUID, available int32) (*models.Inventory, error)
 	AdjustStock(ctx context.Context, productID uuid.UUID, delta int32) (*models.Inventory, error)
 
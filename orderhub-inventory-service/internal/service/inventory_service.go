@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"inventory-service/internal/metrics"
 	"inventory-service/internal/models"
 	"inventory-service/internal/repository"
 	"strings"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
 	"github.com/google/uuid"
 )
 
@@ -22,18 +24,26 @@ func NewInventoryService(repo *repository.Repository) *inventoryService {
 	}
 }
 
-func (s *inventoryService) requireAuth(ctx context.Context) (uuid.UUID, Role, error) {
+func (s *inventoryService) requireAuth(ctx context.Context) (uuid.UUID, error) {
 	uid, ok := UserIDFromContext(ctx)
 	if !ok {
-		return uuid.Nil, "", ErrUnauthorized
+		return uuid.Nil, ErrUnauthorized
 	}
+	return uid, nil
+}
 
-	role, ok := RoleFromContext(ctx)
-	if !ok {
-		return uuid.Nil, "", ErrUnauthorized
+// requireOwned пропускает владельца ресурса с правом perm или любого пользователя с permAny.
+func requireOwned(ctx context.Context, reqUser, owner uuid.UUID, perm, permAny string) error {
+	if authz.Has(ctx, permAny) {
+		return nil
 	}
-
-	return uid, role, nil
+	if err := authz.Require(ctx, perm); err != nil {
+		return err
+	}
+	if owner != reqUser {
+		return ErrForbidden
+	}
+	return nil
 }
 
 func mustRub(code string) bool {
@@ -41,17 +51,19 @@ func mustRub(code string) bool {
 }
 
 func (s *inventoryService) CreateProduct(ctx context.Context, in ProductInput) (*models.Product, error) {
-	reqUser, role, err := s.requireAuth(ctx)
+	reqUser, err := s.requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if role != RoleAdmin && role != RoleVendor {
-		return nil, ErrForbidden
+	if err := requireOwned(ctx, reqUser, in.VendorID, authz.PermProductWrite, authz.PermProductWriteAny); err != nil {
+		return nil, err
 	}
-
-	if role == RoleVendor && in.VendorID != reqUser {
-		return nil, ErrForbidden
+	// ROLE_VENDOR выдаётся только после одобрения заявки в auth-service
+	if !authz.Has(ctx, authz.PermProductWriteAny) {
+		if role, _ := RoleFromContext(ctx); role != RoleVendor {
+			return nil, ErrVendorNotApproved
+		}
 	}
 
 	if !mustRub(in.CurrencyCode) {
@@ -67,6 +79,7 @@ func (s *inventoryService) CreateProduct(ctx context.Context, in ProductInput) (
 		PriceCents:   in.PriceCents,
 		CurrencyCode: currencyRUB,
 		IsActive:     in.IsActive,
+		ImageURL:     strings.TrimSpace(in.ImageURL),
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}
@@ -91,7 +104,7 @@ func (s *inventoryService) CreateProduct(ctx context.Context, in ProductInput) (
 }
 
 func (s *inventoryService) UpdateProduct(ctx context.Context, productID uuid.UUID, patch ProductPatch) (*models.Product, error) {
-	reqUser, role, err := s.requireAuth(ctx)
+	reqUser, err := s.requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +118,8 @@ func (s *inventoryService) UpdateProduct(ctx context.Context, productID uuid.UUI
 		return nil, ErrProductNotFound
 	}
 
-	if role != RoleAdmin && !(role == RoleVendor && p.VendorID == reqUser) {
-		return nil, ErrForbidden
+	if err := requireOwned(ctx, reqUser, p.VendorID, authz.PermProductWrite, authz.PermProductWriteAny); err != nil {
+		return nil, err
 	}
 
 	fields := map[string]any{}
@@ -134,6 +147,10 @@ func (s *inventoryService) UpdateProduct(ctx context.Context, productID uuid.UUI
 		fields["is_active"] = *patch.IsActive
 	}
 
+	if patch.ImageURL != nil {
+		fields["image_url"] = strings.TrimSpace(*patch.ImageURL)
+	}
+
 	if len(fields) == 0 {
 		return p, nil
 	}
@@ -178,7 +195,7 @@ func (s *inventoryService) ListProducts(ctx context.Context, f ProductListFilter
 }
 
 func (s *inventoryService) DeleteProduct(ctx context.Context, productID uuid.UUID) (bool, error) {
-	reqUser, role, err := s.requireAuth(ctx)
+	reqUser, err := s.requireAuth(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -189,8 +206,8 @@ func (s *inventoryService) DeleteProduct(ctx context.Context, productID uuid.UUI
 	if p == nil {
 		return false, ErrProductNotFound
 	}
-	if role != RoleAdmin && !(role == RoleVendor && p.VendorID == reqUser) {
-		return false, ErrForbidden
+	if err := requireOwned(ctx, reqUser, p.VendorID, authz.PermProductWrite, authz.PermProductWriteAny); err != nil {
+		return false, err
 	}
 
 	inv, err := s.repo.Inventories.Get(ctx, productID)
@@ -209,6 +226,10 @@ func (s *inventoryService) BatchGetProducts(ctx context.Context, ids []uuid.UUID
 	return s.repo.Products.BatchGetByIDs(ctx, ids)
 }
 
+func (s *inventoryService) DeactivateVendorProducts(ctx context.Context, vendorID uuid.UUID) (int64, error) {
+	return s.repo.Products.DeactivateByVendor(ctx, vendorID)
+}
+
 func (s *inventoryService) GetStock(ctx context.Context, productID uuid.UUID) (*models.Inventory, error) {
 	inv, err := s.repo.Inventories.Get(ctx, productID)
 	if err != nil {
@@ -220,8 +241,12 @@ func (s *inventoryService) GetStock(ctx context.Context, productID uuid.UUID) (*
 	return inv, nil
 }
 
+func (s *inventoryService) BatchGetStock(ctx context.Context, productIDs []uuid.UUID) ([]models.Inventory, error) {
+	return s.repo.Inventories.BatchGet(ctx, productIDs)
+}
+
 func (s *inventoryService) SetStock(ctx context.Context, productID uuid.UUID, available int32) (*models.Inventory, error) {
-	reqUser, role, err := s.requireAuth(ctx)
+	reqUser, err := s.requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -233,8 +258,8 @@ func (s *inventoryService) SetStock(ctx context.Context, productID uuid.UUID, av
 		return nil, ErrProductNotFound
 	}
 
-	if role != RoleAdmin && !(role == RoleVendor && p.VendorID == reqUser) {
-		return nil, ErrForbidden
+	if err := requireOwned(ctx, reqUser, p.VendorID, authz.PermStockAdjust, authz.PermStockAdjustAny); err != nil {
+		return nil, err
 	}
 
 	if err := s.repo.Inventories.SetAvailable(ctx, productID, available); err != nil {
@@ -245,7 +270,7 @@ func (s *inventoryService) SetStock(ctx context.Context, productID uuid.UUID, av
 }
 
 func (s *inventoryService) AdjustStock(ctx context.Context, productID uuid.UUID, delta int32) (*models.Inventory, error) {
-	reqUser, role, err := s.requireAuth(ctx)
+	reqUser, err := s.requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -257,8 +282,8 @@ func (s *inventoryService) AdjustStock(ctx context.Context, productID uuid.UUID,
 	if p == nil {
 		return nil, ErrProductNotFound
 	}
-	if role != RoleAdmin && !(role == RoleVendor && p.VendorID == reqUser) {
-		return nil, ErrForbidden
+	if err := requireOwned(ctx, reqUser, p.VendorID, authz.PermStockAdjust, authz.PermStockAdjustAny); err != nil {
+		return nil, err
 	}
 
 	_, err = s.repo.Inventories.AdjustAvailable(ctx, productID, delta)
@@ -348,9 +373,26 @@ func (s *inventoryService) Reserve(ctx context.Context, orderID uuid.UUID, items
 	if err != nil {
 		return ReserveResult{}, err
 	}
+	observeReservation(res)
 	return res, nil
 }
 
+// observeReservation пишет исход резерва в метрики
+func observeReservation(res ReserveResult) {
+	switch {
+	case len(res.Failed) == 0:
+		metrics.Reservations.WithLabelValues("reserved").Inc()
+	case len(res.OK) == 0:
+		metrics.Reservations.WithLabelValues("failed").Inc()
+	default:
+		metrics.Reservations.WithLabelValues("partial").Inc()
+	}
+	metrics.ReserveItems.WithLabelValues("reserved").Add(float64(len(res.OK)))
+	for _, f := range res.Failed {
+		metrics.ReserveItems.WithLabelValues(strings.ReplaceAll(f.Reason, " ", "_")).Inc()
+	}
+}
+
 func (s *inventoryService) Release(ctx context.Context, orderID uuid.UUID) (int64, error) {
 	var releasedTotal int64
 
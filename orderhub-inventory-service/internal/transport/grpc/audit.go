@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"inventory-service/internal/service"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// NewImpersonationAuditInterceptor журналирует изменяющие вызовы, которые администратор
+// выполняет от имени пользователя (токен с claim'ом act). Ставится после auth-интерсептора.
+func NewImpersonationAuditInterceptor(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		actor, ok := authz.ActorFromContext(ctx)
+		if !ok || !authz.IsWriteMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		uid, _ := service.UserIDFromContext(ctx)
+		resp, err := handler(ctx, req)
+		correlation.Logger(ctx, log).Info("impersonated write",
+			zap.String("method", info.FullMethod),
+			zap.String("actor_id", actor),
+			zap.String("user_id", uid.String()),
+			zap.String("code", status.Code(err).String()),
+		)
+		return resp, err
+	}
+}
@@ -172,6 +172,33 @@ func (h *Handler) BatchGetProducts(ctx context.Context, req *inventoryv1.BatchGe
 	return out, nil
 }
 
+func (h *Handler) BatchGetStock(ctx context.Context, req *inventoryv1.BatchGetStockRequest) (*inventoryv1.BatchGetStockResponse, error) {
+	if v, ok := any(req).(interface{ ValidateAll() error }); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "validation: %v", err)
+		}
+	}
+	ids := make([]uuid.UUID, 0, len(req.GetProductIds()))
+	for _, pid := range req.GetProductIds() {
+		id, err := fromUUID(pid)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product_id: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	list, err := h.svc.BatchGetStock(ctx, ids)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	out := &inventoryv1.BatchGetStockResponse{
+		Stocks: make([]*inventoryv1.Stock, 0, len(list)),
+	}
+	for i := range list {
+		out.Stocks = append(out.Stocks, toProtoStock(&list[i]))
+	}
+	return out, nil
+}
+
 func (h *Handler) GetStock(ctx context.Context, req *inventoryv1.GetStockRequest) (*inventoryv1.GetStockResponse, error) {
 	if v, ok := any(req).(interface{ ValidateAll() error }); ok {
 		if err := v.ValidateAll(); err != nil {
@@ -319,6 +346,7 @@ func toProductInput(pi *inventoryv1.ProductInput, vendorID uuid.UUID) (service.P
 		PriceCents:   pi.GetPriceCents(),
 		CurrencyCode: pi.GetCurrencyCode(),
 		IsActive:     pi.GetIsActive(),
+		ImageURL:     pi.GetImageUrl(),
 	}, nil
 }
 
@@ -351,6 +379,10 @@ func fromPatch(p *inventoryv1.ProductPatch) service.ProductPatch {
 		v := p.IsActive.Value
 		out.IsActive = &v
 	}
+	if p.ImageUrl != nil {
+		v := p.ImageUrl.Value
+		out.ImageURL = &v
+	}
 	return out
 }
 
@@ -364,6 +396,7 @@ func toProtoProduct(p *models.Product) *inventoryv1.Product {
 		PriceCents:   p.PriceCents,
 		CurrencyCode: p.CurrencyCode,
 		IsActive:     p.IsActive,
+		ImageUrl:     p.ImageURL,
 		CreatedAt:    timestamppb.New(p.CreatedAt),
 		UpdatedAt:    timestamppb.New(p.UpdatedAt),
 	}
@@ -409,6 +442,8 @@ func toStatusErr(err error) error {
 		return status.Error(codes.Unauthenticated, "unauthorized")
 	case errors.Is(err, service.ErrForbidden):
 		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, service.ErrVendorNotApproved):
+		return status.Error(codes.PermissionDenied, err.Error())
 	case errors.Is(err, service.ErrProductNotFound),
 		errors.Is(err, service.ErrInventoryNotFound):
 		return status.Error(codes.NotFound, err.Error())
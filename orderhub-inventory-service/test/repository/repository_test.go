@@ -18,7 +18,7 @@ import (
 func setupDB(t *testing.T) *gorm.DB {
 	t.Helper()
 	db := testutil.SetupTestPostgres(t)
-	if err := migrate.MigrateInventoryDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateInventoryDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return db
@@ -239,6 +239,48 @@ func TestProductRepo_BatchGetByIDs(t *testing.T) {
 	}
 }
 
+func TestProductRepo_DeactivateByVendor(t *testing.T) {
+	db := setupDB(t)
+	repo := repository.NewProductRepo(db)
+
+	ctx := context.Background()
+	vendorID := uuid.New()
+
+	p1 := models.Product{VendorID: vendorID, SKU: "D1", Name: "Active", CurrencyCode: "RUB", IsActive: true}
+	p2 := models.Product{VendorID: vendorID, SKU: "D2", Name: "Inactive", CurrencyCode: "RUB", IsActive: false}
+	other := models.Product{VendorID: uuid.New(), SKU: "D3", Name: "Other vendor", CurrencyCode: "RUB", IsActive: true}
+	for _, p := range []*models.Product{&p1, &p2, &other} {
+		if err := repo.Create(ctx, p); err != nil {
+			t.Fatalf("Create %s: %v", p.SKU, err)
+		}
+	}
+
+	n, err := repo.DeactivateByVendor(ctx, vendorID)
+	if err != nil {
+		t.Fatalf("DeactivateByVendor: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 deactivated, got %d", n)
+	}
+	got, _ := repo.GetByID(ctx, p1.ID)
+	if got.IsActive {
+		t.Fatal("vendor product must be inactive")
+	}
+	got, _ = repo.GetByID(ctx, other.ID)
+	if !got.IsActive {
+		t.Fatal("other vendor's product must stay active")
+	}
+
+	// повторное событие ничего не меняет
+	n, err = repo.DeactivateByVendor(ctx, vendorID)
+	if err != nil {
+		t.Fatalf("DeactivateByVendor again: %v", err)
+	}
+	if n != 0 {
+		t.Fatalf("expected 0 on repeat, got %d", n)
+	}
+}
+
 func TestInventoryRepo_BasicOperations(t *testing.T) {
 	db := setupDB(t)
 	repo := repository.NewInventoryRepo(db)
@@ -769,3 +811,94 @@ func TestInventoryRepo_UpdatedAtTrigger(t *testing.T) {
 		t.Fatalf("expected UpdatedAt to be updated, initial=%v, after=%v", initialUpdatedAt, inv2.UpdatedAt)
 	}
 }
+
+func TestInventoryRepo_Totals(t *testing.T) {
+	db := setupDB(t)
+	repo := repository.NewInventoryRepo(db)
+	prodRepo := repository.NewProductRepo(db)
+	ctx := context.Background()
+	vendorID := uuid.New()
+
+	create := func(sku string, active bool, available int32) uuid.UUID {
+		p := models.Product{VendorID: vendorID, SKU: sku, Name: sku, CurrencyCode: "RUB", IsActive: true}
+		if err := prodRepo.Create(ctx, &p); err != nil {
+			t.Fatalf("Create product: %v", err)
+		}
+		if err := prodRepo.EnsureInventoryRow(ctx, p.ID); err != nil {
+			t.Fatalf("EnsureInventoryRow: %v", err)
+		}
+		if err := repo.SetAvailable(ctx, p.ID, available); err != nil {
+			t.Fatalf("SetAvailable: %v", err)
+		}
+		if !active {
+			if err := prodRepo.UpdateFields(ctx, p.ID, map[string]any{"is_active": false}); err != nil {
+				t.Fatalf("deactivate: %v", err)
+			}
+		}
+		return p.ID
+	}
+	inStock := create("TOT-1", true, 10)
+	create("TOT-2", true, 0)
+	create("TOT-3", false, 100) // неактивные товары не учитываются
+
+	if ok, err := repo.TryReserve(ctx, inStock, 3); err != nil || !ok {
+		t.Fatalf("TryReserve: ok=%v err=%v", ok, err)
+	}
+
+	got, err := repo.Totals(ctx)
+	if err != nil {
+		t.Fatalf("Totals: %v", err)
+	}
+	want := repository.StockTotals{Available: 7, Reserved: 3, OutOfStock: 1}
+	if got != want {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+}
+
+func TestInventoryRepo_BatchGet(t *testing.T) {
+	db := setupDB(t)
+	repo := repository.NewInventoryRepo(db)
+	prodRepo := repository.NewProductRepo(db)
+	ctx := context.Background()
+	vendorID := uuid.New()
+
+	p1 := models.Product{VendorID: vendorID, SKU: "BG-1", Name: "BG-1", CurrencyCode: "RUB", IsActive: true, ImageURL: "https://cdn.example.com/bg-1.png"}
+	p2 := models.Product{VendorID: vendorID, SKU: "BG-2", Name: "BG-2", CurrencyCode: "RUB", IsActive: true}
+	for _, p := range []*models.Product{&p1, &p2} {
+		if err := prodRepo.Create(ctx, p); err != nil {
+			t.Fatalf("Create product: %v", err)
+		}
+		if err := prodRepo.EnsureInventoryRow(ctx, p.ID); err != nil {
+			t.Fatalf("EnsureInventoryRow: %v", err)
+		}
+	}
+	if err := repo.SetAvailable(ctx, p1.ID, 7); err != nil {
+		t.Fatalf("SetAvailable: %v", err)
+	}
+
+	got, err := repo.BatchGet(ctx, []uuid.UUID{p1.ID, p2.ID, uuid.New()})
+	if err != nil {
+		t.Fatalf("BatchGet: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("BatchGet returned %d rows, want 2 (unknown product skipped)", len(got))
+	}
+	for _, inv := range got {
+		if inv.ProductID == p1.ID && inv.Available != 7 {
+			t.Errorf("available = %d, want 7", inv.Available)
+		}
+	}
+
+	stored, err := prodRepo.GetByID(ctx, p1.ID)
+	if err != nil || stored == nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if stored.ImageURL != p1.ImageURL {
+		t.Errorf("image_url = %q, want %q", stored.ImageURL, p1.ImageURL)
+	}
+
+	empty, err := repo.BatchGet(ctx, nil)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("BatchGet(nil) = %v, %v; want empty", empty, err)
+	}
+}
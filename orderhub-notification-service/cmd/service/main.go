@@ -4,6 +4,7 @@ import (
 	"context"
 	"notification-service/config"
 	"notification-service/internal/consumer"
+	nmetrics "notification-service/internal/metrics"
 	"notification-service/internal/sender"
 	"os"
 	"os/signal"
@@ -11,6 +12,10 @@ import (
 	"time"
 
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/metrics"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness/kafkaready"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 )
@@ -26,15 +31,37 @@ func main() {
 
 	log := logger.L()
 
+	shutdownTracing, err := telemetry.Setup(context.Background(), "notification-service", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg := config.Load(log)
 
 	emailSender := sender.NewEmailSender(cfg)
 
+	smsProvider, err := sender.NewSMSProvider(cfg, log)
+	if err != nil {
+		log.Fatal("invalid sms provider", zap.Error(err))
+	}
+	smsSender := sender.NewSMSSender(cfg, smsProvider)
+
 	if len(cfg.KafkaBrokers) == 0 {
 		log.Fatal("no kafka brokers configured (KAFKA_BROKERS)")
 	}
 
 	cons := consumer.NewKafkaEmailConsumer(cfg.KafkaBrokers, cfg.KafkaGroupID, cfg.KafkaTopic, emailSender, log)
+	smsCons := consumer.NewKafkaSMSConsumer(cfg.KafkaBrokers, cfg.KafkaGroupID, cfg.KafkaSMSTopic, smsSender, log)
+	nmetrics.RegisterConsumerLag(cfg.KafkaTopic, cons.Lag)
+	nmetrics.RegisterConsumerLag(cfg.KafkaSMSTopic, smsCons.Lag)
+	// готовность consumer'а — доступность брокеров Kafka
+	ready := readiness.New(log)
+	ready.Add("kafka", kafkaready.Check(cfg.KafkaBrokers))
+	metricsSrv := metrics.Serve(cfg.MetricsAddr, log,
+		metrics.Route{Pattern: "/readyz", Handler: ready.Handler()},
+		metrics.Route{Pattern: "/livez", Handler: readiness.LiveHandler()},
+	)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -44,6 +71,11 @@ func main() {
 			log.Error("consumer stopped", zap.Error(err))
 		}
 	}()
+	go func() {
+		if err := smsCons.Run(ctx); err != nil {
+			log.Error("sms consumer stopped", zap.Error(err))
+		}
+	}()
 
 	// Graceful shutdown
 	sigCh := make(chan os.Signal, 1)
@@ -52,5 +84,7 @@ func main() {
 	log.Info("shutdown signal received")
 	cancel()
 	_ = cons.Close()
+	_ = smsCons.Close()
+	_ = metrics.Shutdown(context.Background(), metricsSrv)
 	time.Sleep(200 * time.Millisecond)
 }
@@ -20,6 +20,12 @@ type Config struct {
 	KafkaBrokers []string
 	KafkaGroupID string
 	KafkaTopic   string
+
+	KafkaSMSTopic string
+	SMSProvider   string // log — SMS пишутся в лог и SMSOutboxFile вместо отправки
+	SMSOutboxFile string
+
+	MetricsAddr string // адрес HTTP-листенера /metrics; "off" — отключить
 }
 
 func Load(log *zap.Logger) *Config {
@@ -33,6 +39,12 @@ func Load(log *zap.Logger) *Config {
 		KafkaBrokers: splitAndTrim(os.Getenv("KAFKA_BROKERS")),
 		KafkaGroupID: getEnv("KAFKA_GROUP_ID", log),
 		KafkaTopic:   getEnv("KAFKA_TOPIC_EMAIL", log),
+
+		KafkaSMSTopic: envDefault("KAFKA_TOPIC_SMS", "sms.send"),
+		SMSProvider:   envDefault("SMS_PROVIDER", "log"),
+		SMSOutboxFile: os.Getenv("SMS_OUTBOX_FILE"),
+
+		MetricsAddr: envDefault("METRICS_ADDR", ":9104"),
 	}
 	return c
 }
@@ -45,6 +57,13 @@ func getEnv(key string, log *zap.Logger) string {
 	panic("missing required environment variable: " + key)
 }
 
+func envDefault(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return def
+}
+
 func getEnvInt(key string, log *zap.Logger) int {
 	valStr := getEnv(key, log)
 	val, err := strconv.Atoi(valStr)
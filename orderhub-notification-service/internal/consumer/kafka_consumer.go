@@ -4,19 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"notification-service/internal/metrics"
 	"notification-service/internal/model"
 	"notification-service/internal/sender"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 )
 
+var errInvalidMessage = errors.New("invalid message")
+
 type EmailMessage struct {
 	To       string         `json:"to"`
 	Subject  string         `json:"subject"`
 	Template string         `json:"template"`
 	Data     map[string]any `json:"data"`
+	Locale   string         `json:"locale,omitempty"`
 }
 
 type KafkaEmailConsumer struct {
@@ -50,21 +56,50 @@ func (c *KafkaEmailConsumer) Run(ctx context.Context) error {
 			c.log.Error("read message", zap.Error(err))
 			continue
 		}
-		var em EmailMessage
-		if err := json.Unmarshal(m.Value, &em); err != nil {
-			c.log.Error("unmarshal email message", zap.ByteString("value", m.Value), zap.Error(err))
-			continue
-		}
-		if em.To == "" || em.Template == "" {
-			c.log.Warn("invalid email message", zap.Any("msg", em))
-			continue
-		}
-		if err = c.emailSender.SendEmail(model.EmailNotification{To: em.To, Subject: em.Subject, Template: em.Template, Data: em.Data}); err != nil {
-			c.log.Error("send email failed", zap.String("to", em.To), zap.String("template", em.Template), zap.Error(err))
-			continue
-		}
-		c.log.Info("email sent", zap.String("to", em.To), zap.String("template", em.Template))
+		msgCtx, span := kafkatrace.StartConsume(ctx, m)
+		msgCtx = correlation.WithIDs(msgCtx, idsFromHeaders(m.Headers))
+		kafkatrace.End(span, c.handle(msgCtx, m))
 	}
 }
 
+func (c *KafkaEmailConsumer) handle(ctx context.Context, m kafka.Message) error {
+	log := correlation.Logger(ctx, c.log)
+	var em EmailMessage
+	if err := json.Unmarshal(m.Value, &em); err != nil {
+		log.Error("unmarshal email message", zap.ByteString("value", m.Value), zap.Error(err))
+		metrics.Notifications.WithLabelValues("email", "", "invalid").Inc()
+		return err
+	}
+	if em.To == "" || em.Template == "" {
+		log.Warn("invalid email message", zap.Any("msg", em))
+		metrics.Notifications.WithLabelValues("email", em.Template, "invalid").Inc()
+		return errInvalidMessage
+	}
+	if err := c.emailSender.SendEmail(model.EmailNotification{To: em.To, Subject: em.Subject, Template: em.Template, Data: em.Data, Locale: em.Locale}); err != nil {
+		log.Error("send email failed", zap.String("to", em.To), zap.String("template", em.Template), zap.Error(err))
+		metrics.Notifications.WithLabelValues("email", em.Template, "failed").Inc()
+		return err
+	}
+	log.Info("email sent", zap.String("to", em.To), zap.String("template", em.Template))
+	metrics.Notifications.WithLabelValues("email", em.Template, "sent").Inc()
+	return nil
+}
+
+// Lag — отставание consumer'а от конца партиции
+func (c *KafkaEmailConsumer) Lag() int64 { return c.reader.Stats().Lag }
+
 func (c *KafkaEmailConsumer) Close() error { return c.reader.Close() }
+
+// idsFromHeaders достаёт x-request-id и traceparent, которые auth-service кладёт в заголовки сообщения
+func idsFromHeaders(headers []kafka.Header) correlation.IDs {
+	var ids correlation.IDs
+	for _, h := range headers {
+		switch h.Key {
+		case correlation.KeyRequestID:
+			ids.RequestID = string(h.Value)
+		case correlation.KeyTraceparent:
+			ids.Traceparent = string(h.Value)
+		}
+	}
+	return ids
+}
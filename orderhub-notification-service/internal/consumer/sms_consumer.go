@@ -0,0 +1,89 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"notification-service/internal/metrics"
+	"notification-service/internal/model"
+	"notification-service/internal/sender"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+type SMSMessage struct {
+	To       string         `json:"to"`
+	Template string         `json:"template"`
+	Data     map[string]any `json:"data"`
+	Locale   string         `json:"locale,omitempty"`
+}
+
+type KafkaSMSConsumer struct {
+	reader    *kafka.Reader
+	smsSender *sender.SMSSender
+	log       *zap.Logger
+}
+
+func NewKafkaSMSConsumer(brokers []string, groupID, topic string, smsSender *sender.SMSSender, log *zap.Logger) *KafkaSMSConsumer {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           brokers,
+		GroupID:           groupID,
+		Topic:             topic,
+		MinBytes:          1,
+		MaxBytes:          10e6,
+		CommitInterval:    time.Second,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+	})
+	return &KafkaSMSConsumer{reader: r, smsSender: smsSender, log: log}
+}
+
+func (c *KafkaSMSConsumer) Run(ctx context.Context) error {
+	c.log.Info("kafka sms consumer started")
+	for {
+		m, err := c.reader.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			c.log.Error("read message", zap.Error(err))
+			continue
+		}
+		msgCtx, span := kafkatrace.StartConsume(ctx, m)
+		msgCtx = correlation.WithIDs(msgCtx, idsFromHeaders(m.Headers))
+		kafkatrace.End(span, c.handle(msgCtx, m))
+	}
+}
+
+func (c *KafkaSMSConsumer) handle(ctx context.Context, m kafka.Message) error {
+	log := correlation.Logger(ctx, c.log)
+	var sm SMSMessage
+	if err := json.Unmarshal(m.Value, &sm); err != nil {
+		// не логируем тело: в нём одноразовый код
+		log.Error("unmarshal sms message", zap.Error(err))
+		metrics.Notifications.WithLabelValues("sms", "", "invalid").Inc()
+		return err
+	}
+	if sm.To == "" || sm.Template == "" {
+		log.Warn("invalid sms message", zap.String("to", sm.To), zap.String("template", sm.Template))
+		metrics.Notifications.WithLabelValues("sms", sm.Template, "invalid").Inc()
+		return errInvalidMessage
+	}
+	if err := c.smsSender.SendSMS(ctx, model.SMSNotification{To: sm.To, Template: sm.Template, Data: sm.Data, Locale: sm.Locale}); err != nil {
+		log.Error("send sms failed", zap.String("to", sm.To), zap.String("template", sm.Template), zap.Error(err))
+		metrics.Notifications.WithLabelValues("sms", sm.Template, "failed").Inc()
+		return err
+	}
+	log.Info("sms sent", zap.String("to", sm.To), zap.String("template", sm.Template))
+	metrics.Notifications.WithLabelValues("sms", sm.Template, "sent").Inc()
+	return nil
+}
+
+// Lag — отставание consumer'а от конца партиции
+func (c *KafkaSMSConsumer) Lag() int64 { return c.reader.Stats().Lag }
+
+func (c *KafkaSMSConsumer) Close() error { return c.reader.Close() }
@@ -0,0 +1,24 @@
+// Package metrics — метрики notification-service; /metrics отдаёт листенер из pkg/metrics
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Notifications — обработанные сообщения: channel (email, sms), template, result (sent,
+// failed, invalid)
+var Notifications = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "notification_messages_total",
+	Help: "Notification messages processed by channel, template and result.",
+}, []string{"channel", "template", "result"})
+
+// RegisterConsumerLag публикует отставание consumer'а топика (сообщений до конца партиции);
+// lag вызывается при каждом scrape
+func RegisterConsumerLag(topic string, lag func() int64) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "kafka_consumer_lag",
+		Help:        "Messages between the consumer position and the end of the partition.",
+		ConstLabels: prometheus.Labels{"topic": topic},
+	}, func() float64 { return float64(lag()) }))
+}
@@ -5,4 +5,12 @@ type EmailNotification struct {
 	Subject  string
 	Template string         // имя шаблона (например, "verify_email")
 	Data     map[string]any // данные для шаблона
+	Locale   string         // язык получателя (BCP 47); пусто — шаблон по умолчанию
+}
+
+type SMSNotification struct {
+	To       string         // номер в формате E.164
+	Template string         // имя шаблона из каталога sms (например, "verify_phone")
+	Data     map[string]any // данные для шаблона
+	Locale   string
 }
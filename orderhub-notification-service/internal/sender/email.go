@@ -22,11 +22,11 @@ func NewEmailSender(cfg *config.Config) *EmailSender {
 }
 
 func (s *EmailSender) SendEmail(n model.EmailNotification) error {
-	htmlBody, err := s.renderHTML(n.Template, n.Data)
+	htmlBody, err := s.renderHTML(n.Template, n.Locale, n.Data)
 	if err != nil {
 		return fmt.Errorf("render html: %w", err)
 	}
-	plainBody, err := s.renderPlain(n.Template, n.Data)
+	plainBody, err := s.renderPlain(n.Template, n.Locale, n.Data)
 	if err != nil {
 		return fmt.Errorf("render plain: %w", err)
 	}
@@ -54,9 +54,34 @@ func (s *EmailSender) SendEmail(n model.EmailNotification) error {
 	return nil
 }
 
-func (s *EmailSender) renderHTML(tmplName string, data map[string]any) (string, error) {
-	path := filepath.Join(s.cfg.TMPLDir, tmplName+".html")
-	content, err := os.ReadFile(path)
+func (s *EmailSender) readTemplate(tmplName, locale, ext string) ([]byte, error) {
+	return readLocalized(s.cfg.TMPLDir, tmplName, locale, ext)
+}
+
+// readLocalized ищет шаблон на языке получателя: "verify_email.en-US.html", затем
+// "verify_email.en.html" и, если перевода нет, шаблон по умолчанию "verify_email.html"
+func readLocalized(dir, tmplName, locale, ext string) ([]byte, error) {
+	candidates := []string{}
+	if locale != "" {
+		candidates = append(candidates, tmplName+"."+locale+ext)
+		if lang, _, ok := strings.Cut(locale, "-"); ok {
+			candidates = append(candidates, tmplName+"."+lang+ext)
+		}
+	}
+	for _, name := range candidates {
+		// locale приходит из сообщения — не даём выйти за каталог шаблонов
+		if strings.ContainsAny(name, `/\`) {
+			continue
+		}
+		if content, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			return content, nil
+		}
+	}
+	return os.ReadFile(filepath.Join(dir, tmplName+ext))
+}
+
+func (s *EmailSender) renderHTML(tmplName, locale string, data map[string]any) (string, error) {
+	content, err := s.readTemplate(tmplName, locale, ".html")
 	if err != nil {
 		return "", err
 	}
@@ -71,9 +96,8 @@ func (s *EmailSender) renderHTML(tmplName string, data map[string]any) (string,
 	return buf.String(), nil
 }
 
-func (s *EmailSender) renderPlain(tmplName string, data map[string]any) (string, error) {
-	path := filepath.Join(s.cfg.TMPLDir, tmplName+".txt")
-	content, err := os.ReadFile(path)
+func (s *EmailSender) renderPlain(tmplName, locale string, data map[string]any) (string, error) {
+	content, err := s.readTemplate(tmplName, locale, ".txt")
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,44 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"notification-service/config"
+	"notification-service/internal/model"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// SMSProvider доставляет готовый текст SMS. Реализация для конкретного оператора
+// подключается в NewSMSProvider по SMS_PROVIDER.
+type SMSProvider interface {
+	Send(ctx context.Context, to, text string) error
+}
+
+// SMSSender рендерит шаблон из каталога sms и передаёт текст провайдеру
+type SMSSender struct {
+	cfg      *config.Config
+	provider SMSProvider
+}
+
+func NewSMSSender(cfg *config.Config, provider SMSProvider) *SMSSender {
+	return &SMSSender{cfg: cfg, provider: provider}
+}
+
+func (s *SMSSender) SendSMS(ctx context.Context, n model.SMSNotification) error {
+	content, err := readLocalized(filepath.Join(s.cfg.TMPLDir, "sms"), n.Template, n.Locale, ".txt")
+	if err != nil {
+		return fmt.Errorf("read sms template: %w", err)
+	}
+	tmpl, err := template.New(n.Template).Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("parse sms template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, n.Data); err != nil {
+		return fmt.Errorf("render sms: %w", err)
+	}
+	return s.provider.Send(ctx, n.To, strings.TrimSpace(buf.String()))
+}
@@ -0,0 +1,62 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"notification-service/config"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"go.uber.org/zap"
+)
+
+// NewSMSProvider выбирает провайдера по SMS_PROVIDER
+func NewSMSProvider(cfg *config.Config, log *zap.Logger) (SMSProvider, error) {
+	switch cfg.SMSProvider {
+	case "", "log":
+		return NewLogSMSProvider(cfg.SMSOutboxFile, log), nil
+	default:
+		return nil, fmt.Errorf("unknown SMS_PROVIDER %q", cfg.SMSProvider)
+	}
+}
+
+// LogSMSProvider — заглушка для разработки и тестов: ничего не отправляет, а пишет SMS в лог
+// и, если задан SMS_OUTBOX_FILE, дописывает их в файл по одной JSON-строке
+type LogSMSProvider struct {
+	path string
+	log  *zap.Logger
+	mu   sync.Mutex
+}
+
+func NewLogSMSProvider(path string, log *zap.Logger) *LogSMSProvider {
+	return &LogSMSProvider{path: path, log: log}
+}
+
+func (p *LogSMSProvider) Send(ctx context.Context, to, text string) error {
+	correlation.Logger(ctx, p.log).Info("sms (not sent, log provider)", zap.String("to", to), zap.String("text", text))
+	if p.path == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(struct {
+		To     string    `json:"to"`
+		Text   string    `json:"text"`
+		SentAt time.Time `json:"sent_at"`
+	}{to, text, time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	f, err := os.OpenFile(p.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open sms outbox file: %w", err)
+	}
+	defer f.Close()
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -1,8 +1,13 @@
 package main
 
 import (
+	"context"
 	"net"
 	"order-service/config"
+	"order-service/internal/consumer"
+	ometrics "order-service/internal/metrics"
+	"order-service/internal/outbox"
+	"order-service/internal/producer"
 	"order-service/internal/repository"
 	"order-service/internal/service"
 	gtransport "order-service/internal/transport/grpc"
@@ -14,8 +19,14 @@ import (
 	inventoryv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/inventory/v1"
 	orderv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/order/v1"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation/interceptor"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/logger"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/metrics"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness/kafkaready"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -36,6 +47,12 @@ func main() {
 
 	log := logger.L()
 
+	shutdownTracing, err := telemetry.Setup(context.Background(), "order-service", log)
+	if err != nil {
+		log.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg := config.Load(log)
 	db := database.ConnectDB(&cfg.DB.Config, log)
 	defer database.CloseDB(db, log)
@@ -43,7 +60,8 @@ func main() {
 	repos := repository.New(db)
 
 	// Connect to Auth service for token introspection
-	authConn, err := grpc.Dial(cfg.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authConn, err := grpc.Dial(cfg.AuthAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), telemetry.DialOption(),
+		grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("auth-service", cfg.AuthRPC, log)))
 	if err != nil {
 		log.Fatal("failed to connect to auth service", zap.Error(err))
 	}
@@ -51,7 +69,8 @@ func main() {
 	authClient := authv1.NewAuthServiceClient(authConn)
 
 	// Connect to Inventory service for pricing
-	inventoryConn, err := grpc.Dial(cfg.InventoryAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	inventoryConn, err := grpc.Dial(cfg.InventoryAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), telemetry.DialOption(),
+		grpc.WithChainUnaryInterceptor(interceptor.UnaryClient(), resilience.UnaryClientInterceptor("inventory-service", cfg.InventoryRPC, log)))
 	if err != nil {
 		log.Fatal("failed to connect to inventory service", zap.Error(err))
 	}
@@ -61,8 +80,33 @@ func main() {
 	// Create pricing provider from inventory client
 	pricing := service.NewInventoryPricingClient(inventoryClient)
 
-	// Event bus is optional for now (nil disables publishing)
-	svc := service.NewOrderService(repos, pricing, nil)
+	// Шина событий опциональна: без KAFKA_BROKERS публикация отключена. События пишутся
+	// в outbox вместе с заказом, в Kafka их отправляет relay — не на пути запроса.
+	var events service.EventNotifier
+	var eventRelay *outbox.Relay
+	if len(cfg.KafkaBrokers) > 0 {
+		orderEvents := producer.NewOrderEventProducer(cfg.KafkaBrokers, cfg.KafkaOrderEventsTopic, cfg.KafkaStatusTopic)
+		defer orderEvents.Close()
+		eventRelay = outbox.NewRelay(repos.OrderEvents, orderEvents, log)
+		// останавливается после GracefulStop: события последних запросов уходят до выхода
+		eventRelay.Start(context.Background())
+		events = eventRelay
+	}
+	svc := service.NewOrderService(repos, pricing, events)
+
+	// account_deleted из auth-service: отмена ожидающих заказов удалённого аккаунта
+	consumerCtx, consumerCancel := context.WithCancel(context.Background())
+	defer consumerCancel()
+	var userEvents *consumer.UserEventConsumer
+	if len(cfg.KafkaBrokers) > 0 {
+		userEvents = consumer.NewUserEventConsumer(cfg.KafkaBrokers, cfg.KafkaGroupID, cfg.KafkaUserEventsTopic, svc, log)
+		ometrics.RegisterConsumerLag(cfg.KafkaUserEventsTopic, userEvents.Lag)
+		go func() {
+			if err := userEvents.Run(consumerCtx); err != nil {
+				log.Error("user events consumer stopped", zap.Error(err))
+			}
+		}()
+	}
 
 	lis, err := net.Listen("tcp", cfg.Port)
 	if err != nil {
@@ -72,14 +116,25 @@ func main() {
 	authInterceptor := gtransport.NewAuthUnaryServerInterceptor(authClient)
 
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(authInterceptor),
+		telemetry.ServerOption(),
+		grpc.ChainUnaryInterceptor(interceptor.UnaryServer(log), metrics.UnaryServerInterceptor(), telemetry.InterceptorSpan("authenticate", authInterceptor), gtransport.NewImpersonationAuditInterceptor(log)),
 	)
 
-	// Health server
+	// Health server: "" — готовность по зависимостям, "liveness" — процесс жив
 	healthSrv := health.NewServer()
-	healthSrv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthSrv)
 
+	ready := readiness.New(log)
+	ready.Add("postgres", database.Ping(db))
+	ready.Add("auth", readiness.GRPC(authConn, ""))
+	ready.Add("inventory", readiness.GRPC(inventoryConn, ""))
+	if len(cfg.KafkaBrokers) > 0 {
+		ready.Add("kafka", kafkaready.Check(cfg.KafkaBrokers))
+	}
+	readyCtx, readyCancel := context.WithCancel(context.Background())
+	defer readyCancel()
+	go ready.Watch(readyCtx, healthSrv, readiness.DefaultInterval)
+
 	// Reflection for local debugging
 	reflection.Register(grpcServer)
 
@@ -90,6 +145,11 @@ func main() {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	metricsSrv := metrics.Serve(cfg.MetricsAddr, log,
+		metrics.Route{Pattern: "/readyz", Handler: ready.Handler()},
+		metrics.Route{Pattern: "/livez", Handler: readiness.LiveHandler()},
+	)
+
 	go func() {
 		log.Info("Starting Order gRPC server", zap.String("addr", cfg.Port))
 		if err := grpcServer.Serve(lis); err != nil {
@@ -99,6 +159,16 @@ func main() {
 
 	<-quit
 	log.Info("Shutting down Order gRPC server...")
+	healthSrv.Shutdown()
+	readyCancel()
+	consumerCancel()
+	if userEvents != nil {
+		_ = userEvents.Close()
+	}
+	_ = metrics.Shutdown(context.Background(), metricsSrv)
 	grpcServer.GracefulStop()
+	if eventRelay != nil {
+		eventRelay.Stop()
+	}
 	log.Info("Order gRPC server stopped gracefully")
 }
@@ -2,8 +2,10 @@ package config
 
 import (
 	"os"
+	"strings"
 
 	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/database"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/resilience"
 
 	"go.uber.org/zap"
 )
@@ -13,10 +15,18 @@ type Config struct {
 	DB            DB
 	AuthAddr      string
 	InventoryAddr string
+
+	AuthRPC      resilience.Config // дедлайны, ретраи и breaker вызовов auth-service (AUTH_RPC_*)
+	InventoryRPC resilience.Config // то же для inventory-service (INVENTORY_RPC_*)
+
+	MetricsAddr string // адрес HTTP-листенера /metrics; "off" — отключить
 	// 	Redis Redis
 
-	// 	KafkaBrokers []string
-	// 	KafkaTopic   string
+	KafkaBrokers          []string // пусто — публикация событий отключена
+	KafkaOrderEventsTopic string
+	KafkaStatusTopic      string // смены статуса заказов для стриминга в gateway
+	KafkaUserEventsTopic  string // события пользователя из auth-service (account_deleted)
+	KafkaGroupID          string
 }
 
 type DB struct {
@@ -32,10 +42,22 @@ type DB struct {
 // }
 
 func Load(log *zap.Logger) *Config {
+	authRPC, err := resilience.FromEnv("AUTH", resilience.AuthDefaults())
+	if err != nil {
+		log.Fatal("invalid auth rpc settings", zap.Error(err))
+	}
+	inventoryRPC, err := resilience.FromEnv("INVENTORY", resilience.InventoryDefaults())
+	if err != nil {
+		log.Fatal("invalid inventory rpc settings", zap.Error(err))
+	}
 	return &Config{
 		Port:          getEnv("APP_PORT", log),
 		AuthAddr:      getEnv("AUTH_ADDR", log),
 		InventoryAddr: getEnv("INVENTORY_ADDR", log),
+		AuthRPC:       authRPC,
+		InventoryRPC:  inventoryRPC,
+
+		MetricsAddr: envDefault("METRICS_ADDR", ":9102"),
 		DB: DB{
 			Config: database.Config{
 				Host:     getEnv("DB_HOST", log),
@@ -53,8 +75,11 @@ func Load(log *zap.Logger) *Config {
 		// 	DB:         atoiDefault(getEnv("REDIS_DB", log), 0),
 		// 	TTLSeconds: atoiDefault(getEnv("CACHE_TTL_SECONDS", log), 60),
 		// },
-		// KafkaBrokers: splitAndTrim(os.Getenv("KAFKA_BROKERS")),
-		// KafkaTopic:   getEnv("KAFKA_TOPIC_EMAIL", log),
+		KafkaBrokers:          splitAndTrim(os.Getenv("KAFKA_BROKERS")),
+		KafkaOrderEventsTopic: envDefault("KAFKA_TOPIC_ORDER_EVENTS", "order.events"),
+		KafkaStatusTopic:      envDefault("KAFKA_TOPIC_ORDER_STATUS", "order.status"),
+		KafkaUserEventsTopic:  envDefault("KAFKA_TOPIC_USER_EVENTS", "users.events"),
+		KafkaGroupID:          envDefault("KAFKA_GROUP_ID", "order-service"),
 	}
 }
 
@@ -66,6 +91,13 @@ func getEnv(key string, log *zap.Logger) string {
 	panic("missing required environment variable: " + key)
 }
 
+func envDefault(key, def string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return def
+}
+
 // func atoiDefault(s string, def int) int {
 // 	n, err := strconv.Atoi(s)
 // 	if err != nil {
@@ -74,16 +106,16 @@ func getEnv(key string, log *zap.Logger) string {
 // 	return n
 // }
 
-// func splitAndTrim(s string) []string {
-// 	if s == "" {
-// 		return nil
-// 	}
-// 	parts := []string{}
-// 	for _, p := range strings.Split(s, ",") {
-// 		pt := strings.TrimSpace(p)
-// 		if pt != "" {
-// 			parts = append(parts, pt)
-// 		}
-// 	}
-// 	return parts
-// }
+func splitAndTrim(s string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := []string{}
+	for _, p := range strings.Split(s, ",") {
+		pt := strings.TrimSpace(p)
+		if pt != "" {
+			parts = append(parts, pt)
+		}
+	}
+	return parts
+}
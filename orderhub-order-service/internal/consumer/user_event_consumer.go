@@ -0,0 +1,143 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"order-service/internal/service"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Типы событий пользователя, которые публикует auth-service
+const (
+	UserEventAccountDeleted = "account_deleted"
+)
+
+// CancelReasonAccountDeleted — причина отмены заказов удалённого аккаунта
+const CancelReasonAccountDeleted = "account_deleted"
+
+const (
+	retryMinBackoff = time.Second
+	retryMaxBackoff = 30 * time.Second
+)
+
+var errInvalidMessage = errors.New("invalid message")
+
+type UserEvent struct {
+	Type       string    `json:"type"`
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// UserEventConsumer читает события пользователя из auth-service. На account_deleted
+// отменяет ожидающие заказы аккаунта: выполнить их уже некому. Подтверждённые и
+// отменённые заказы остаются — user_id в них указывает на обезличенную запись users.
+type UserEventConsumer struct {
+	reader *kafka.Reader
+	orders service.OrderService
+	log    *zap.Logger
+}
+
+func NewUserEventConsumer(brokers []string, groupID, topic string, orders service.OrderService, log *zap.Logger) *UserEventConsumer {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:           brokers,
+		GroupID:           groupID,
+		Topic:             topic,
+		MinBytes:          1,
+		MaxBytes:          10e6,
+		HeartbeatInterval: 3 * time.Second,
+		SessionTimeout:    30 * time.Second,
+	})
+	return &UserEventConsumer{reader: r, orders: orders, log: log}
+}
+
+// Run коммитит смещение только после обработки: событие удаления нельзя потерять,
+// поэтому сбой БД повторяется с backoff, пока не пройдёт или не отменят ctx.
+func (c *UserEventConsumer) Run(ctx context.Context) error {
+	c.log.Info("kafka user events consumer started")
+	for {
+		m, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			c.log.Error("fetch message", zap.Error(err))
+			continue
+		}
+		if err := c.process(ctx, m); err != nil {
+			// ctx отменён: смещение не коммитим, событие перечитает следующий запуск
+			return nil
+		}
+		if err := c.reader.CommitMessages(ctx, m); err != nil && !errors.Is(err, context.Canceled) {
+			c.log.Error("commit message", zap.Error(err))
+		}
+	}
+}
+
+func (c *UserEventConsumer) process(ctx context.Context, m kafka.Message) error {
+	backoff := retryMinBackoff
+	for {
+		msgCtx, span := kafkatrace.StartConsume(ctx, m)
+		msgCtx = correlation.WithIDs(msgCtx, idsFromHeaders(m.Headers))
+		err := c.handle(msgCtx, m)
+		kafkatrace.End(span, err)
+		if err == nil || errors.Is(err, errInvalidMessage) {
+			return nil
+		}
+		correlation.Logger(msgCtx, c.log).Error("handle user event failed, retrying", zap.Duration("backoff", backoff), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, retryMaxBackoff)
+	}
+}
+
+func (c *UserEventConsumer) handle(ctx context.Context, m kafka.Message) error {
+	log := correlation.Logger(ctx, c.log)
+	var ev UserEvent
+	if err := json.Unmarshal(m.Value, &ev); err != nil {
+		log.Error("unmarshal user event", zap.ByteString("value", m.Value), zap.Error(err))
+		return errInvalidMessage
+	}
+	if ev.Type != UserEventAccountDeleted {
+		return nil
+	}
+	userID, err := uuid.Parse(ev.UserID)
+	if err != nil {
+		log.Warn("invalid user event", zap.String("type", ev.Type), zap.String("user_id", ev.UserID))
+		return errInvalidMessage
+	}
+	n, err := c.orders.CancelUserOrders(ctx, userID, CancelReasonAccountDeleted)
+	if err != nil {
+		return err
+	}
+	log.Info("orders of deleted account cancelled", zap.String("user_id", ev.UserID), zap.Int("cancelled", n))
+	return nil
+}
+
+// Lag — отставание consumer'а от конца партиции
+func (c *UserEventConsumer) Lag() int64 { return c.reader.Stats().Lag }
+
+func (c *UserEventConsumer) Close() error { return c.reader.Close() }
+
+// idsFromHeaders достаёт x-request-id и traceparent, которые auth-service кладёт в заголовки сообщения
+func idsFromHeaders(headers []kafka.Header) correlation.IDs {
+	var ids correlation.IDs
+	for _, h := range headers {
+		switch h.Key {
+		case correlation.KeyRequestID:
+			ids.RequestID = string(h.Value)
+		case correlation.KeyTraceparent:
+			ids.Traceparent = string(h.Value)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,29 @@
+// Package metrics — доменные метрики order-service; /metrics отдаёт листенер из pkg/metrics
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	OrdersCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_created_total",
+		Help: "Orders created.",
+	})
+
+	OrdersCancelled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_cancelled_total",
+		Help: "Orders cancelled.",
+	})
+)
+
+// RegisterConsumerLag публикует отставание consumer'а топика (сообщений до конца партиции);
+// lag вызывается при каждом scrape
+func RegisterConsumerLag(topic string, lag func() int64) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "kafka_consumer_lag",
+		Help:        "Messages between the consumer position and the end of the partition.",
+		ConstLabels: prometheus.Labels{"topic": topic},
+	}, func() float64 { return float64(lag()) }))
+}
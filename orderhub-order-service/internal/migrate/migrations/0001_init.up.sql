@@ -0,0 +1,83 @@
+-- Базовая схема order-service. Написана идемпотентно (IF NOT EXISTS), чтобы
+-- применяться и к базам, созданным прежним AutoMigrate.
+
+CREATE EXTENSION IF NOT EXISTS pgcrypto;
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS orders (
+  id                uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  user_id           uuid NOT NULL,
+  status            text NOT NULL DEFAULT 'ORDER_STATUS_PENDING',
+  total_price_cents bigint NOT NULL DEFAULT 0,
+  currency_code     char(3) NOT NULL,
+  cancel_reason     text,
+  created_at        timestamptz NOT NULL DEFAULT now(),
+  updated_at        timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders (user_id);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders (status);
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders (created_at);
+
+CREATE TABLE IF NOT EXISTS order_items (
+  id               uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  order_id         uuid NOT NULL,
+  product_id       uuid NOT NULL,
+  quantity         int NOT NULL,
+  unit_price_cents bigint NOT NULL,
+  line_total_cents bigint NOT NULL,
+  currency_code    char(3) NOT NULL,
+  created_at       timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_order_items_order_id ON order_items (order_id);
+
+-- updated_at
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger AS $$
+BEGIN NEW.updated_at = now(); RETURN NEW; END; $$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_orders_updated ON orders;
+CREATE TRIGGER trg_orders_updated
+BEFORE UPDATE ON orders
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- CHECK-и
+ALTER TABLE orders
+  DROP CONSTRAINT IF EXISTS chk_orders_status_allowed,
+  ADD CONSTRAINT chk_orders_status_allowed
+  CHECK (status IN ('ORDER_STATUS_PENDING','ORDER_STATUS_CONFIRMED','ORDER_STATUS_CANCELLED'));
+
+ALTER TABLE orders
+  DROP CONSTRAINT IF EXISTS chk_orders_currency_code_len,
+  ADD CONSTRAINT chk_orders_currency_code_len
+  CHECK (char_length(currency_code) = 3);
+
+ALTER TABLE order_items
+  DROP CONSTRAINT IF EXISTS chk_order_items_currency_code_len,
+  ADD CONSTRAINT chk_order_items_currency_code_len
+  CHECK (char_length(currency_code) = 3);
+
+ALTER TABLE order_items
+  DROP CONSTRAINT IF EXISTS chk_order_items_quantity_gt_zero,
+  ADD CONSTRAINT chk_order_items_quantity_gt_zero
+  CHECK (quantity > 0);
+
+ALTER TABLE order_items
+  DROP CONSTRAINT IF EXISTS chk_order_items_prices_non_negative,
+  ADD CONSTRAINT chk_order_items_prices_non_negative
+  CHECK (unit_price_cents >= 0 AND line_total_cents >= 0);
+
+ALTER TABLE orders
+  DROP CONSTRAINT IF EXISTS chk_orders_total_price_non_negative,
+  ADD CONSTRAINT chk_orders_total_price_non_negative
+  CHECK (total_price_cents >= 0);
+
+-- индексы
+CREATE UNIQUE INDEX IF NOT EXISTS ux_order_items_order_product ON order_items (order_id, product_id);
+CREATE INDEX IF NOT EXISTS ix_orders_user_created ON orders (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS ix_orders_status_created ON orders (status, created_at DESC);
+
+-- внешние ключи
+ALTER TABLE order_items
+  DROP CONSTRAINT IF EXISTS fk_order_items_order,
+  ADD CONSTRAINT fk_order_items_order
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE;
@@ -0,0 +1,19 @@
+-- События заказов для Kafka: пишутся в транзакции вместе с изменением заказа и публикуются
+-- фоновым relay в порядке seq
+CREATE TABLE IF NOT EXISTS order_event_outbox (
+  id          uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  seq         bigserial NOT NULL,
+  stream      text NOT NULL,
+  event_type  text NOT NULL,
+  order_id    uuid NOT NULL,
+  payload     jsonb NOT NULL,
+  request_id  text,
+  attempts    integer NOT NULL DEFAULT 0,
+  last_error  text,
+  sent_at     timestamptz,
+  created_at  timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS ix_order_event_outbox_pending
+  ON order_event_outbox (seq) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS ix_order_event_outbox_sent_at
+  ON order_event_outbox (sent_at) WHERE sent_at IS NOT NULL;
@@ -44,3 +44,27 @@ type OrderItem struct {
 }
 
 func (OrderItem) TableName() string { return "order_items" }
+
+// Потоки outbox: события Саги уходят в топик заказов, смены статуса — в топик, который читает gateway
+const (
+	OutboxStreamEvents = "events"
+	OutboxStreamStatus = "status"
+)
+
+// OrderEventOutbox — событие заказа, записанное в одной транзакции с изменением заказа.
+// В Kafka его отправляет relay; Payload — готовое значение сообщения.
+type OrderEventOutbox struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Seq       int64     `gorm:"->"` // bigserial: порядок публикации
+	Stream    string    `gorm:"type:text;not null"`
+	EventType string    `gorm:"type:text;not null"`
+	OrderID   uuid.UUID `gorm:"type:uuid;not null"` // ключ сообщения: события заказа идут в одну партицию
+	Payload   string    `gorm:"type:jsonb;not null"`
+	RequestID *string   `gorm:"type:text"` // X-Request-ID запроса, породившего событие
+	Attempts  int       `gorm:"not null;default:0"`
+	LastError *string   `gorm:"type:text"`
+	SentAt    *time.Time
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (OrderEventOutbox) TableName() string { return "order_event_outbox" }
@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"context"
+	"order-service/internal/models"
+	"order-service/internal/repository"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInterval  = 5 * time.Second
+	defaultBatchSize = 100
+	// отправленные события хранятся сутки — для разбора инцидентов
+	sentRetention = 24 * time.Hour
+	pruneInterval = time.Hour
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, e models.OrderEventOutbox) error
+}
+
+// Relay публикует события заказов из outbox. Сервис будит его через Notify после коммита,
+// опрос раз в interval подбирает остальное (рестарт, сбой Kafka). Outbox разбирает одна
+// реплика за раз, события уходят в порядке записи: на ошибке пачка прерывается и
+// повторяется со следующего опроса. Доставка «хотя бы один раз» — если событие ушло в
+// Kafka, а отметка не записалась, оно будет отправлено повторно.
+type Relay struct {
+	store     repository.OrderEventOutboxRepo
+	publisher Publisher
+	log       *zap.Logger
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+	wake      chan struct{}
+	stopCh    chan struct{}
+	done      chan struct{}
+}
+
+func NewRelay(store repository.OrderEventOutboxRepo, publisher Publisher, log *zap.Logger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		log:       log,
+		interval:  defaultInterval,
+		batchSize: defaultBatchSize,
+		now:       time.Now,
+		wake:      make(chan struct{}, 1),
+		stopCh:    make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Notify будит relay; не блокирует, повторные вызовы до пробуждения схлопываются
+func (r *Relay) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start запускает публикацию в фоне
+func (r *Relay) Start(ctx context.Context) {
+	r.log.Info("starting order event outbox relay")
+	go r.run(ctx)
+}
+
+// Stop останавливает relay: дожидается текущей пачки и последним проходом отправляет то,
+// что успели записать до остановки
+func (r *Relay) Stop() {
+	r.log.Info("stopping order event outbox relay")
+	close(r.stopCh)
+	<-r.done
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	var lastPrune time.Time
+
+	for {
+		sent, err := r.RunOnce(ctx)
+		if err != nil {
+			r.log.Error("order event outbox relay failed", zap.Error(err))
+		}
+		if now := r.now(); now.Sub(lastPrune) >= pruneInterval {
+			lastPrune = now
+			if _, err := r.store.DeleteSent(ctx, now.Add(-sentRetention)); err != nil {
+				r.log.Warn("failed to prune order event outbox", zap.Error(err))
+			}
+		}
+		// полная пачка — в outbox, скорее всего, есть ещё
+		if err == nil && sent == r.batchSize {
+			continue
+		}
+		select {
+		case <-ticker.C:
+		case <-r.wake:
+		case <-r.stopCh:
+			if _, err := r.RunOnce(ctx); err != nil {
+				r.log.Error("order event outbox relay failed", zap.Error(err))
+			}
+			r.log.Info("order event outbox relay stopped")
+			return
+		case <-ctx.Done():
+			r.log.Info("order event outbox relay cancelled")
+			return
+		}
+	}
+}
+
+// RunOnce публикует одну пачку событий и возвращает число отправленных. Если outbox
+// разбирает другая реплика, ничего не делает.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	sent := 0
+	var publishErr error
+	_, err := r.store.Exclusive(ctx, func(tx repository.OrderEventOutboxRepo) error {
+		events, err := tx.ListPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			pubCtx := ctx
+			if e.RequestID != nil {
+				pubCtx = correlation.WithIDs(ctx, correlation.IDs{RequestID: *e.RequestID})
+			}
+			if err := r.publisher.Publish(pubCtx, e); err != nil {
+				// следующие события ждут этого: порядок событий заказа важнее
+				r.log.Warn("failed to publish order event, will retry",
+					zap.String("event_id", e.ID.String()),
+					zap.String("type", e.EventType),
+					zap.Int("attempt", e.Attempts+1),
+					zap.Error(err))
+				publishErr = err
+				return tx.MarkFailed(ctx, e.ID, err.Error())
+			}
+			if err := tx.MarkSent(ctx, e.ID, r.now()); err != nil {
+				return err
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, publishErr
+}
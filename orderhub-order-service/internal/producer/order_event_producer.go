@@ -0,0 +1,78 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"order-service/internal/models"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry/kafkatrace"
+	"github.com/segmentio/kafka-go"
+)
+
+// OrderEventProducer отправляет события из outbox: события Саги — в топик заказов,
+// смены статуса — в отдельный топик, который читает gateway.
+type OrderEventProducer struct {
+	events *kafka.Writer
+	status *kafka.Writer
+}
+
+func NewOrderEventProducer(brokers []string, eventsTopic, statusTopic string) *OrderEventProducer {
+	return &OrderEventProducer{
+		events: newWriter(brokers, eventsTopic),
+		status: newWriter(brokers, statusTopic),
+	}
+}
+
+func newWriter(brokers []string, topic string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{}, // события одного заказа — в одну партицию, порядок сохраняется
+		RequiredAcks: kafka.RequireAll,
+		// relay пишет по одному сообщению и ждёт подтверждения; пачку по умолчанию (1 с) не копим
+		BatchTimeout: 10 * time.Millisecond,
+	}
+}
+
+// Publish отправляет событие outbox в топик его потока
+func (p *OrderEventProducer) Publish(ctx context.Context, e models.OrderEventOutbox) error {
+	switch e.Stream {
+	case models.OutboxStreamEvents:
+		return publish(ctx, p.events, e.OrderID.String(), []byte(e.Payload))
+	case models.OutboxStreamStatus:
+		return publish(ctx, p.status, e.OrderID.String(), []byte(e.Payload))
+	default:
+		return fmt.Errorf("unknown outbox stream %q", e.Stream)
+	}
+}
+
+func (p *OrderEventProducer) Close() error {
+	err := p.events.Close()
+	if serr := p.status.Close(); err == nil {
+		err = serr
+	}
+	return err
+}
+
+func publish(ctx context.Context, w *kafka.Writer, key string, value []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	msg := kafka.Message{Key: []byte(key), Value: value, Headers: correlationHeaders(ctx)}
+	ctx, span := kafkatrace.StartProduce(ctx, w.Topic, &msg)
+	err := w.WriteMessages(ctx, msg)
+	kafkatrace.End(span, err)
+	return err
+}
+
+// correlationHeaders передаёт x-request-id (и traceparent, если трассы в контексте нет)
+// потребителям
+func correlationHeaders(ctx context.Context) []kafka.Header {
+	kv := correlation.Outgoing(ctx)
+	headers := make([]kafka.Header, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		headers = append(headers, kafka.Header{Key: kv[i], Value: []byte(kv[i+1])})
+	}
+	return headers
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"order-service/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// outboxLockKey — ключ advisory-блокировки, под которой relay разбирает outbox
+const outboxLockKey int64 = 0x6f72646572 // "order"
+
+type OrderEventOutboxRepo interface {
+	Add(ctx context.Context, events ...*models.OrderEventOutbox) error
+	// ListPending возвращает неотправленные события в порядке записи
+	ListPending(ctx context.Context, limit int) ([]models.OrderEventOutbox, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string) error
+	// DeleteSent удаляет события, отправленные до before
+	DeleteSent(ctx context.Context, before time.Time) (int64, error)
+	// Exclusive выполняет fn в транзакции под advisory-блокировкой: outbox в каждый момент
+	// разбирает одна реплика, и события уходят в порядке записи. false — блокировку держит
+	// другая реплика, fn не вызывается.
+	Exclusive(ctx context.Context, fn func(tx OrderEventOutboxRepo) error) (bool, error)
+}
+
+type orderEventOutboxRepo struct{ db *gorm.DB }
+
+func NewOrderEventOutboxRepo(db *gorm.DB) OrderEventOutboxRepo {
+	return &orderEventOutboxRepo{db: db}
+}
+
+func (r *orderEventOutboxRepo) Add(ctx context.Context, events ...*models.OrderEventOutbox) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(events).Error
+}
+
+func (r *orderEventOutboxRepo) ListPending(ctx context.Context, limit int) ([]models.OrderEventOutbox, error) {
+	var events []models.OrderEventOutbox
+	err := r.db.WithContext(ctx).
+		Where("sent_at IS NULL").
+		Order("seq ASC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *orderEventOutboxRepo) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.OrderEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"sent_at": at, "last_error": nil}).Error
+}
+
+func (r *orderEventOutboxRepo) MarkFailed(ctx context.Context, id uuid.UUID, reason string) error {
+	return r.db.WithContext(ctx).Model(&models.OrderEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason,
+		}).Error
+}
+
+func (r *orderEventOutboxRepo) DeleteSent(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Where("sent_at IS NOT NULL AND sent_at < ?", before).
+		Delete(&models.OrderEventOutbox{})
+	return res.RowsAffected, res.Error
+}
+
+func (r *orderEventOutboxRepo) Exclusive(ctx context.Context, fn func(tx OrderEventOutboxRepo) error) (bool, error) {
+	locked := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", outboxLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return fn(&orderEventOutboxRepo{db: tx})
+	})
+	return locked, err
+}
@@ -25,7 +25,8 @@ type OrderRepo interface {
 	List(ctx context.Context, f OrderListFilter) ([]*models.Order, int64, error)
 	Exists(ctx context.Context, id uuid.UUID) (bool, error)
 
-	WithTx(ctx context.Context, fn func(txRepo OrderRepo, txItems OrderItemRepo) error) error
+	// WithTx выполняет fn в транзакции; события заказа пишутся в txOutbox в ней же
+	WithTx(ctx context.Context, fn func(txRepo OrderRepo, txItems OrderItemRepo, txOutbox OrderEventOutboxRepo) error) error
 }
 
 type orderRepo struct{ db *gorm.DB }
@@ -104,8 +105,8 @@ func (r *orderRepo) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	return cnt > 0, err
 }
 
-func (r *orderRepo) WithTx(ctx context.Context, fn func(txRepo OrderRepo, txItems OrderItemRepo) error) error {
+func (r *orderRepo) WithTx(ctx context.Context, fn func(txRepo OrderRepo, txItems OrderItemRepo, txOutbox OrderEventOutboxRepo) error) error {
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		return fn(&orderRepo{db: tx}, &orderItemRepo{db: tx})
+		return fn(&orderRepo{db: tx}, &orderItemRepo{db: tx}, &orderEventOutboxRepo{db: tx})
 	})
 }
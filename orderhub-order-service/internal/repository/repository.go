@@ -3,16 +3,18 @@ package repository
 import "gorm.io/gorm"
 
 type Repository struct {
-	DB         *gorm.DB
-	Orders     OrderRepo
-	OrderItems OrderItemRepo
+	DB          *gorm.DB
+	Orders      OrderRepo
+	OrderItems  OrderItemRepo
+	OrderEvents OrderEventOutboxRepo
 }
 
 func buildRepository(db *gorm.DB) *Repository {
 	return &Repository{
-		DB:         db,
-		Orders:     NewOrderRepo(db),
-		OrderItems: NewOrderItemRepo(db),
+		DB:          db,
+		Orders:      NewOrderRepo(db),
+		OrderItems:  NewOrderItemRepo(db),
+		OrderEvents: NewOrderEventOutboxRepo(db),
 	}
 }
 
@@ -1,10 +1,14 @@
 package service
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+)
 
 var (
 	ErrUnauthorized     = errors.New("unauthorized")
-	ErrForbidden        = errors.New("forbidden")
+	ErrForbidden        = authz.ErrForbidden
 	ErrOrderNotFound    = errors.New("order not found")
 	ErrProductNotFound  = errors.New("product not found or inactive")
 	ErrEmptyItems       = errors.New("empty items")
@@ -1,12 +1,24 @@
 package service
 
 import (
-	"context"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Типы событий заказа
+const (
+	OrderEventCreated       = "order_created"
+	OrderEventCancelled     = "order_cancelled"
+	OrderEventStatusChanged = "order_status_changed"
+)
+
+// OrderEvent — конверт события в топике заказов. Смены статуса уходят в свой топик без конверта.
+type OrderEvent struct {
+	Type string `json:"type"`
+	Data any    `json:"data"`
+}
+
 type OrderItemEvent struct {
 	ProductID  uuid.UUID `json:"product_id"`
 	Quantity   uint32    `json:"quantity"`
@@ -31,7 +43,19 @@ type OrderCancelledEvent struct {
 	CancelledAt time.Time `json:"cancelled_at"`
 }
 
-type EventBus interface {
-	PublishOrderCreated(ctx context.Context, e OrderCreatedEvent) error
-	PublishOrderCancelled(ctx context.Context, e OrderCancelledEvent) error
+// OrderStatusChangedEvent — смена статуса заказа; по нему gateway стримит статусы клиентам
+type OrderStatusChangedEvent struct {
+	OrderID        uuid.UUID `json:"order_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	Reason         string    `json:"reason,omitempty"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+// EventNotifier — relay outbox'а. События пишутся в outbox в транзакции изменения заказа,
+// а в Kafka их отправляет relay; Notify после коммита будит его, чтобы событие не ждало
+// очередного опроса.
+type EventNotifier interface {
+	Notify()
 }
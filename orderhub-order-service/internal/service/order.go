@@ -30,4 +30,7 @@ type OrderService interface {
 	GetOrder(ctx context.Context, id uuid.UUID) (*models.Order, error)
 	ListOrders(ctx context.Context, f ListFilter) ([]models.Order, int64, error)
 	CancelOrder(ctx context.Context, id uuid.UUID, reason *string) (*models.Order, error)
+	// CancelUserOrders отменяет ожидающие заказы пользователя без проверки прав вызывающего:
+	// вызывается consumer'ом событий auth-service, а не из gRPC.
+	CancelUserOrders(ctx context.Context, userID uuid.UUID, reason string) (int, error)
 }
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"order-service/internal/metrics"
 	"order-service/internal/models"
 	"order-service/internal/repository"
 	"time"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
 	"github.com/google/uuid"
 )
 
@@ -14,11 +18,11 @@ const currencyRUB = "RUB"
 type orderService struct {
 	repo    *repository.Repository
 	pricing PricingProvider
-	events  EventBus
+	events  EventNotifier // nil — публикация отключена (нет KAFKA_BROKERS), outbox не пишется
 	now     func() time.Time
 }
 
-func NewOrderService(repo *repository.Repository, pricing PricingProvider, events EventBus) OrderService {
+func NewOrderService(repo *repository.Repository, pricing PricingProvider, events EventNotifier) OrderService {
 	return &orderService{
 		repo:    repo,
 		pricing: pricing,
@@ -27,22 +31,24 @@ func NewOrderService(repo *repository.Repository, pricing PricingProvider, event
 	}
 }
 
-func requireAuth(ctx context.Context) (uuid.UUID, Role, error) {
+func requireAuth(ctx context.Context) (uuid.UUID, error) {
 	uid, ok := UserIDFromContext(ctx)
 	if !ok {
-		return uuid.Nil, "", ErrUnauthorized
+		return uuid.Nil, ErrUnauthorized
 	}
-	role, _ := RoleFromContext(ctx) // если нет — считаем customer по умолчанию
-	return uid, role, nil
+	return uid, nil
 }
 
 func toOrderStatus(s models.OrderStatus) string { return string(s) }
 
 func (s *orderService) CreateOrder(ctx context.Context, in CreateOrderInput) (*models.Order, error) {
-	userID, _, err := requireAuth(ctx)
+	userID, err := requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if err := authz.Require(ctx, authz.PermOrderCreate); err != nil {
+		return nil, err
+	}
 
 	if len(in.Items) == 0 {
 		return nil, ErrEmptyItems
@@ -68,7 +74,7 @@ func (s *orderService) CreateOrder(ctx context.Context, in CreateOrderInput) (*m
 		return nil, err
 	}
 
-	err = s.repo.Orders.WithTx(ctx, func(or repository.OrderRepo, ir repository.OrderItemRepo) error {
+	err = s.repo.Orders.WithTx(ctx, func(or repository.OrderRepo, ir repository.OrderItemRepo, ob repository.OrderEventOutboxRepo) error {
 		for _, it := range in.Items {
 			if it.Quantity == 0 {
 				return ErrQuantityInvalid
@@ -106,7 +112,7 @@ func (s *orderService) CreateOrder(ctx context.Context, in CreateOrderInput) (*m
 			UpdatedAt:       now,
 		}
 
-		if err := s.repo.Orders.Create(ctx, order); err != nil {
+		if err := or.Create(ctx, order); err != nil {
 			return err
 		}
 
@@ -128,14 +134,9 @@ func (s *orderService) CreateOrder(ctx context.Context, in CreateOrderInput) (*m
 		}
 		order = ordWith
 
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if s.events != nil {
+		if s.events == nil {
+			return nil
+		}
 		evItems := make([]OrderItemEvent, 0, len(itemsDB))
 		for _, it := range itemsDB {
 			evItems = append(evItems, OrderItemEvent{
@@ -146,28 +147,41 @@ func (s *orderService) CreateOrder(ctx context.Context, in CreateOrderInput) (*m
 				LineTotal:  it.LineTotalCents,
 			})
 		}
-		_ = s.events.PublishOrderCreated(ctx, OrderCreatedEvent{
-			OrderID:    order.ID,
-			UserID:     order.UserID,
-			Items:      evItems,
-			TotalCents: order.TotalPriceCents,
-			Currency:   order.CurrencyCode,
-			CreatedAt:  order.CreatedAt,
-		})
+		return s.enqueue(ctx, ob,
+			orderEvent(order.ID, OrderEventCreated, OrderCreatedEvent{
+				OrderID:    order.ID,
+				UserID:     order.UserID,
+				Items:      evItems,
+				TotalCents: order.TotalPriceCents,
+				Currency:   order.CurrencyCode,
+				CreatedAt:  order.CreatedAt,
+			}),
+			statusEvent(OrderStatusChangedEvent{
+				OrderID:   order.ID,
+				UserID:    order.UserID,
+				Status:    toOrderStatus(order.Status),
+				ChangedAt: order.CreatedAt,
+			}),
+		)
+	})
+
+	if err != nil {
+		return nil, err
 	}
+	s.notify()
+	metrics.OrdersCreated.Inc()
 
 	return order, nil
 }
 
 func (s *orderService) GetOrder(ctx context.Context, id uuid.UUID) (*models.Order, error) {
-	userID, role, err := requireAuth(ctx)
+	userID, err := requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
-	isAdmin := role == RoleAdmin
 
 	var ord *models.Order
-	if isAdmin {
+	if authz.Has(ctx, authz.PermOrderReadAny) {
 		ord, err = s.repo.Orders.GetByID(ctx, id)
 	} else {
 		ord, err = s.repo.Orders.GetByIDForUser(ctx, id, userID)
@@ -182,13 +196,12 @@ func (s *orderService) GetOrder(ctx context.Context, id uuid.UUID) (*models.Orde
 }
 
 func (s *orderService) ListOrders(ctx context.Context, f ListFilter) ([]models.Order, int64, error) {
-	userID, role, err := requireAuth(ctx)
+	userID, err := requireAuth(ctx)
 	if err != nil {
 		return nil, 0, err
 	}
-	isAdmin := role == RoleAdmin
 
-	if !isAdmin {
+	if !authz.Has(ctx, authz.PermOrderReadAny) {
 		f.UserID = &userID
 	}
 	if f.Limit <= 0 {
@@ -216,11 +229,10 @@ func (s *orderService) ListOrders(ctx context.Context, f ListFilter) ([]models.O
 }
 
 func (s *orderService) CancelOrder(ctx context.Context, id uuid.UUID, reason *string) (*models.Order, error) {
-	userID, role, err := requireAuth(ctx)
+	userID, err := requireAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
-	isAdmin := role == RoleAdmin
 
 	ord, err := s.repo.Orders.GetByID(ctx, id)
 	if err != nil {
@@ -229,7 +241,7 @@ func (s *orderService) CancelOrder(ctx context.Context, id uuid.UUID, reason *st
 	if ord == nil {
 		return nil, ErrOrderNotFound
 	}
-	if !isAdmin && ord.UserID != userID {
+	if !authz.Has(ctx, authz.PermOrderCancelAny) && ord.UserID != userID {
 		return nil, ErrForbidden
 	}
 	switch ord.Status {
@@ -240,27 +252,122 @@ func (s *orderService) CancelOrder(ctx context.Context, id uuid.UUID, reason *st
 	default:
 		// pending — ок
 	}
+	return s.cancel(ctx, ord, reason)
+}
 
-	// меняем статус
-	if err := s.repo.Orders.UpdateStatus(ctx, id, models.OrderStatusCancelled, reason); err != nil {
-		return nil, err
+// userOrdersBatch — сколько заказов CancelUserOrders отменяет за один проход
+const userOrdersBatch = 100
+
+func (s *orderService) CancelUserOrders(ctx context.Context, userID uuid.UUID, reason string) (int, error) {
+	pending := models.OrderStatusPending
+	cancelled := 0
+	for {
+		// отменённые выпадают из выборки, поэтому всегда первая страница
+		batch, _, err := s.repo.Orders.List(ctx, repository.OrderListFilter{UserID: &userID, Status: &pending, Limit: userOrdersBatch})
+		if err != nil {
+			return cancelled, err
+		}
+		if len(batch) == 0 {
+			return cancelled, nil
+		}
+		for _, ord := range batch {
+			if _, err := s.cancel(ctx, ord, &reason); err != nil {
+				return cancelled, err
+			}
+			cancelled++
+		}
 	}
-	ord, err = s.repo.Orders.GetByID(ctx, id)
+}
+
+// cancel переводит заказ в CANCELLED и записывает события в outbox; права проверяет вызывающий
+func (s *orderService) cancel(ctx context.Context, ord *models.Order, reason *string) (*models.Order, error) {
+	id, prevStatus := ord.ID, ord.Status
+
+	err := s.repo.Orders.WithTx(ctx, func(or repository.OrderRepo, _ repository.OrderItemRepo, ob repository.OrderEventOutboxRepo) error {
+		if err := or.UpdateStatus(ctx, id, models.OrderStatusCancelled, reason); err != nil {
+			return err
+		}
+		updated, err := or.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ord = updated
+
+		if s.events == nil {
+			return nil
+		}
+		// событие компенсации и смена статуса для подписчиков
+		cancelledAt := s.now()
+		return s.enqueue(ctx, ob,
+			orderEvent(ord.ID, OrderEventCancelled, OrderCancelledEvent{
+				OrderID:     ord.ID,
+				UserID:      ord.UserID,
+				Reason:      s.sanitizeReason(reason),
+				CancelledAt: cancelledAt,
+			}),
+			statusEvent(OrderStatusChangedEvent{
+				OrderID:        ord.ID,
+				UserID:         ord.UserID,
+				Status:         toOrderStatus(ord.Status),
+				PreviousStatus: toOrderStatus(prevStatus),
+				Reason:         s.sanitizeReason(reason),
+				ChangedAt:      cancelledAt,
+			}),
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.notify()
+	metrics.OrdersCancelled.Inc()
 
-	// событие компенсации
-	if s.events != nil {
-		_ = s.events.PublishOrderCancelled(ctx, OrderCancelledEvent{
-			OrderID:     ord.ID,
-			UserID:      ord.UserID,
-			Reason:      s.sanitizeReason(reason),
-			CancelledAt: s.now(),
+	return ord, nil
+}
+
+// outboxEvent — событие до сериализации
+type outboxEvent struct {
+	stream    string
+	eventType string
+	orderID   uuid.UUID
+	value     any
+}
+
+func orderEvent(orderID uuid.UUID, eventType string, data any) outboxEvent {
+	return outboxEvent{stream: models.OutboxStreamEvents, eventType: eventType, orderID: orderID, value: OrderEvent{Type: eventType, Data: data}}
+}
+
+func statusEvent(e OrderStatusChangedEvent) outboxEvent {
+	return outboxEvent{stream: models.OutboxStreamStatus, eventType: OrderEventStatusChanged, orderID: e.OrderID, value: e}
+}
+
+// enqueue пишет события в outbox транзакции; X-Request-ID запроса relay передаст в заголовке
+func (s *orderService) enqueue(ctx context.Context, ob repository.OrderEventOutboxRepo, events ...outboxEvent) error {
+	var requestID *string
+	if id := correlation.FromContext(ctx).RequestID; id != "" {
+		requestID = &id
+	}
+	rows := make([]*models.OrderEventOutbox, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e.value)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, &models.OrderEventOutbox{
+			Stream:    e.stream,
+			EventType: e.eventType,
+			OrderID:   e.orderID,
+			Payload:   string(payload),
+			RequestID: requestID,
 		})
 	}
+	return ob.Add(ctx, rows...)
+}
 
-	return ord, nil
+// notify будит relay после коммита
+func (s *orderService) notify() {
+	if s.events != nil {
+		s.events.Notify()
+	}
 }
 
 func (s *orderService) sanitizeReason(reason *string) string {
@@ -260,10 +260,10 @@ func toStatusErr(err error) error {
 	case errors.Is(err, service.ErrEmptyItems),
 		errors.Is(err, service.ErrQuantityInvalid),
 		errors.Is(err, service.ErrCurrencyMismatch):
-		return status.Errorf(codes.InvalidArgument, err.Error())
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, service.ErrAlreadyCancelled),
 		errors.Is(err, service.ErrAlreadyConfirmed):
-		return status.Errorf(codes.FailedPrecondition, err.Error())
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Errorf(codes.Internal, "internal: %v", err)
 	}
@@ -2,30 +2,37 @@ package grpc
 
 import (
 	"context"
+	"net"
 	"strings"
+	"time"
 
 	"order-service/internal/service"
 
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/apikey"
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/authz"
 	authv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/auth/v1"
 	commonv1 "github.com/Anabol1ks/orderhub-pkg-proto/proto/common/v1"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 // AuthClient is a minimal interface of AuthService gRPC client we need for introspection.
 type AuthClient interface {
 	Introspect(ctx context.Context, in *authv1.IntrospectRequest, opts ...grpc.CallOption) (*authv1.IntrospectResponse, error)
+	ResolveApiKey(ctx context.Context, in *authv1.ResolveApiKeyRequest, opts ...grpc.CallOption) (*authv1.ResolveApiKeyResponse, error)
 }
 
 // NewAuthUnaryServerInterceptor returns a unary interceptor that:
 // - allows public methods (health)
-// - extracts Bearer token from metadata Authorization
-// - calls AuthService.Introspect to validate token
-// - injects user id and role into context for downstream handlers
+// - extracts Bearer token or ApiKey from metadata Authorization
+// - calls AuthService.Introspect / ResolveApiKey to validate it (API keys are cached for apikey.DefaultCacheTTL)
+// - injects user id, role and the impersonating admin (actor) into context for downstream handlers
 func NewAuthUnaryServerInterceptor(client AuthClient) grpc.UnaryServerInterceptor {
+	keyCache := apikey.NewCache(apikey.DefaultCacheTTL)
 	public := map[string]struct{}{
 		"/grpc.health.v1.Health/Check":                                   {},
 		"/grpc.health.v1.Health/Watch":                                   {},
@@ -42,23 +49,35 @@ func NewAuthUnaryServerInterceptor(client AuthClient) grpc.UnaryServerIntercepto
 		if !ok {
 			return nil, status.Errorf(codes.Unauthenticated, "missing metadata (method=%s)", info.FullMethod)
 		}
-		authz := getFirst(md, "authorization")
-		if authz == "" {
+		header := getFirst(md, "authorization")
+		if header == "" {
 			return nil, status.Errorf(codes.Unauthenticated, "authorization header not found (method=%s)", info.FullMethod)
 		}
-		prefix := "bearer "
-		if len(authz) < len(prefix) || !strings.EqualFold(authz[:len(prefix)], prefix) {
+		scheme, access, ok := apikey.ParseAuthorization(header)
+		if !ok {
 			return nil, status.Error(codes.Unauthenticated, "invalid authorization scheme")
 		}
-		access := strings.TrimSpace(authz[len(prefix):])
-		if access == "" {
-			return nil, status.Error(codes.Unauthenticated, "empty bearer token")
+		if scheme == apikey.SchemeAPIKey {
+			id, err := resolveAPIKey(ctx, client, keyCache, access)
+			if err != nil {
+				return nil, err
+			}
+			uid, err := uuid.Parse(id.UserID)
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, "invalid user id")
+			}
+			ctx = service.WithUserID(ctx, uid)
+			if id.Role != "" {
+				ctx = service.WithRole(ctx, service.Role(id.Role))
+			}
+			ctx = authz.WithPermissions(ctx, id.Scopes)
+			return handler(ctx, req)
 		}
 
 		// Validate via Auth service
 		resp, err := client.Introspect(ctx, &authv1.IntrospectRequest{AccessToken: access})
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "introspection failed: %v", err)
+			return nil, authCallErr("introspection failed", err)
 		}
 		if resp == nil || !resp.GetActive() || resp.GetUserId() == nil || resp.GetUserId().GetValue() == "" {
 			return nil, status.Error(codes.Unauthenticated, "invalid or inactive token")
@@ -73,10 +92,70 @@ func NewAuthUnaryServerInterceptor(client AuthClient) grpc.UnaryServerIntercepto
 		if role := resp.GetRole(); role != commonv1.Role_ROLE_UNSPECIFIED {
 			ctx = service.WithRole(ctx, service.Role(role.String()))
 		}
+		ctx = authz.WithPermissions(ctx, resp.GetScopes())
+		if actor := resp.GetActorId().GetValue(); actor != "" {
+			// токен выдан администратору через Impersonate
+			ctx = authz.WithActor(ctx, actor)
+		}
 		return handler(ctx, req)
 	}
 }
 
+// authCallErr: недоступность auth-service (в том числе разомкнутый breaker) отдаётся как
+// Unavailable, чтобы клиент повторил запрос, а не считал свой токен недействительным
+func authCallErr(op string, err error) error {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return status.Errorf(codes.Unavailable, "%s: auth service unavailable", op)
+	default:
+		return status.Errorf(codes.Unauthenticated, "%s: %v", op, err)
+	}
+}
+
+// resolveAPIKey validates an API key via AuthService.ResolveApiKey; successful results are cached briefly.
+func resolveAPIKey(ctx context.Context, client AuthClient, cache *apikey.Cache, key string) (apikey.Identity, error) {
+	if id, ok := cache.Get(key); ok {
+		return id, nil
+	}
+	resp, err := client.ResolveApiKey(ctx, &authv1.ResolveApiKeyRequest{Key: key, Ip: clientIP(ctx)})
+	if err != nil {
+		return apikey.Identity{}, authCallErr("api key resolution failed", err)
+	}
+	if resp == nil || !resp.GetActive() || resp.GetUserId().GetValue() == "" {
+		return apikey.Identity{}, status.Error(codes.Unauthenticated, "invalid or inactive api key")
+	}
+	id := apikey.Identity{
+		KeyID:  resp.GetKeyId().GetValue(),
+		UserID: resp.GetUserId().GetValue(),
+		Scopes: resp.GetScopes(),
+	}
+	if role := resp.GetRole(); role != commonv1.Role_ROLE_UNSPECIFIED {
+		id.Role = role.String()
+	}
+	if resp.GetExpUnix() > 0 {
+		id.ExpiresAt = time.Unix(resp.GetExpUnix(), 0)
+	}
+	cache.Set(key, id)
+	return id, nil
+}
+
+func clientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("x-forwarded-for"); len(vals) > 0 {
+			if ip := strings.TrimSpace(strings.Split(vals[0], ",")[0]); ip != "" {
+				return ip
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
 func getFirst(md metadata.MD, key string) string {
 	vals := md.Get(key)
 	if len(vals) > 0 {
@@ -0,0 +1,220 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"order-service/internal/models"
+	"order-service/internal/outbox"
+	"order-service/internal/repository"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// memStore — outbox в памяти
+type memStore struct {
+	mu     sync.Mutex
+	events []models.OrderEventOutbox
+	locked bool // блокировку держит другая реплика
+}
+
+func (s *memStore) Add(ctx context.Context, events ...*models.OrderEventOutbox) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range events {
+		e.ID = uuid.New()
+		e.Seq = int64(len(s.events) + 1)
+		s.events = append(s.events, *e)
+	}
+	return nil
+}
+
+func (s *memStore) ListPending(ctx context.Context, limit int) ([]models.OrderEventOutbox, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []models.OrderEventOutbox
+	for _, e := range s.events {
+		if e.SentAt == nil && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (s *memStore) find(id uuid.UUID) *models.OrderEventOutbox {
+	for i := range s.events {
+		if s.events[i].ID == id {
+			return &s.events[i]
+		}
+	}
+	return nil
+}
+
+func (s *memStore) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.find(id).SentAt = &at
+	return nil
+}
+
+func (s *memStore) MarkFailed(ctx context.Context, id uuid.UUID, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.find(id)
+	e.Attempts++
+	e.LastError = &reason
+	return nil
+}
+
+func (s *memStore) DeleteSent(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (s *memStore) sent() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, e := range s.events {
+		if e.SentAt != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *memStore) Exclusive(ctx context.Context, fn func(tx repository.OrderEventOutboxRepo) error) (bool, error) {
+	if s.locked {
+		return false, nil
+	}
+	return true, fn(s)
+}
+
+// flakyPublisher отказывает первые failures вызовов
+type flakyPublisher struct {
+	failures   int
+	published  []models.OrderEventOutbox
+	requestIDs []string
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, e models.OrderEventOutbox) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("kafka unavailable")
+	}
+	p.published = append(p.published, e)
+	p.requestIDs = append(p.requestIDs, correlation.FromContext(ctx).RequestID)
+	return nil
+}
+
+func addEvents(t *testing.T, store *memStore, orderID uuid.UUID, types ...string) {
+	t.Helper()
+	for _, typ := range types {
+		stream := models.OutboxStreamEvents
+		if typ == "order_status_changed" {
+			stream = models.OutboxStreamStatus
+		}
+		if err := store.Add(context.Background(), &models.OrderEventOutbox{Stream: stream, EventType: typ, OrderID: orderID, Payload: `{}`}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRelay_RunOnce_PublishesInOrder(t *testing.T) {
+	store := &memStore{}
+	orderID := uuid.New()
+	addEvents(t, store, orderID, "order_created", "order_status_changed", "order_cancelled")
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	sent, err := relay.RunOnce(context.Background())
+	if err != nil || sent != 3 {
+		t.Fatalf("Expected 3 sent, got %d err=%v", sent, err)
+	}
+	for i, want := range []string{"order_created", "order_status_changed", "order_cancelled"} {
+		if pub.published[i].EventType != want || pub.published[i].OrderID != orderID {
+			t.Fatalf("Unexpected publish order: %+v", pub.published)
+		}
+	}
+
+	// повторный проход ничего не отправляет
+	if sent, _ := relay.RunOnce(context.Background()); sent != 0 {
+		t.Errorf("Expected nothing to send, got %d", sent)
+	}
+}
+
+func TestRelay_RunOnce_StopsOnFailureToKeepOrder(t *testing.T) {
+	store := &memStore{}
+	addEvents(t, store, uuid.New(), "order_created", "order_status_changed")
+	pub := &flakyPublisher{failures: 1}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	sent, err := relay.RunOnce(context.Background())
+	if err == nil || sent != 0 {
+		t.Fatalf("Expected publish error and nothing sent, got %d err=%v", sent, err)
+	}
+	if len(pub.published) != 0 {
+		t.Fatalf("Later events must wait for the failed one, published %+v", pub.published)
+	}
+	if store.events[0].Attempts != 1 || store.events[0].LastError == nil {
+		t.Errorf("Expected failed attempt to be recorded: %+v", store.events[0])
+	}
+
+	sent, err = relay.RunOnce(context.Background())
+	if err != nil || sent != 2 || pub.published[0].EventType != "order_created" {
+		t.Fatalf("Expected both events on retry in order, got %d err=%v published=%+v", sent, err, pub.published)
+	}
+}
+
+func TestRelay_RunOnce_SkipsWhenAnotherReplicaHoldsTheLock(t *testing.T) {
+	store := &memStore{locked: true}
+	addEvents(t, store, uuid.New(), "order_created")
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	if sent, err := relay.RunOnce(context.Background()); err != nil || sent != 0 || len(pub.published) != 0 {
+		t.Fatalf("Expected nothing to be published without the lock, got %d err=%v", sent, err)
+	}
+}
+
+func TestRelay_RunOnce_PropagatesRequestID(t *testing.T) {
+	store := &memStore{}
+	requestID := "req-42"
+	if err := store.Add(context.Background(), &models.OrderEventOutbox{Stream: models.OutboxStreamStatus, EventType: "order_status_changed", OrderID: uuid.New(), Payload: `{}`, RequestID: &requestID}); err != nil {
+		t.Fatal(err)
+	}
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+
+	if _, err := relay.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+	if len(pub.requestIDs) != 1 || pub.requestIDs[0] != requestID {
+		t.Errorf("Expected request id %q in publish context, got %v", requestID, pub.requestIDs)
+	}
+}
+
+func TestRelay_NotifyWakesRelay(t *testing.T) {
+	store := &memStore{}
+	pub := &flakyPublisher{}
+	relay := outbox.NewRelay(store, pub, zap.NewNop())
+	relay.Start(context.Background())
+	defer relay.Stop()
+
+	// первый проход при старте пуст; событие появляется позже и уходит по Notify, не дожидаясь опроса
+	time.Sleep(20 * time.Millisecond)
+	addEvents(t, store, uuid.New(), "order_created")
+	relay.Notify()
+	relay.Notify() // повторный вызов не блокирует
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if store.sent() == 1 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("Notify must trigger publishing before the poll interval")
+}
@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"order-service/internal/migrate"
 	"order-service/internal/models"
@@ -17,7 +18,7 @@ import (
 func setupDB(t *testing.T) *gorm.DB {
 	t.Helper()
 	db := testutil.SetupTestPostgres(t)
-	if err := migrate.MigrateOrderDB(context.Background(), db, zap.NewNop(), migrate.DefaultMigrateOptions()); err != nil {
+	if err := migrate.MigrateOrderDB(context.Background(), db, zap.NewNop()); err != nil {
 		t.Fatalf("migrate: %v", err)
 	}
 	return db
@@ -97,7 +98,7 @@ func TestOrderRepo_WithTx(t *testing.T) {
 	}
 
 	repo := repository.New(db)
-	err := repo.Orders.WithTx(ctx, func(txOrders repository.OrderRepo, txItems repository.OrderItemRepo) error {
+	err := repo.Orders.WithTx(ctx, func(txOrders repository.OrderRepo, txItems repository.OrderItemRepo, _ repository.OrderEventOutboxRepo) error {
 		// add 2 items
 		p1, p2 := uuid.New(), uuid.New()
 		items := []models.OrderItem{
@@ -181,3 +182,64 @@ func TestOrderItemRepo_CRUD(t *testing.T) {
 		t.Fatalf("deleted second expected 0 got %d", deleted2)
 	}
 }
+
+func TestOrderEventOutboxRepo(t *testing.T) {
+	db := setupDB(t)
+	outbox := repository.NewOrderEventOutboxRepo(db)
+	ctx := context.Background()
+
+	orderID := uuid.New()
+	created := &models.OrderEventOutbox{Stream: models.OutboxStreamEvents, EventType: "order_created", OrderID: orderID, Payload: `{"type":"order_created"}`}
+	status := &models.OrderEventOutbox{Stream: models.OutboxStreamStatus, EventType: "order_status_changed", OrderID: orderID, Payload: `{"status":"ORDER_STATUS_PENDING"}`}
+	if err := outbox.Add(ctx, created, status); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	pending, err := outbox.ListPending(ctx, 10)
+	if err != nil || len(pending) != 2 {
+		t.Fatalf("ListPending: %v %v", pending, err)
+	}
+	if pending[0].ID != created.ID || pending[1].ID != status.ID || pending[0].Seq >= pending[1].Seq {
+		t.Fatalf("events must be listed in insertion order: %+v", pending)
+	}
+
+	if err := outbox.MarkFailed(ctx, created.ID, "kafka unavailable"); err != nil {
+		t.Fatalf("MarkFailed: %v", err)
+	}
+	sentAt := time.Now().Add(-48 * time.Hour)
+	if err := outbox.MarkSent(ctx, created.ID, sentAt); err != nil {
+		t.Fatalf("MarkSent: %v", err)
+	}
+	pending, _ = outbox.ListPending(ctx, 10)
+	if len(pending) != 1 || pending[0].ID != status.ID {
+		t.Fatalf("sent event must leave the pending list: %+v", pending)
+	}
+
+	deleted, err := outbox.DeleteSent(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil || deleted != 1 {
+		t.Fatalf("DeleteSent: deleted=%d err=%v", deleted, err)
+	}
+}
+
+func TestOrderEventOutboxRepo_Exclusive(t *testing.T) {
+	db := setupDB(t)
+	outbox := repository.NewOrderEventOutboxRepo(db)
+	ctx := context.Background()
+
+	ran := false
+	locked, err := outbox.Exclusive(ctx, func(tx repository.OrderEventOutboxRepo) error {
+		// вторая реплика в это время блокировку не получает
+		other, err := outbox.Exclusive(ctx, func(repository.OrderEventOutboxRepo) error {
+			t.Error("second relay must not run while the lock is held")
+			return nil
+		})
+		if err != nil || other {
+			t.Errorf("Exclusive while locked: locked=%v err=%v", other, err)
+		}
+		ran = true
+		return nil
+	})
+	if err != nil || !locked || !ran {
+		t.Fatalf("Exclusive: locked=%v ran=%v err=%v", locked, ran, err)
+	}
+}
@@ -0,0 +1,117 @@
+// Package apikey — общие части аутентификации по персональным API-ключам:
+// разбор заголовка Authorization и кратковременный кэш результатов ResolveApiKey.
+package apikey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SchemeBearer и SchemeAPIKey — поддерживаемые схемы заголовка Authorization
+	SchemeBearer = "Bearer"
+	SchemeAPIKey = "ApiKey"
+
+	// Prefix — начало каждого ключа; по нему ключи легко находить в логах и репозиториях
+	Prefix = "ohk_"
+
+	// DefaultCacheTTL — сколько переиспользуется результат проверки ключа.
+	// Отозванный ключ перестаёт работать не позже чем через это время.
+	DefaultCacheTTL = 30 * time.Second
+
+	maxCacheEntries = 10000
+)
+
+// ParseAuthorization разбирает "Bearer <jwt>" и "ApiKey <ключ>" (схема без учёта регистра).
+func ParseAuthorization(header string) (scheme, credential string, ok bool) {
+	parts := strings.SplitN(strings.TrimSpace(header), " ", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	credential = strings.TrimSpace(parts[1])
+	switch {
+	case strings.EqualFold(parts[0], SchemeBearer):
+		return SchemeBearer, credential, credential != ""
+	case strings.EqualFold(parts[0], SchemeAPIKey):
+		return SchemeAPIKey, credential, credential != ""
+	default:
+		return "", "", false
+	}
+}
+
+// Identity — владелец ключа и его действующие права
+type Identity struct {
+	KeyID     string
+	UserID    string
+	Role      string
+	Scopes    []string
+	ExpiresAt time.Time // нулевое значение — бессрочный ключ
+}
+
+type cacheEntry struct {
+	id       Identity
+	deadline time.Time
+}
+
+// Cache хранит успешные проверки ключей в памяти процесса. Ключи кэша — sha256
+// от самого ключа, так что открытые значения в карте не лежат. Неудачные
+// проверки не кэшируются.
+type Cache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]cacheEntry
+	now   func() time.Time
+}
+
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, items: map[string]cacheEntry{}, now: time.Now}
+}
+
+func cacheKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *Cache) Get(key string) (Identity, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := cacheKey(key)
+	e, ok := c.items[k]
+	if !ok {
+		return Identity{}, false
+	}
+	if !c.now().Before(e.deadline) {
+		delete(c.items, k)
+		return Identity{}, false
+	}
+	return e.id, true
+}
+
+// Set запоминает результат; запись живёт не дольше TTL и не дольше срока ключа.
+func (c *Cache) Set(key string, id Identity) {
+	now := c.now()
+	deadline := now.Add(c.ttl)
+	if !id.ExpiresAt.IsZero() && id.ExpiresAt.Before(deadline) {
+		deadline = id.ExpiresAt
+	}
+	if !now.Before(deadline) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.items) >= maxCacheEntries {
+		for k, e := range c.items {
+			if !now.Before(e.deadline) {
+				delete(c.items, k)
+			}
+		}
+		if len(c.items) >= maxCacheEntries {
+			c.items = map[string]cacheEntry{}
+		}
+	}
+	c.items[cacheKey(key)] = cacheEntry{id: id, deadline: deadline}
+}
@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAuthorization(t *testing.T) {
+	cases := []struct {
+		header     string
+		scheme     string
+		credential string
+		ok         bool
+	}{
+		{"Bearer abc.def", SchemeBearer, "abc.def", true},
+		{"bearer  abc.def ", SchemeBearer, "abc.def", true},
+		{"ApiKey ohk_secret", SchemeAPIKey, "ohk_secret", true},
+		{"APIKEY ohk_secret", SchemeAPIKey, "ohk_secret", true},
+		{"Basic dXNlcjpwd2Q=", "", "", false},
+		{"ApiKey ", "", "", false},
+		{"ohk_secret", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tc := range cases {
+		scheme, cred, ok := ParseAuthorization(tc.header)
+		if scheme != tc.scheme || cred != tc.credential || ok != tc.ok {
+			t.Errorf("ParseAuthorization(%q) = %q, %q, %v; want %q, %q, %v",
+				tc.header, scheme, cred, ok, tc.scheme, tc.credential, tc.ok)
+		}
+	}
+}
+
+func TestCache_ExpiresAfterTTL(t *testing.T) {
+	now := time.Now()
+	c := NewCache(30 * time.Second)
+	c.now = func() time.Time { return now }
+
+	c.Set("ohk_a", Identity{UserID: "u1"})
+	if id, ok := c.Get("ohk_a"); !ok || id.UserID != "u1" {
+		t.Fatalf("Expected cached identity, got %+v ok=%v", id, ok)
+	}
+	if _, ok := c.Get("ohk_b"); ok {
+		t.Fatal("Unexpected hit for unknown key")
+	}
+
+	now = now.Add(30 * time.Second)
+	if _, ok := c.Get("ohk_a"); ok {
+		t.Fatal("Expected entry to expire after TTL")
+	}
+}
+
+func TestCache_RespectsKeyExpiry(t *testing.T) {
+	now := time.Now()
+	c := NewCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("ohk_soon", Identity{UserID: "u1", ExpiresAt: now.Add(5 * time.Second)})
+	now = now.Add(5 * time.Second)
+	if _, ok := c.Get("ohk_soon"); ok {
+		t.Fatal("Entry must not outlive the key itself")
+	}
+
+	c.Set("ohk_expired", Identity{UserID: "u1", ExpiresAt: now.Add(-time.Second)})
+	if _, ok := c.Get("ohk_expired"); ok {
+		t.Fatal("Expired key must not be cached")
+	}
+}
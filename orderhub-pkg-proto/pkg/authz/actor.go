@@ -0,0 +1,35 @@
+package authz
+
+import (
+	"context"
+	"strings"
+)
+
+type actorKey struct{}
+
+// WithActor отмечает, что запрос выполняется администратором actorID от имени
+// пользователя (токен выдан через Impersonate, claim act).
+func WithActor(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actorID)
+}
+
+// ActorFromContext возвращает ID администратора, действующего от имени пользователя.
+func ActorFromContext(ctx context.Context) (string, bool) {
+	id, _ := ctx.Value(actorKey{}).(string)
+	return id, id != ""
+}
+
+// readPrefixes — префиксы имён gRPC-методов, которые ничего не меняют
+var readPrefixes = []string{"Get", "List", "BatchGet", "Introspect", "Resolve", "Export"}
+
+// IsWriteMethod сообщает, изменяет ли gRPC-метод ("/pkg.Service/Method") данные.
+// Используется для аудита: изменения под чужим именем журналируются вместе с actor.
+func IsWriteMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndexByte(fullMethod, '/')+1:]
+	for _, p := range readPrefixes {
+		if strings.HasPrefix(name, p) {
+			return false
+		}
+	}
+	return true
+}
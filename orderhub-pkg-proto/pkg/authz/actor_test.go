@@ -0,0 +1,35 @@
+package authz
+
+import (
+	"context"
+	"testing"
+)
+
+func TestActorContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := ActorFromContext(ctx); ok {
+		t.Fatal("empty context must have no actor")
+	}
+	ctx = WithActor(ctx, "admin-1")
+	if id, ok := ActorFromContext(ctx); !ok || id != "admin-1" {
+		t.Fatalf("ActorFromContext = %q, %v", id, ok)
+	}
+}
+
+func TestIsWriteMethod(t *testing.T) {
+	cases := map[string]bool{
+		"/order.v1.OrderService/CreateOrder":              true,
+		"/order.v1.OrderService/CancelOrder":              true,
+		"/order.v1.OrderService/GetOrder":                 false,
+		"/order.v1.OrderService/ListOrders":               false,
+		"/inventory.v1.InventoryService/BatchGetProducts": false,
+		"/inventory.v1.InventoryService/AdjustStock":      true,
+		"/auth.v1.AuthService/ExportMyData":               false,
+		"/auth.v1.AuthService/DeleteAccount":              true,
+	}
+	for method, want := range cases {
+		if got := IsWriteMethod(method); got != want {
+			t.Errorf("IsWriteMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
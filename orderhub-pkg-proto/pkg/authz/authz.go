@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// Права доступа. Набор прав роли хранится в auth-service (таблица role_permissions)
+// и попадает в access-токен claim'ом perms, а во внутренние сервисы — через Introspect.
+const (
+	PermProductWrite      = "product:write"     // создание/изменение своих товаров
+	PermProductWriteAny   = "product:write:any" // изменение чужих товаров
+	PermStockAdjust       = "stock:adjust"      // остатки своих товаров
+	PermStockAdjustAny    = "stock:adjust:any"  // остатки любых товаров
+	PermOrderCreate       = "order:create"
+	PermOrderReadAny      = "order:read:any"      // просмотр чужих заказов
+	PermOrderCancelAny    = "order:cancel:any"    // отмена чужих заказов
+	PermRBACManage        = "rbac:manage"         // редактирование прав ролей
+	PermVendorReview      = "vendor:review"       // рассмотрение заявок продавцов
+	PermUserManage        = "user:manage"         // смена роли и блокировка пользователей
+	PermUserImpersonate   = "user:impersonate"    // вход от имени пользователя (поддержка)
+	PermOAuthClientManage = "oauth_client:manage" // регистрация клиентов интроспекции и отзыва токенов
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+type ctxKey struct{}
+
+// WithPermissions кладёт права текущего пользователя в контекст.
+func WithPermissions(ctx context.Context, perms []string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, perms)
+}
+
+// PermissionsFromContext возвращает права текущего пользователя.
+func PermissionsFromContext(ctx context.Context) []string {
+	perms, _ := ctx.Value(ctxKey{}).([]string)
+	return perms
+}
+
+// Has сообщает, есть ли у пользователя право perm.
+func Has(ctx context.Context, perm string) bool {
+	return slices.Contains(PermissionsFromContext(ctx), perm)
+}
+
+// Require возвращает ErrForbidden, если у пользователя нет права perm.
+func Require(ctx context.Context, perm string) error {
+	if !Has(ctx, perm) {
+		return ErrForbidden
+	}
+	return nil
+}
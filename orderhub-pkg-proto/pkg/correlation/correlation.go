@@ -0,0 +1,204 @@
+// Package correlation связывает запрос gateway со всеми вызовами и событиями, которые он
+// порождает: X-Request-ID и W3C traceparent передаются через gRPC-метаданные и заголовки
+// Kafka и попадают в каждую строку лога.
+package correlation
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderRequestID   = "X-Request-ID"
+	HeaderTraceparent = "traceparent"
+
+	// ключи gRPC-метаданных и заголовков Kafka
+	KeyRequestID   = "x-request-id"
+	KeyTraceparent = "traceparent"
+
+	maxRequestIDLen = 128
+)
+
+// IDs — идентификаторы запроса
+type IDs struct {
+	RequestID   string
+	Traceparent string // 00-<trace-id>-<parent-id>-<flags>
+}
+
+type ctxKey struct{}
+
+// WithIDs кладёт идентификаторы в контекст. Если в контексте ещё нет трассы OpenTelemetry,
+// traceparent становится её удалённым родителем — так спаны и исходящие вызовы продолжают
+// ту же трассу, что видна в логах.
+func WithIDs(ctx context.Context, ids IDs) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		if sc, ok := spanContext(ids.Traceparent); ok {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+		}
+	}
+	return context.WithValue(ctx, ctxKey{}, ids)
+}
+
+// FromContext возвращает идентификаторы запроса; traceparent указывает на текущий спан,
+// если он есть
+func FromContext(ctx context.Context) IDs {
+	ids, _ := ctx.Value(ctxKey{}).(IDs)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		ids.Traceparent = "00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-" + sc.TraceFlags().String()
+	}
+	return ids
+}
+
+// Outgoing — пары для исходящего вызова или сообщения. traceparent добавляется, только
+// если в контексте нет спана: иначе его записывает propagator OpenTelemetry.
+func Outgoing(ctx context.Context) []string {
+	ids := FromContext(ctx)
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		ids.Traceparent = ""
+	} else if ids.Traceparent != "" {
+		ids.Traceparent = ChildTraceparent(ids.Traceparent)
+	}
+	return ids.Pairs()
+}
+
+// TraceID — trace-id из traceparent; пусто, если traceparent нет
+func (ids IDs) TraceID() string {
+	traceID, _, ok := ParseTraceparent(ids.Traceparent)
+	if !ok {
+		return ""
+	}
+	return traceID
+}
+
+// Pairs — непустые идентификаторы парами ключ/значение для metadata.Pairs и заголовков Kafka
+func (ids IDs) Pairs() []string {
+	var kv []string
+	if ids.RequestID != "" {
+		kv = append(kv, KeyRequestID, ids.RequestID)
+	}
+	if ids.Traceparent != "" {
+		kv = append(kv, KeyTraceparent, ids.Traceparent)
+	}
+	return kv
+}
+
+// Resolve проверяет идентификаторы, пришедшие извне, и создаёт недостающие
+func Resolve(requestID, traceparent string) IDs {
+	if !ValidRequestID(requestID) {
+		requestID = NewRequestID()
+	}
+	if _, _, ok := ParseTraceparent(traceparent); !ok {
+		traceparent = NewTraceparent()
+	}
+	return IDs{RequestID: requestID, Traceparent: traceparent}
+}
+
+// ValidRequestID допускает только печатные ASCII-символы без пробелов и не длиннее 128:
+// значение приходит от клиента и пишется в логи
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func NewRequestID() string {
+	return randomHex(16)
+}
+
+// NewTraceparent начинает новую трассу (sampled)
+func NewTraceparent() string {
+	return "00-" + randomHex(16) + "-" + randomHex(8) + "-01"
+}
+
+// ChildTraceparent — traceparent для следующего вызова в той же трассе: trace-id и флаги
+// сохраняются, parent-id новый
+func ChildTraceparent(parent string) string {
+	traceID, _, ok := ParseTraceparent(parent)
+	if !ok {
+		return NewTraceparent()
+	}
+	return "00-" + traceID + "-" + randomHex(8) + "-" + parent[53:55]
+}
+
+// ParseTraceparent разбирает заголовок версии 00 (W3C Trace Context)
+func ParseTraceparent(s string) (traceID, parentID string, ok bool) {
+	parts := strings.Split(s, "-")
+	if len(s) != 55 || len(parts) != 4 || parts[0] != "00" {
+		return "", "", false
+	}
+	if !isLowerHex(parts[1], 32) || !isLowerHex(parts[2], 16) || !isLowerHex(parts[3], 2) {
+		return "", "", false
+	}
+	// нулевые trace-id и parent-id недопустимы
+	if strings.Trim(parts[1], "0") == "" || strings.Trim(parts[2], "0") == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// Fields — поля лога с идентификаторами запроса из контекста
+func Fields(ctx context.Context) []zap.Field {
+	ids := FromContext(ctx)
+	var fields []zap.Field
+	if ids.RequestID != "" {
+		fields = append(fields, zap.String("request_id", ids.RequestID))
+	}
+	if traceID := ids.TraceID(); traceID != "" {
+		fields = append(fields, zap.String("trace_id", traceID))
+	}
+	return fields
+}
+
+// Logger добавляет к log идентификаторы запроса из контекста
+func Logger(ctx context.Context, log *zap.Logger) *zap.Logger {
+	if fields := Fields(ctx); len(fields) > 0 {
+		return log.With(fields...)
+	}
+	return log
+}
+
+func spanContext(traceparent string) (trace.SpanContext, bool) {
+	traceID, parentID, ok := ParseTraceparent(traceparent)
+	if !ok {
+		return trace.SpanContext{}, false
+	}
+	tid, _ := trace.TraceIDFromHex(traceID)
+	sid, _ := trace.SpanIDFromHex(parentID)
+	flags, _ := hex.DecodeString(traceparent[53:55])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: trace.TraceFlags(flags[0]),
+		Remote:     true,
+	}), true
+}
+
+func isLowerHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,77 @@
+package correlation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestResolve_KeepsValidIDs(t *testing.T) {
+	tp := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ids := Resolve("req-1", tp)
+	if ids.RequestID != "req-1" || ids.Traceparent != tp {
+		t.Fatalf("valid ids must be kept, got %+v", ids)
+	}
+	if ids.TraceID() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("unexpected trace id %q", ids.TraceID())
+	}
+}
+
+func TestResolve_ReplacesInvalidIDs(t *testing.T) {
+	cases := []struct{ requestID, traceparent string }{
+		{"", ""},
+		{"with space", "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{strings.Repeat("a", 129), "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{"bad\nid", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"},
+	}
+	for _, c := range cases {
+		ids := Resolve(c.requestID, c.traceparent)
+		if ids.RequestID == c.requestID || !ValidRequestID(ids.RequestID) {
+			t.Errorf("request id %q must be replaced, got %q", c.requestID, ids.RequestID)
+		}
+		if _, _, ok := ParseTraceparent(ids.Traceparent); !ok || ids.Traceparent == c.traceparent {
+			t.Errorf("traceparent %q must be replaced, got %q", c.traceparent, ids.Traceparent)
+		}
+	}
+}
+
+func TestChildTraceparent(t *testing.T) {
+	parent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"
+	child := ChildTraceparent(parent)
+	traceID, parentID, ok := ParseTraceparent(child)
+	if !ok || traceID != "4bf92f3577b34da6a3ce929d0e0e4736" || parentID == "00f067aa0ba902b7" || !strings.HasSuffix(child, "-00") {
+		t.Errorf("unexpected child %q", child)
+	}
+}
+
+func TestPairsAndFields(t *testing.T) {
+	ctx := WithIDs(context.Background(), IDs{RequestID: "r1", Traceparent: NewTraceparent()})
+	ids := FromContext(ctx)
+	if kv := ids.Pairs(); len(kv) != 4 || kv[0] != KeyRequestID || kv[1] != "r1" {
+		t.Errorf("unexpected pairs %v", kv)
+	}
+	if fields := Fields(ctx); len(fields) != 2 {
+		t.Errorf("expected request_id and trace_id fields, got %d", len(fields))
+	}
+	if fields := Fields(context.Background()); len(fields) != 0 {
+		t.Errorf("empty context must give no fields, got %d", len(fields))
+	}
+}
+
+func TestWithIDs_ContinuesTraceInSpanContext(t *testing.T) {
+	tp := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := WithIDs(context.Background(), IDs{RequestID: "r1", Traceparent: tp})
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || sc.TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || !sc.IsSampled() {
+		t.Fatalf("traceparent must become the remote span context, got %+v", sc)
+	}
+	if got := FromContext(ctx).Traceparent; got != tp {
+		t.Errorf("unexpected traceparent %q", got)
+	}
+	// traceparent пишет propagator OpenTelemetry, здесь — только request id
+	if kv := Outgoing(ctx); len(kv) != 2 || kv[0] != KeyRequestID {
+		t.Errorf("unexpected outgoing pairs %v", kv)
+	}
+}
@@ -0,0 +1,54 @@
+// Package interceptor передаёт идентификаторы correlation через gRPC
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/correlation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServer кладёт x-request-id и traceparent из входящих метаданных в контекст (создаёт,
+// если вызывающий их не передал) и пишет строку лога на каждый вызов. Должен стоять
+// первым в цепочке, чтобы идентификаторы видели остальные перехватчики.
+func UnaryServer(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var requestID, traceparent string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			requestID = first(md, correlation.KeyRequestID)
+			traceparent = first(md, correlation.KeyTraceparent)
+		}
+		ctx = correlation.WithIDs(ctx, correlation.Resolve(requestID, traceparent))
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		correlation.Logger(ctx, log).Info("grpc call",
+			zap.String("method", info.FullMethod),
+			zap.String("code", status.Code(err).String()),
+			zap.Duration("duration", time.Since(start)),
+		)
+		return resp, err
+	}
+}
+
+// UnaryClient передаёт идентификаторы из контекста в исходящие метаданные; parent-id
+// traceparent у каждого вызова свой
+func UnaryClient() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if kv := correlation.Outgoing(ctx); len(kv) > 0 {
+			ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
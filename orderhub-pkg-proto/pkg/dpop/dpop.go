@@ -0,0 +1,299 @@
+// Package dpop — проверка DPoP-доказательств (RFC 9449): клиент подписывает каждый запрос
+// своим ключом, а токены привязываются к отпечатку этого ключа (claim cnf.jkt).
+// Пакет проверяет подпись, htm/htu, свежесть и ath; защита от повторов (jti) и nonce
+// остаются на стороне вызывающего, потому что им нужно общее хранилище.
+package dpop
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderName — заголовок HTTP с доказательством
+	HeaderName = "DPoP"
+	// NonceHeaderName — заголовок, в котором сервер выдаёт nonce
+	NonceHeaderName = "DPoP-Nonce"
+	// Scheme — схема Authorization для привязанных токенов
+	Scheme = "DPoP"
+
+	typ = "dpop+jwt"
+
+	// DefaultMaxAge — насколько старым может быть iat доказательства
+	DefaultMaxAge = 5 * time.Minute
+	// DefaultSkew — допустимое опережение часов клиента
+	DefaultSkew = time.Minute
+
+	maxProofLen = 8192
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid dpop proof")
+	ErrExpiredProof = errors.New("dpop proof is too old or issued in the future")
+)
+
+// VerifyOptions — с чем сверяется доказательство
+type VerifyOptions struct {
+	Method      string // htm
+	URL         string // htu; query и fragment не сравниваются
+	AccessToken string // если задан, доказательство должно содержать ath этого токена
+	Now         time.Time
+	MaxAge      time.Duration // 0 — DefaultMaxAge
+	Skew        time.Duration // 0 — DefaultSkew
+}
+
+// Proof — проверенное доказательство
+type Proof struct {
+	JTI        string
+	Nonce      string
+	IssuedAt   time.Time
+	Thumbprint string // jkt: отпечаток публичного ключа клиента (RFC 7638)
+}
+
+type header struct {
+	Typ string          `json:"typ"`
+	Alg string          `json:"alg"`
+	JWK json.RawMessage `json:"jwk"`
+}
+
+type claims struct {
+	JTI   string  `json:"jti"`
+	HTM   string  `json:"htm"`
+	HTU   string  `json:"htu"`
+	IAT   float64 `json:"iat"`
+	Nonce string  `json:"nonce,omitempty"`
+	ATH   string  `json:"ath,omitempty"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	D   string `json:"d,omitempty"`
+}
+
+// Verify проверяет доказательство и возвращает его jti, nonce и отпечаток ключа.
+func Verify(proof string, opts VerifyOptions) (*Proof, error) {
+	if proof == "" || len(proof) > maxProofLen {
+		return nil, fmt.Errorf("%w: empty or too long", ErrInvalidProof)
+	}
+	parts := strings.Split(proof, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: not a compact JWS", ErrInvalidProof)
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidProof, err)
+	}
+	if h.Typ != typ {
+		return nil, fmt.Errorf("%w: typ must be %s", ErrInvalidProof, typ)
+	}
+	var key jwk
+	if err := json.Unmarshal(h.JWK, &key); err != nil {
+		return nil, fmt.Errorf("%w: jwk: %v", ErrInvalidProof, err)
+	}
+	if key.D != "" {
+		return nil, fmt.Errorf("%w: jwk contains a private key", ErrInvalidProof)
+	}
+	pub, err := key.publicKey()
+	if err != nil {
+		return nil, fmt.Errorf("%w: jwk: %v", ErrInvalidProof, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature encoding", ErrInvalidProof)
+	}
+	if err := verifySignature(h.Alg, pub, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, fmt.Errorf("%w: claims: %v", ErrInvalidProof, err)
+	}
+	if c.JTI == "" || len(c.JTI) > 256 {
+		return nil, fmt.Errorf("%w: jti", ErrInvalidProof)
+	}
+	if c.HTM != opts.Method {
+		return nil, fmt.Errorf("%w: htm mismatch", ErrInvalidProof)
+	}
+	if !sameURL(c.HTU, opts.URL) {
+		return nil, fmt.Errorf("%w: htu mismatch", ErrInvalidProof)
+	}
+	if opts.AccessToken != "" && c.ATH != AccessTokenHash(opts.AccessToken) {
+		return nil, fmt.Errorf("%w: ath mismatch", ErrInvalidProof)
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	maxAge, skew := opts.MaxAge, opts.Skew
+	if maxAge == 0 {
+		maxAge = DefaultMaxAge
+	}
+	if skew == 0 {
+		skew = DefaultSkew
+	}
+	iat := time.Unix(0, int64(c.IAT*float64(time.Second)))
+	if iat.Before(now.Add(-maxAge)) || iat.After(now.Add(skew)) {
+		return nil, ErrExpiredProof
+	}
+
+	thumb, err := key.thumbprint()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	return &Proof{JTI: c.JTI, Nonce: c.Nonce, IssuedAt: iat, Thumbprint: thumb}, nil
+}
+
+// AccessTokenHash — значение ath: base64url(sha256(access token))
+func AccessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Thumbprint считает jkt публичного ключа (ECDSA P-256 или RSA)
+func Thumbprint(pub crypto.PublicKey) (string, error) {
+	key, err := publicJWK(pub)
+	if err != nil {
+		return "", err
+	}
+	return key.thumbprint()
+}
+
+func decodeSegment(seg string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func verifySignature(alg string, pub crypto.PublicKey, signed, sig []byte) error {
+	digest := sha256.Sum256(signed)
+	switch alg {
+	case "ES256":
+		k, ok := pub.(*ecdsa.PublicKey)
+		if !ok || len(sig) != 64 {
+			return errors.New("alg does not match the key")
+		}
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			return errors.New("bad signature")
+		}
+		return nil
+	case "RS256":
+		k, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("alg does not match the key")
+		}
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig); err != nil {
+			return errors.New("bad signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("bad EC coordinates")
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("point is not on the curve")
+		}
+		return pub, nil
+	case "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("bad RSA key")
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if pub.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key is shorter than 2048 bits")
+		}
+		return pub, nil
+	default:
+		return nil, fmt.Errorf("unsupported kty %q", k.Kty)
+	}
+}
+
+// thumbprint — RFC 7638: SHA-256 от обязательных членов JWK в лексикографическом порядке
+func (k jwk) thumbprint() (string, error) {
+	var canonical string
+	switch k.Kty {
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":%q,"kty":"EC","x":%q,"y":%q}`, k.Crv, k.X, k.Y)
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, k.E, k.N)
+	default:
+		return "", fmt.Errorf("unsupported kty %q", k.Kty)
+	}
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func publicJWK(pub crypto.PublicKey) (jwk, error) {
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return jwk{}, errors.New("only P-256 keys are supported")
+		}
+		return jwk{
+			Kty: "EC", Crv: "P-256",
+			X: base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, 32))),
+			Y: base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, 32))),
+		}, nil
+	case *rsa.PublicKey:
+		return jwk{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	default:
+		return jwk{}, fmt.Errorf("unsupported key type %T", pub)
+	}
+}
+
+// sameURL сравнивает htu без query и fragment, схема и хост — без учёта регистра (RFC 9449, 4.3)
+func sameURL(a, b string) bool {
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil || ua.Host == "" {
+		return false
+	}
+	norm := func(u *url.URL) string {
+		path := u.EscapedPath()
+		if path == "" {
+			path = "/"
+		}
+		return strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host) + path
+	}
+	return norm(ua) == norm(ub)
+}
@@ -0,0 +1,77 @@
+package dpop
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify_RoundTrip(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	now := time.Now()
+
+	for name, key := range map[string]crypto.Signer{"ES256": ecKey, "RS256": rsaKey} {
+		proof, err := NewProof(key, "POST", "https://api.example.com/api/v1/auth/refresh", "n1", "", now)
+		if err != nil {
+			t.Fatalf("%s: sign: %v", name, err)
+		}
+		got, err := Verify(proof, VerifyOptions{Method: "POST", URL: "https://API.example.com/api/v1/auth/refresh?x=1", Now: now})
+		if err != nil {
+			t.Fatalf("%s: verify: %v", name, err)
+		}
+		want, _ := Thumbprint(key.Public())
+		if got.Thumbprint != want || got.Nonce != "n1" || got.JTI == "" {
+			t.Errorf("%s: unexpected proof %+v, want jkt %s", name, got, want)
+		}
+	}
+}
+
+func TestVerify_Rejections(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	now := time.Now()
+	const target = "https://api.example.com/api/v1/orders"
+	proof, err := NewProof(key, "GET", target, "", "access-token", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		proof string
+		opts  VerifyOptions
+		want  error
+	}{
+		"method":       {proof, VerifyOptions{Method: "POST", URL: target, Now: now}, ErrInvalidProof},
+		"url":          {proof, VerifyOptions{Method: "GET", URL: "https://api.example.com/api/v1/users", Now: now}, ErrInvalidProof},
+		"access token": {proof, VerifyOptions{Method: "GET", URL: target, AccessToken: "other-token", Now: now}, ErrInvalidProof},
+		"too old":      {proof, VerifyOptions{Method: "GET", URL: target, Now: now.Add(DefaultMaxAge + time.Second)}, ErrExpiredProof},
+		"future":       {proof, VerifyOptions{Method: "GET", URL: target, Now: now.Add(-DefaultSkew - time.Second)}, ErrExpiredProof},
+		"tampered":     {tamper(proof), VerifyOptions{Method: "GET", URL: target, Now: now}, ErrInvalidProof},
+		"garbage":      {"a.b", VerifyOptions{Method: "GET", URL: target, Now: now}, ErrInvalidProof},
+	}
+	for name, tc := range cases {
+		if _, err := Verify(tc.proof, tc.opts); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+
+	if _, err := Verify(proof, VerifyOptions{Method: "GET", URL: target, AccessToken: "access-token", Now: now}); err != nil {
+		t.Errorf("valid proof with ath rejected: %v", err)
+	}
+}
+
+// tamper меняет последний символ подписи
+func tamper(proof string) string {
+	last := proof[len(proof)-1:]
+	repl := "A"
+	if last == "A" {
+		repl = "B"
+	}
+	return strings.TrimSuffix(proof, last) + repl
+}
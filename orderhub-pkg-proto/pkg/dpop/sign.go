@@ -0,0 +1,68 @@
+package dpop
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// NewProof подписывает доказательство ключом клиента (ECDSA P-256 или RSA).
+// Нужен клиентам на Go и тестам; nonce и accessToken необязательны.
+func NewProof(key crypto.Signer, method, url, nonce, accessToken string, now time.Time) (string, error) {
+	pub, err := publicJWK(key.Public())
+	if err != nil {
+		return "", err
+	}
+	alg := "ES256"
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		alg = "RS256"
+	}
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	hdr, err := json.Marshal(map[string]any{"typ": typ, "alg": alg, "jwk": pub})
+	if err != nil {
+		return "", err
+	}
+	c := claims{
+		JTI:   base64.RawURLEncoding.EncodeToString(jti),
+		HTM:   method,
+		HTU:   url,
+		IAT:   float64(now.Unix()),
+		Nonce: nonce,
+	}
+	if accessToken != "" {
+		c.ATH = AccessTokenHash(accessToken)
+	}
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(hdr) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return "", err
+		}
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case *rsa.PrivateKey:
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:]); err != nil {
+			return "", err
+		}
+	default:
+		return "", errors.New("unsupported key type")
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
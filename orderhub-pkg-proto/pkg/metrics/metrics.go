@@ -0,0 +1,89 @@
+// Package metrics — общие Prometheus-метрики сервисов OrderHub: отдельный HTTP-листенер с
+// /metrics для gRPC-сервисов и consumer'ов и RED-метрики gRPC-вызовов.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Total number of gRPC calls completed on the server, by method and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+
+	grpcHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Duration of gRPC calls on the server, by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+)
+
+// Route — дополнительный обработчик на листенере метрик (например, /readyz)
+type Route struct {
+	Pattern string
+	Handler http.Handler
+}
+
+// Serve поднимает HTTP-листенер с /metrics и routes на addr в отдельной горутине. Пустой
+// addr или "off" отключают листенер (возвращается nil). Сервер останавливается через Shutdown.
+func Serve(addr string, log *zap.Logger, routes ...Route) *http.Server {
+	if addr == "" || addr == "off" {
+		log.Info("metrics listener disabled")
+		return nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	for _, r := range routes {
+		mux.Handle(r.Pattern, r.Handler)
+	}
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		log.Info("Starting metrics listener", zap.String("addr", addr))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("metrics listener failed", zap.Error(err))
+		}
+	}()
+	return srv
+}
+
+// Shutdown останавливает листенер, поднятый Serve; nil допустим
+func Shutdown(ctx context.Context, srv *http.Server) error {
+	if srv == nil {
+		return nil
+	}
+	return srv.Shutdown(ctx)
+}
+
+// UnaryServerInterceptor считает вызовы (rate и errors по коду) и их длительность по
+// методам. Ставится перед auth-перехватчиком, чтобы учитывать и его отказы.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		service, method := splitMethod(info.FullMethod)
+		grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		grpcHandling.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// splitMethod разбирает "/auth.v1.AuthService/Login" на сервис и метод
+func splitMethod(fullMethod string) (string, string) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "unknown", fullMethod
+	}
+	return service, method
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor_CountsByMethodAndCode(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/auth.v1.AuthService/Login"}
+	before := testutil.ToFloat64(grpcHandled.WithLabelValues("auth.v1.AuthService", "Login", "Unauthenticated"))
+
+	_, err := UnaryServerInterceptor()(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("interceptor must return the handler error, got %v", err)
+	}
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("auth.v1.AuthService", "Login", "Unauthenticated")); got != before+1 {
+		t.Errorf("expected counter to grow by 1, got %v -> %v", before, got)
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	if s, m := splitMethod("/order.v1.OrderService/CreateOrder"); s != "order.v1.OrderService" || m != "CreateOrder" {
+		t.Errorf("unexpected split %q %q", s, m)
+	}
+	if s, _ := splitMethod("broken"); s != "unknown" {
+		t.Errorf("unexpected service %q", s)
+	}
+}
@@ -0,0 +1,71 @@
+package migrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+)
+
+const Usage = `usage: migrate [command]
+  up            применить все новые миграции (по умолчанию)
+  down N        откатить N последних миграций
+  status        показать состояние миграций
+  create NAME   создать файлы новой миграции`
+
+// Run выполняет команды up, down N и status. Команда create не требует БД
+// и обрабатывается вызывающей стороной через Create.
+func Run(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	cmd := "up"
+	if len(args) > 0 {
+		cmd = args[0]
+	}
+
+	switch cmd {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "applied %d migration(s)\n", n)
+		return nil
+
+	case "down":
+		if len(args) != 2 {
+			return fmt.Errorf("down requires the number of steps\n%s", Usage)
+		}
+		steps, err := strconv.Atoi(args[1])
+		if err != nil || steps <= 0 {
+			return fmt.Errorf("invalid number of steps %q", args[1])
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "rolled back %d migration(s)\n", n)
+		return nil
+
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, st := range list {
+			applied := "pending"
+			if st.AppliedAt != nil {
+				applied = st.AppliedAt.Format("2006-01-02 15:04:05Z07:00")
+			}
+			if st.Missing {
+				applied += " (file missing)"
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", st.Version, st.Name, applied)
+		}
+		return w.Flush()
+
+	default:
+		return fmt.Errorf("unknown command %q\n%s", cmd, Usage)
+	}
+}
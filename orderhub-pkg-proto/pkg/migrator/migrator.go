@@ -0,0 +1,325 @@
+// Package migrator — версионные SQL-миграции (up/down) поверх gorm.
+//
+// Файлы миграций именуются как <версия>_<название>.up.sql и <версия>_<название>.down.sql
+// (например, 0001_init.up.sql) и обычно встраиваются в бинарь через embed.FS.
+// Применённые версии хранятся в таблице schema_migrations, а параллельный запуск
+// нескольких экземпляров исключается advisory lock'ом PostgreSQL. Status и Version
+// lock не берут: они только читают schema_migrations и не ждут идущую миграцию.
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// TableName — таблица с применёнными версиями
+const TableName = "schema_migrations"
+
+// lockKey — ключ pg_advisory_lock; у каждого сервиса своя БД, поэтому ключ общий
+const lockKey int64 = 0x6f72646572687562 // "orderhub"
+
+var ErrNoDownMigration = errors.New("down migration is missing")
+
+var fileRe = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status — состояние одной миграции
+type Status struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time // nil — ещё не применена
+	Missing   bool       // применена в БД, но файла нет
+}
+
+type appliedRow struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:text;not null"`
+	AppliedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (appliedRow) TableName() string { return TableName }
+
+type Migrator struct {
+	db         *gorm.DB
+	log        *zap.Logger
+	migrations []Migration
+}
+
+// New загружает миграции из корня fsys.
+func New(db *gorm.DB, fsys fs.FS, log *zap.Logger) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, log: log, migrations: migrations}, nil
+}
+
+// Load читает и сортирует миграции из корня fsys.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	seen := map[string]string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		m := fileRe.FindStringSubmatch(e.Name())
+		if m == nil {
+			return nil, fmt.Errorf("migrator: unexpected file %q", e.Name())
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrator: bad version in %q: %w", e.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mg
+		} else if mg.Name != m[2] {
+			return nil, fmt.Errorf("migrator: version %d has two names: %q and %q", version, mg.Name, m[2])
+		}
+		key := fmt.Sprintf("%d.%s", version, m[3])
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("migrator: duplicate %s migration for version %d: %q and %q", m[3], version, prev, e.Name())
+		}
+		seen[key] = e.Name()
+		if m[3] == "up" {
+			mg.Up = string(body)
+		} else {
+			mg.Down = string(body)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if strings.TrimSpace(mg.Up) == "" {
+			return nil, fmt.Errorf("migrator: version %d has no up migration", mg.Version)
+		}
+		out = append(out, *mg)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+// withLock выполняет fn на одном соединении под pg_advisory_lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec(`SELECT pg_advisory_lock(?)`, lockKey).Error; err != nil {
+			return fmt.Errorf("migrator: acquire lock: %w", err)
+		}
+		defer func() {
+			if err := conn.Exec(`SELECT pg_advisory_unlock(?)`, lockKey).Error; err != nil {
+				m.log.Warn("Не удалось снять advisory lock миграций", zap.Error(err))
+			}
+		}()
+
+		if err := conn.Exec(`
+CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+  version    bigint PRIMARY KEY,
+  name       text NOT NULL,
+  applied_at timestamptz NOT NULL DEFAULT now()
+)`).Error; err != nil {
+			return fmt.Errorf("migrator: create %s: %w", TableName, err)
+		}
+		return fn(conn)
+	})
+}
+
+// readApplied читает schema_migrations без lock'а; отсутствие таблицы — пустая схема.
+func (m *Migrator) readApplied(ctx context.Context) (map[int64]appliedRow, error) {
+	db := m.db.WithContext(ctx)
+	var exists bool
+	if err := db.Raw(`SELECT to_regclass(?) IS NOT NULL`, TableName).Scan(&exists).Error; err != nil {
+		return nil, fmt.Errorf("migrator: check %s: %w", TableName, err)
+	}
+	if !exists {
+		return map[int64]appliedRow{}, nil
+	}
+	return applied(db)
+}
+
+func applied(conn *gorm.DB) (map[int64]appliedRow, error) {
+	var rows []appliedRow
+	if err := conn.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	out := make(map[int64]appliedRow, len(rows))
+	for _, r := range rows {
+		out[r.Version] = r
+	}
+	return out, nil
+}
+
+// Up применяет все неприменённые миграции по возрастанию версии.
+// Каждая миграция выполняется в отдельной транзакции вместе с записью в schema_migrations.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	var n int
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := applied(conn)
+		if err != nil {
+			return err
+		}
+		for _, mg := range m.migrations {
+			if _, ok := done[mg.Version]; ok {
+				continue
+			}
+			m.log.Info("Применение миграции", zap.Int64("version", mg.Version), zap.String("name", mg.Name))
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(mg.Up).Error; err != nil {
+					return err
+				}
+				return tx.Create(&appliedRow{Version: mg.Version, Name: mg.Name, AppliedAt: time.Now()}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migrator: up %d_%s: %w", mg.Version, mg.Name, err)
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// Down откатывает steps последних применённых миграций.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, nil
+	}
+	byVersion := make(map[int64]Migration, len(m.migrations))
+	for _, mg := range m.migrations {
+		byVersion[mg.Version] = mg
+	}
+
+	var n int
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		var rows []appliedRow
+		if err := conn.Order("version DESC").Limit(steps).Find(&rows).Error; err != nil {
+			return err
+		}
+		for _, r := range rows {
+			mg, ok := byVersion[r.Version]
+			if !ok || strings.TrimSpace(mg.Down) == "" {
+				return fmt.Errorf("migrator: down %d_%s: %w", r.Version, r.Name, ErrNoDownMigration)
+			}
+			m.log.Info("Откат миграции", zap.Int64("version", mg.Version), zap.String("name", mg.Name))
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(mg.Down).Error; err != nil {
+					return err
+				}
+				return tx.Where("version = ?", mg.Version).Delete(&appliedRow{}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migrator: down %d_%s: %w", mg.Version, mg.Name, err)
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// Status возвращает состояние всех известных миграций (из файлов и из БД).
+// Каждая миграция фиксируется одной транзакцией, поэтому чтение без lock'а
+// видит согласованное состояние даже во время Up в другом процессе.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	done, err := m.readApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStatus(m.migrations, done), nil
+}
+
+func mergeStatus(migrations []Migration, done map[int64]appliedRow) []Status {
+	out := make([]Status, 0, len(migrations))
+	for _, mg := range migrations {
+		st := Status{Version: mg.Version, Name: mg.Name}
+		if r, ok := done[mg.Version]; ok {
+			at := r.AppliedAt
+			st.AppliedAt = &at
+		}
+		out = append(out, st)
+	}
+	known := make(map[int64]bool, len(migrations))
+	for _, mg := range migrations {
+		known[mg.Version] = true
+	}
+	for _, r := range done {
+		if known[r.Version] {
+			continue
+		}
+		at := r.AppliedAt
+		out = append(out, Status{Version: r.Version, Name: r.Name, AppliedAt: &at, Missing: true})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out
+}
+
+// Version возвращает последнюю применённую версию (0 — схема пуста).
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	done, err := m.readApplied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var v int64
+	for version := range done {
+		v = max(v, version)
+	}
+	return v, nil
+}
+
+// Create создаёт в dir пару пустых файлов для новой миграции со следующим номером.
+func Create(dir, name string) (upPath, downPath string, err error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(name, "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		return "", "", errors.New("migrator: empty migration name")
+	}
+
+	existing, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	var next int64 = 1
+	if len(existing) > 0 {
+		next = existing[len(existing)-1].Version + 1
+	}
+
+	base := fmt.Sprintf("%04d_%s", next, name)
+	upPath = filepath.Join(dir, base+".up.sql")
+	downPath = filepath.Join(dir, base+".down.sql")
+	if err := os.WriteFile(upPath, []byte("-- "+base+": up\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(downPath, []byte("-- "+base+": down\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	return upPath, downPath, nil
+}
@@ -0,0 +1,149 @@
+package migrator_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/migrator"
+)
+
+func file(body string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(body)} }
+
+func TestLoad_SortsAndPairsFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_orders.up.sql":   file("CREATE TABLE orders ();"),
+		"0002_orders.down.sql": file("DROP TABLE orders;"),
+		"0001_init.up.sql":     file("CREATE TABLE users ();"),
+		"0010_late.up.sql":     file("SELECT 1;"),
+		"nested/ignored.txt":   file("каталоги пропускаются"),
+	}
+
+	got, err := migrator.Load(fsys)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 migrations, got %+v", got)
+	}
+	wantVersions := []int64{1, 2, 10}
+	for i, v := range wantVersions {
+		if got[i].Version != v {
+			t.Errorf("migration %d: expected version %d, got %d", i, v, got[i].Version)
+		}
+	}
+	if got[1].Name != "orders" || got[1].Up == "" || got[1].Down != "DROP TABLE orders;" {
+		t.Errorf("Unexpected pairing: %+v", got[1])
+	}
+	if got[0].Down != "" {
+		t.Errorf("Expected empty down for 0001, got %q", got[0].Down)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{
+			name: "bad filename",
+			fsys: fstest.MapFS{"init.sql": file("SELECT 1;")},
+			want: "unexpected file",
+		},
+		{
+			name: "uppercase name",
+			fsys: fstest.MapFS{"0001_Init.up.sql": file("SELECT 1;")},
+			want: "unexpected file",
+		},
+		{
+			name: "version overflow",
+			fsys: fstest.MapFS{"99999999999999999999_big.up.sql": file("SELECT 1;")},
+			want: "bad version",
+		},
+		{
+			name: "two names for one version",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql":  file("SELECT 1;"),
+				"0001_other.up.sql": file("SELECT 2;"),
+			},
+			want: "has two names",
+		},
+		{
+			name: "duplicate version with different padding",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql": file("SELECT 1;"),
+				"1_init.up.sql":    file("SELECT 2;"),
+			},
+			want: "duplicate up migration",
+		},
+		{
+			name: "missing up file",
+			fsys: fstest.MapFS{"0001_init.down.sql": file("DROP TABLE users;")},
+			want: "has no up migration",
+		},
+		{
+			name: "empty up file",
+			fsys: fstest.MapFS{"0001_init.up.sql": file("  \n")},
+			want: "has no up migration",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := migrator.Load(tc.fsys)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Expected error containing %q, got %v", tc.want, err)
+			}
+		})
+	}
+}
+
+func TestCreate_Numbering(t *testing.T) {
+	dir := t.TempDir()
+
+	up, down, err := migrator.Create(dir, "Init Schema")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filepath.Base(up) != "0001_init_schema.up.sql" || filepath.Base(down) != "0001_init_schema.down.sql" {
+		t.Fatalf("Unexpected file names: %s, %s", up, down)
+	}
+
+	// пустой up-файл из шаблона всё равно содержит комментарий и проходит Load
+	up, _, err = migrator.Create(dir, "add-orders")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filepath.Base(up) != "0002_add_orders.up.sql" {
+		t.Fatalf("Expected 0002_add_orders.up.sql, got %s", up)
+	}
+
+	// номер берётся от максимальной версии, а не от числа файлов
+	if err := os.WriteFile(filepath.Join(dir, "0041_manual.up.sql"), []byte("SELECT 1;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	up, _, err = migrator.Create(dir, "next")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if filepath.Base(up) != "0042_next.up.sql" {
+		t.Fatalf("Expected 0042_next.up.sql, got %s", up)
+	}
+}
+
+func TestCreate_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if _, _, err := migrator.Create(dir, " -- "); err == nil {
+		t.Error("Expected error for empty name")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "junk.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := migrator.Create(dir, "next"); err == nil {
+		t.Error("Expected error when the directory contains a foreign file")
+	}
+}
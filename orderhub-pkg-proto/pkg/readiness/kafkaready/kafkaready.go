@@ -0,0 +1,34 @@
+// Package kafkaready — проверка доступности Kafka для readiness; вынесена отдельно, чтобы
+// сервисы без Kafka не тянули kafka-go
+package kafkaready
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/readiness"
+	"github.com/segmentio/kafka-go"
+)
+
+// ErrNoBrokers — в конфигурации не задано ни одного брокера
+var ErrNoBrokers = errors.New("no kafka brokers configured")
+
+// Check считает шину доступной, если отвечает хотя бы один брокер: клиенты kafka-go
+// получают остальные адреса из метаданных кластера
+func Check(brokers []string) readiness.Check {
+	return func(ctx context.Context) error {
+		if len(brokers) == 0 {
+			return ErrNoBrokers
+		}
+		var errs []error
+		for _, addr := range brokers {
+			conn, err := kafka.DialContext(ctx, "tcp", addr)
+			if err == nil {
+				_ = conn.Close()
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,30 @@
+package kafkaready
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestCheck_NoBrokers(t *testing.T) {
+	if err := Check(nil)(context.Background()); !errors.Is(err, ErrNoBrokers) {
+		t.Fatalf("expected ErrNoBrokers, got %v", err)
+	}
+}
+
+func TestCheck_UnreachableBroker(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := lis.Addr().String()
+	_ = lis.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := Check([]string{addr})(ctx); err == nil {
+		t.Fatal("closed port must fail the check")
+	}
+}
@@ -0,0 +1,180 @@
+// Package readiness — проверки готовности сервисов OrderHub: зависимости (Postgres, Redis,
+// Kafka, соседние gRPC-сервисы) опрашиваются по расписанию, а итог выставляется в
+// grpc_health_v1 и отдаётся по HTTP с детализацией по каждой зависимости.
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// DefaultInterval — период опроса зависимостей в Watch
+	DefaultInterval = 10 * time.Second
+	// DefaultTimeout — таймаут одной проверки
+	DefaultTimeout = 3 * time.Second
+
+	// LivenessService — имя сервиса в grpc_health_v1, который SERVING, пока процесс жив;
+	// пустое имя ("") отражает готовность
+	LivenessService = "liveness"
+
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Check — проверка одной зависимости; nil — зависимость доступна
+type Check func(ctx context.Context) error
+
+// Result — итог проверки одной зависимости
+type Result struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
+}
+
+// Report — итог всех проверок: status up, только если up все зависимости
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Ready сообщает, готов ли сервис принимать трафик
+func (r Report) Ready() bool { return r.Status == StatusUp }
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker хранит набор проверок; проверки добавляются до Watch/Handler
+type Checker struct {
+	timeout time.Duration
+	checks  []namedCheck
+	log     *zap.Logger
+}
+
+func New(log *zap.Logger) *Checker {
+	return &Checker{timeout: DefaultTimeout, log: log}
+}
+
+// SetTimeout меняет таймаут одной проверки
+func (c *Checker) SetTimeout(d time.Duration) {
+	if d > 0 {
+		c.timeout = d
+	}
+}
+
+// Add регистрирует проверку зависимости name
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Run выполняет все проверки параллельно, каждую — со своим таймаутом
+func (c *Checker) Run(ctx context.Context) Report {
+	report := Report{Status: StatusUp, Checks: make(map[string]Result, len(c.checks))}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, nc := range c.checks {
+		wg.Add(1)
+		go func(nc namedCheck) {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			start := time.Now()
+			err := nc.check(cctx)
+			res := Result{Status: StatusUp, LatencyMS: time.Since(start).Milliseconds()}
+			if err != nil {
+				res.Status = StatusDown
+				res.Error = err.Error()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[nc.name] = res
+			if err != nil {
+				report.Status = StatusDown
+			}
+		}(nc)
+	}
+	wg.Wait()
+	return report
+}
+
+// Watch сразу и затем каждые interval выставляет в srv статус готовности ("") по итогу
+// проверок; LivenessService всегда SERVING. До первой успешной проверки сервис NOT_SERVING.
+// Переходы между состояниями логируются. Возвращается, когда ctx отменён.
+func (c *Checker) Watch(ctx context.Context, srv *health.Server, interval time.Duration) {
+	srv.SetServingStatus(LivenessService, grpc_health_v1.HealthCheckResponse_SERVING)
+	srv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	ready := false
+	for {
+		report := c.Run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case report.Ready() && !ready:
+			c.log.Info("service is ready")
+			srv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+		case !report.Ready() && ready:
+			c.log.Warn("service is not ready", failedFields(report)...)
+			srv.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+		case !report.Ready():
+			c.log.Debug("service is not ready yet", failedFields(report)...)
+		}
+		ready = report.Ready()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Handler отдаёт отчёт в JSON: 200, если все зависимости доступны, иначе 503
+func (c *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Run(r.Context())
+		code := http.StatusOK
+		if !report.Ready() {
+			code = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// LiveHandler — liveness: 200, пока процесс отвечает
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	})
+}
+
+func failedFields(report Report) []zap.Field {
+	fields := make([]zap.Field, 0, len(report.Checks))
+	for name, res := range report.Checks {
+		if res.Status == StatusDown {
+			fields = append(fields, zap.String(name, res.Error))
+		}
+	}
+	return fields
+}
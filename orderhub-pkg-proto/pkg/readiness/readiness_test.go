@@ -0,0 +1,138 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRun_ReportsEachDependency(t *testing.T) {
+	c := New(zap.NewNop())
+	c.Add("postgres", func(ctx context.Context) error { return nil })
+	c.Add("kafka", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	report := c.Run(context.Background())
+	if report.Ready() {
+		t.Fatal("one failed dependency must make the service not ready")
+	}
+	if report.Checks["postgres"].Status != StatusUp || report.Checks["kafka"].Status != StatusDown {
+		t.Errorf("unexpected checks %+v", report.Checks)
+	}
+	if report.Checks["kafka"].Error != "connection refused" {
+		t.Errorf("error must be reported, got %q", report.Checks["kafka"].Error)
+	}
+}
+
+func TestRun_AppliesTimeout(t *testing.T) {
+	c := New(zap.NewNop())
+	c.SetTimeout(20 * time.Millisecond)
+	c.Add("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if report := c.Run(context.Background()); report.Ready() {
+		t.Fatal("a hanging check must fail by timeout")
+	}
+}
+
+func TestHandler_StatusCodes(t *testing.T) {
+	var fail atomic.Bool
+	c := New(zap.NewNop())
+	c.Add("redis", func(ctx context.Context) error {
+		if fail.Load() {
+			return errors.New("down")
+		}
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	c.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	fail.Store(true)
+	rec = httptest.NewRecorder()
+	c.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+	var report Report
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil || report.Checks["redis"].Status != StatusDown {
+		t.Errorf("unexpected body %s", rec.Body.String())
+	}
+}
+
+func TestWatch_FlipsServingStatus(t *testing.T) {
+	var fail atomic.Bool
+	c := New(zap.NewNop())
+	c.Add("postgres", func(ctx context.Context) error {
+		if fail.Load() {
+			return errors.New("down")
+		}
+		return nil
+	})
+	srv := health.NewServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Watch(ctx, srv, 10*time.Millisecond)
+
+	waitStatus(t, srv, "", grpc_health_v1.HealthCheckResponse_SERVING)
+	fail.Store(true)
+	waitStatus(t, srv, "", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	waitStatus(t, srv, LivenessService, grpc_health_v1.HealthCheckResponse_SERVING)
+	fail.Store(false)
+	waitStatus(t, srv, "", grpc_health_v1.HealthCheckResponse_SERVING)
+}
+
+func TestGRPC_ChecksDownstreamStatus(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(srv, hs)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	check := GRPC(conn, "")
+
+	if err := check(context.Background()); err != nil {
+		t.Fatalf("serving downstream must pass, got %v", err)
+	}
+	hs.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	if err := check(context.Background()); err == nil {
+		t.Fatal("not serving downstream must fail")
+	}
+}
+
+func waitStatus(t *testing.T, srv *health.Server, service string, want grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := srv.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		if err == nil && resp.GetStatus() == want {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("service %q did not become %s", service, want)
+}
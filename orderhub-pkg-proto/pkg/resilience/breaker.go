@@ -0,0 +1,114 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateHalfOpen
+	stateOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case stateHalfOpen:
+		return "half_open"
+	case stateOpen:
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// outcome — итог вызова для breaker'а
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota // сервис ответил, в том числе ошибкой бизнес-логики
+	outcomeFailure                // отказ целевого сервиса
+	outcomeNeutral                // ничего не говорит о сервисе: вызов отменил клиент
+)
+
+// breaker — размыкатель цепи на целевой сервис. После failures отказов подряд цепь
+// размыкается и вызовы сразу отклоняются; через openTimeout пропускается один пробный вызов
+// (half-open): успех замыкает цепь, отказ снова размыкает её, а отменённая проба только
+// освобождает место для следующей.
+type breaker struct {
+	mu          sync.Mutex
+	state       breakerState
+	failures    int
+	threshold   int
+	openTimeout time.Duration
+	openedAt    time.Time
+	probing     bool
+	now         func() time.Time
+	onChange    func(breakerState)
+}
+
+func newBreaker(threshold int, openTimeout time.Duration, onChange func(breakerState)) *breaker {
+	return &breaker{threshold: threshold, openTimeout: openTimeout, now: time.Now, onChange: onChange}
+}
+
+// allow сообщает, можно ли выполнить вызов; в half-open пропускается один вызов за раз
+func (b *breaker) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(stateHalfOpen)
+		b.probing = true
+		return true
+	case stateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// record учитывает итог разрешённого вызова
+func (b *breaker) record(o outcome) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	switch o {
+	case outcomeNeutral:
+		return
+	case outcomeSuccess:
+		b.failures = 0
+		if b.state != stateClosed {
+			b.setState(stateClosed)
+		}
+		return
+	}
+	b.failures++
+	if b.state == stateHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		if b.state != stateOpen {
+			b.setState(stateOpen)
+		}
+	}
+}
+
+func (b *breaker) setState(s breakerState) {
+	b.state = s
+	if b.onChange != nil {
+		b.onChange(s)
+	}
+}
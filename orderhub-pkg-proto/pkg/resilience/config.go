@@ -0,0 +1,140 @@
+package resilience
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Config — политика вызовов одного целевого сервиса
+type Config struct {
+	// Timeout — дедлайн вызова по умолчанию; охватывает все попытки. Более ранний дедлайн
+	// вызывающего сохраняется.
+	Timeout time.Duration
+	// MethodTimeouts — дедлайны отдельных методов по короткому имени ("Login")
+	MethodTimeouts map[string]time.Duration
+
+	// MaxAttempts — попыток вызова идемпотентного метода, включая первую; 1 — без ретраев
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Idempotent — методы, которые безопасно повторять после Unavailable
+	Idempotent map[string]bool
+
+	// BreakerFailures — подряд идущих отказов, после которых цепь размыкается; 0 — без breaker'а
+	BreakerFailures int
+	// BreakerOpenTimeout — сколько цепь разомкнута до пробного вызова (half-open)
+	BreakerOpenTimeout time.Duration
+	// BreakerCodes — коды, которые считаются отказом цели; пусто — DefaultBreakerCodes
+	BreakerCodes []codes.Code
+}
+
+// DefaultBreakerCodes — отказы, по которым видно, что цель недоступна или не успевает.
+// Internal и Unknown сюда не входят: их отдаёт и живой сервис на отдельных запросах.
+var DefaultBreakerCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded}
+
+func (c Config) breakerCodes() map[codes.Code]bool {
+	list := c.BreakerCodes
+	if len(list) == 0 {
+		list = DefaultBreakerCodes
+	}
+	set := make(map[codes.Code]bool, len(list))
+	for _, code := range list {
+		set[code] = true
+	}
+	return set
+}
+
+func (c Config) timeoutFor(method string) time.Duration {
+	if d, ok := c.MethodTimeouts[method]; ok {
+		return d
+	}
+	return c.Timeout
+}
+
+// FromEnv переопределяет политику переменными окружения с префиксом цели, например для
+// prefix "AUTH": AUTH_RPC_TIMEOUT, AUTH_RPC_MAX_ATTEMPTS, AUTH_RPC_BREAKER_FAILURES,
+// AUTH_RPC_BREAKER_OPEN_TIMEOUT, AUTH_RPC_BREAKER_CODES (через запятую, например
+// "UNAVAILABLE,DEADLINE_EXCEEDED,INTERNAL"). Не заданные переменные оставляют значения base.
+func FromEnv(prefix string, base Config) (Config, error) {
+	cfg := base
+	if err := envDuration(prefix+"_RPC_TIMEOUT", &cfg.Timeout); err != nil {
+		return cfg, err
+	}
+	if err := envInt(prefix+"_RPC_MAX_ATTEMPTS", &cfg.MaxAttempts); err != nil {
+		return cfg, err
+	}
+	if err := envInt(prefix+"_RPC_BREAKER_FAILURES", &cfg.BreakerFailures); err != nil {
+		return cfg, err
+	}
+	if err := envDuration(prefix+"_RPC_BREAKER_OPEN_TIMEOUT", &cfg.BreakerOpenTimeout); err != nil {
+		return cfg, err
+	}
+	if err := envCodes(prefix+"_RPC_BREAKER_CODES", &cfg.BreakerCodes); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+func envDuration(key string, dst *time.Duration) error {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return fmt.Errorf("%s: invalid duration %q", key, v)
+	}
+	*dst = d
+	return nil
+}
+
+func envInt(key string, dst *int) error {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return fmt.Errorf("%s: invalid number %q", key, v)
+	}
+	*dst = n
+	return nil
+}
+
+// envCodes читает список кодов gRPC по именам, как в спецификации ("DEADLINE_EXCEEDED") или
+// как их печатает codes.Code ("DeadlineExceeded"), без учёта регистра
+func envCodes(key string, dst *[]codes.Code) error {
+	v, ok := os.LookupEnv(key)
+	if !ok || v == "" {
+		return nil
+	}
+	var list []codes.Code
+	for _, name := range strings.Split(v, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		code, ok := parseCode(name)
+		if !ok {
+			return fmt.Errorf("%s: unknown code %q", key, name)
+		}
+		list = append(list, code)
+	}
+	*dst = list
+	return nil
+}
+
+func parseCode(name string) (codes.Code, bool) {
+	name = strings.ReplaceAll(name, "_", "")
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if strings.EqualFold(name, c.String()) {
+			return c, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,149 @@
+// Package resilience — клиентский стек вызовов между сервисами OrderHub: дедлайны по
+// методам, ретраи идемпотентных методов с экспоненциальной задержкой, размыкатель цепи на
+// целевой сервис и метрики исходов. Политика задаётся на цель (см. AuthDefaults, FromEnv).
+package resilience
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	clientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_handled_total",
+		Help: "Outgoing gRPC calls by target, method and final status code.",
+	}, []string{"target", "grpc_method", "grpc_code"})
+
+	clientHandling = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_client_handling_seconds",
+		Help:    "Duration of outgoing gRPC calls including retries, by target and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"target", "grpc_method"})
+
+	clientRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_retries_total",
+		Help: "Retried outgoing gRPC attempts by target and method.",
+	}, []string{"target", "grpc_method"})
+
+	breakerStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_breaker_state",
+		Help: "Circuit breaker state per target: 0 closed, 1 half-open, 2 open.",
+	}, []string{"target"})
+
+	breakerRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_breaker_rejected_total",
+		Help: "Outgoing gRPC calls rejected by an open circuit breaker.",
+	}, []string{"target"})
+)
+
+// healthPrefix — health-check'и readiness идут мимо стека: они должны видеть реальное
+// состояние соседа и не влиять на breaker
+const healthPrefix = "/grpc.health.v1.Health/"
+
+// UnaryClientInterceptor — стек для соединения с целевым сервисом target. Ставится после
+// перехватчика корреляции, чтобы все попытки несли одни и те же метаданные.
+func UnaryClientInterceptor(target string, cfg Config, log *zap.Logger) grpc.UnaryClientInterceptor {
+	var br *breaker
+	failureCodes := cfg.breakerCodes()
+	if cfg.BreakerFailures > 0 {
+		breakerStateGauge.WithLabelValues(target).Set(float64(stateClosed))
+		br = newBreaker(cfg.BreakerFailures, cfg.BreakerOpenTimeout, func(s breakerState) {
+			breakerStateGauge.WithLabelValues(target).Set(float64(s))
+			log.Warn("circuit breaker state changed", zap.String("target", target), zap.String("state", s.String()))
+		})
+	}
+	attempts := max(cfg.MaxAttempts, 1)
+
+	return func(ctx context.Context, fullMethod string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if strings.HasPrefix(fullMethod, healthPrefix) {
+			return invoker(ctx, fullMethod, req, reply, cc, opts...)
+		}
+		method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+		start := time.Now()
+
+		if d := cfg.timeoutFor(method); d > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, d)
+			defer cancel()
+		}
+
+		maxAttempts := 1
+		if cfg.Idempotent[method] {
+			maxAttempts = attempts
+		}
+
+		var err error
+		for attempt := 1; ; attempt++ {
+			if !br.allow() {
+				breakerRejected.WithLabelValues(target).Inc()
+				err = status.Errorf(codes.Unavailable, "circuit breaker open for %s", target)
+				break
+			}
+			err = invoker(ctx, fullMethod, req, reply, cc, opts...)
+			br.record(classify(err, failureCodes))
+			if err == nil || attempt >= maxAttempts || status.Code(err) != codes.Unavailable {
+				break
+			}
+			if !sleep(ctx, backoff(cfg, attempt)) {
+				break
+			}
+			clientRetries.WithLabelValues(target, method).Inc()
+		}
+
+		clientHandled.WithLabelValues(target, method, status.Code(err).String()).Inc()
+		clientHandling.WithLabelValues(target, method).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// classify — итог вызова для breaker'а. Отказом считаются только коды failureCodes;
+// ошибки бизнес-логики (NotFound, InvalidArgument, ...) значат, что сервис отвечает, а
+// отмена вызова клиентом о сервисе ничего не говорит.
+func classify(err error, failureCodes map[codes.Code]bool) outcome {
+	code := status.Code(err)
+	switch {
+	case code == codes.Canceled || errors.Is(err, context.Canceled):
+		return outcomeNeutral
+	case failureCodes[code]:
+		return outcomeFailure
+	default:
+		return outcomeSuccess
+	}
+}
+
+// backoff — экспоненциальная задержка с полным джиттером
+func backoff(cfg Config, attempt int) time.Duration {
+	d := cfg.InitialBackoff << (attempt - 1)
+	if cfg.MaxBackoff > 0 && (d > cfg.MaxBackoff || d <= 0) {
+		d = cfg.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d) + 1
+}
+
+// sleep ждёт d; false — дедлайн вызова истёк раньше
+func sleep(ctx context.Context, d time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= d {
+		return false
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,240 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testConfig() Config {
+	return Config{
+		Timeout:            time.Second,
+		MaxAttempts:        3,
+		InitialBackoff:     time.Millisecond,
+		MaxBackoff:         2 * time.Millisecond,
+		Idempotent:         map[string]bool{"GetProduct": true},
+		BreakerFailures:    2,
+		BreakerOpenTimeout: time.Hour,
+	}
+}
+
+// countingInvoker возвращает ошибки из errs по очереди, затем nil
+func countingInvoker(calls *int, errs ...error) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*calls++
+		if *calls <= len(errs) {
+			return errs[*calls-1]
+		}
+		return nil
+	}
+}
+
+func TestInterceptor_RetriesIdempotentMethods(t *testing.T) {
+	cfg := testConfig()
+	cfg.BreakerFailures = 0
+	ic := UnaryClientInterceptor("inventory", cfg, zap.NewNop())
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	calls := 0
+	err := ic(context.Background(), "/inventory.v1.InventoryService/GetProduct", nil, nil, nil, countingInvoker(&calls, unavailable, unavailable))
+	if err != nil || calls != 3 {
+		t.Fatalf("idempotent call must be retried until success, calls=%d err=%v", calls, err)
+	}
+
+	calls = 0
+	err = ic(context.Background(), "/inventory.v1.InventoryService/Reserve", nil, nil, nil, countingInvoker(&calls, unavailable))
+	if status.Code(err) != codes.Unavailable || calls != 1 {
+		t.Fatalf("non-idempotent call must not be retried, calls=%d err=%v", calls, err)
+	}
+
+	calls = 0
+	err = ic(context.Background(), "/inventory.v1.InventoryService/GetProduct", nil, nil, nil, countingInvoker(&calls, status.Error(codes.NotFound, "no product")))
+	if status.Code(err) != codes.NotFound || calls != 1 {
+		t.Fatalf("business errors must not be retried, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestInterceptor_AppliesMethodDeadline(t *testing.T) {
+	cfg := testConfig()
+	cfg.MethodTimeouts = map[string]time.Duration{"Login": 50 * time.Millisecond}
+	ic := UnaryClientInterceptor("auth", cfg, zap.NewNop())
+
+	var got time.Duration
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			t.Fatal("call must have a deadline")
+		}
+		got = time.Until(deadline)
+		return nil
+	}
+	_ = ic(context.Background(), "/auth.v1.AuthService/Login", nil, nil, nil, invoker)
+	if got <= 0 || got > 50*time.Millisecond {
+		t.Errorf("expected per-method deadline, got %v", got)
+	}
+
+	// более ранний дедлайн вызывающего сохраняется
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_ = ic(ctx, "/auth.v1.AuthService/GetMe", nil, nil, nil, invoker)
+	if got > 10*time.Millisecond {
+		t.Errorf("caller deadline must win, got %v", got)
+	}
+}
+
+func TestInterceptor_BreakerOpensAndRejects(t *testing.T) {
+	ic := UnaryClientInterceptor("auth-breaker-test", testConfig(), zap.NewNop())
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	calls := 0
+	for range 2 {
+		_ = ic(context.Background(), "/auth.v1.AuthService/Logout", nil, nil, nil, countingInvoker(&calls, unavailable))
+		calls = 0
+	}
+	err := ic(context.Background(), "/auth.v1.AuthService/Logout", nil, nil, nil, countingInvoker(&calls))
+	if status.Code(err) != codes.Unavailable || calls != 0 {
+		t.Fatalf("open breaker must reject without calling, calls=%d err=%v", calls, err)
+	}
+
+	// health-check'и идут мимо breaker'а
+	err = ic(context.Background(), "/grpc.health.v1.Health/Check", nil, nil, nil, countingInvoker(&calls))
+	if err != nil || calls != 1 {
+		t.Fatalf("health checks must bypass the breaker, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestInterceptor_BreakerCodes(t *testing.T) {
+	internal := status.Error(codes.Internal, "db down")
+	logout := func(ic grpc.UnaryClientInterceptor, errs ...error) (int, error) {
+		calls := 0
+		err := ic(context.Background(), "/auth.v1.AuthService/Logout", nil, nil, nil, countingInvoker(&calls, errs...))
+		return calls, err
+	}
+
+	// по умолчанию Internal — ответ живого сервиса, цепь не размыкается
+	ic := UnaryClientInterceptor("auth-breaker-codes-default", testConfig(), zap.NewNop())
+	for range 3 {
+		_, _ = logout(ic, internal)
+	}
+	if calls, err := logout(ic); err != nil || calls != 1 {
+		t.Fatalf("Internal must not open the breaker by default, calls=%d err=%v", calls, err)
+	}
+
+	cfg := testConfig()
+	cfg.BreakerCodes = []codes.Code{codes.Unavailable, codes.Internal}
+	ic = UnaryClientInterceptor("auth-breaker-codes-custom", cfg, zap.NewNop())
+	for range 2 {
+		_, _ = logout(ic, internal)
+	}
+	if calls, err := logout(ic); status.Code(err) != codes.Unavailable || calls != 0 {
+		t.Fatalf("configured code must open the breaker, calls=%d err=%v", calls, err)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	failureCodes := testConfig().breakerCodes()
+	tests := []struct {
+		err  error
+		want outcome
+	}{
+		{nil, outcomeSuccess},
+		{status.Error(codes.NotFound, "no user"), outcomeSuccess},
+		{status.Error(codes.Internal, "db down"), outcomeSuccess},
+		{status.Error(codes.Unknown, "panic"), outcomeSuccess},
+		{status.Error(codes.Unavailable, "connection refused"), outcomeFailure},
+		{status.Error(codes.DeadlineExceeded, "slow"), outcomeFailure},
+		{status.Error(codes.Canceled, "client gone"), outcomeNeutral},
+		{context.Canceled, outcomeNeutral},
+	}
+	for _, tt := range tests {
+		if got := classify(tt.err, failureCodes); got != tt.want {
+			t.Errorf("classify(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestBreaker_HalfOpenProbe(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newBreaker(1, 10*time.Second, nil)
+	b.now = func() time.Time { return now }
+
+	b.record(outcomeFailure)
+	if b.allow() {
+		t.Fatal("breaker must be open after the threshold")
+	}
+
+	now = now.Add(10 * time.Second)
+	if !b.allow() {
+		t.Fatal("breaker must let a probe through after the open timeout")
+	}
+	if b.allow() {
+		t.Fatal("only one probe may run in half-open state")
+	}
+	b.record(outcomeFailure)
+	if b.state != stateOpen || b.allow() {
+		t.Fatal("failed probe must reopen the breaker")
+	}
+
+	// отменённая проба ничего не решает: цепь остаётся half-open и пускает следующую
+	now = now.Add(10 * time.Second)
+	if !b.allow() {
+		t.Fatal("breaker must probe again")
+	}
+	b.record(outcomeNeutral)
+	if b.state != stateHalfOpen {
+		t.Fatalf("cancelled probe must not change the state, got %s", b.state)
+	}
+	if !b.allow() {
+		t.Fatal("cancelled probe must free the probe slot")
+	}
+	if b.allow() {
+		t.Fatal("only one probe may run in half-open state")
+	}
+	b.record(outcomeSuccess)
+	if b.state != stateClosed || !b.allow() {
+		t.Fatal("successful probe must close the breaker")
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("AUTH_RPC_TIMEOUT", "750ms")
+	t.Setenv("AUTH_RPC_MAX_ATTEMPTS", "1")
+	cfg, err := FromEnv("AUTH", AuthDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Timeout != 750*time.Millisecond || cfg.MaxAttempts != 1 || cfg.BreakerFailures != AuthDefaults().BreakerFailures {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+
+	t.Setenv("AUTH_RPC_BREAKER_CODES", "UNAVAILABLE, deadline_exceeded,Internal")
+	cfg, err = FromEnv("AUTH", AuthDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.Internal}
+	if len(cfg.BreakerCodes) != len(want) {
+		t.Fatalf("BreakerCodes: want %v, got %v", want, cfg.BreakerCodes)
+	}
+	for i := range want {
+		if cfg.BreakerCodes[i] != want[i] {
+			t.Fatalf("BreakerCodes: want %v, got %v", want, cfg.BreakerCodes)
+		}
+	}
+
+	t.Setenv("AUTH_RPC_BREAKER_CODES", "Teapot")
+	if _, err := FromEnv("AUTH", AuthDefaults()); err == nil {
+		t.Fatal("unknown code must be rejected")
+	}
+	t.Setenv("AUTH_RPC_BREAKER_CODES", "")
+
+	t.Setenv("AUTH_RPC_BREAKER_OPEN_TIMEOUT", "soon")
+	if _, err := FromEnv("AUTH", AuthDefaults()); err == nil {
+		t.Fatal("invalid duration must be rejected")
+	}
+}
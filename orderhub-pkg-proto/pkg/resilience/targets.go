@@ -0,0 +1,74 @@
+package resilience
+
+import "time"
+
+// AuthDefaults — политика вызовов auth-service. Повторяются только чтения и проверки
+// токенов; Login и Register медленнее из-за bcrypt, выгрузка данных — из-за объёма.
+func AuthDefaults() Config {
+	return Config{
+		Timeout: 3 * time.Second,
+		MethodTimeouts: map[string]time.Duration{
+			"Login":                5 * time.Second,
+			"Register":             5 * time.Second,
+			"ConfirmPasswordReset": 5 * time.Second,
+			"UpgradeGuest":         5 * time.Second,
+			"ExportMyData":         15 * time.Second,
+		},
+		MaxAttempts:    3,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Idempotent: map[string]bool{
+			"Introspect":             true,
+			"IntrospectToken":        true,
+			"ResolveApiKey":          true,
+			"GetJwks":                true,
+			"GetMe":                  true,
+			"ExportMyData":           true,
+			"ListPermissions":        true,
+			"ListRolePermissions":    true,
+			"ListApiKeys":            true,
+			"ListTrustedDevices":     true,
+			"ListVendorApplications": true,
+			"ListOAuthClients":       true,
+		},
+		BreakerFailures:    5,
+		BreakerOpenTimeout: 10 * time.Second,
+	}
+}
+
+// OrderDefaults — политика вызовов order-service: повторяются только чтения;
+// создание и отмена заказа запускают Сагу и не повторяются
+func OrderDefaults() Config {
+	return Config{
+		Timeout:        3 * time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     500 * time.Millisecond,
+		Idempotent: map[string]bool{
+			"GetOrder":   true,
+			"ListOrders": true,
+		},
+		BreakerFailures:    5,
+		BreakerOpenTimeout: 10 * time.Second,
+	}
+}
+
+// InventoryDefaults — политика вызовов inventory-service: чтения каталога повторяются,
+// резервы — нет
+func InventoryDefaults() Config {
+	return Config{
+		Timeout:        2 * time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     500 * time.Millisecond,
+		Idempotent: map[string]bool{
+			"GetProduct":       true,
+			"BatchGetProducts": true,
+			"ListProducts":     true,
+			"GetStock":         true,
+			"BatchGetStock":    true,
+		},
+		BreakerFailures:    5,
+		BreakerOpenTimeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,85 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormParentKey = "otel:parent_ctx"
+
+// GormPlugin пишет спан на каждый запрос GORM. Запрос попадает в трассу, только если
+// репозиторий передаёт контекст через db.WithContext(ctx). В спан пишется SQL с
+// плейсхолдерами, значения параметров не пишутся.
+type GormPlugin struct{}
+
+func NewGormPlugin() *GormPlugin {
+	return &GormPlugin{}
+}
+
+func (GormPlugin) Name() string { return "otel" }
+
+func (p GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	register := []error{
+		cb.Create().Before("gorm:create").Register("otel:before_create", before("create")),
+		cb.Create().After("gorm:create").Register("otel:after_create", after),
+		cb.Query().Before("gorm:query").Register("otel:before_query", before("query")),
+		cb.Query().After("gorm:query").Register("otel:after_query", after),
+		cb.Update().Before("gorm:update").Register("otel:before_update", before("update")),
+		cb.Update().After("gorm:update").Register("otel:after_update", after),
+		cb.Delete().Before("gorm:delete").Register("otel:before_delete", before("delete")),
+		cb.Delete().After("gorm:delete").Register("otel:after_delete", after),
+		cb.Row().Before("gorm:row").Register("otel:before_row", before("row")),
+		cb.Row().After("gorm:row").Register("otel:after_row", after),
+		cb.Raw().Before("gorm:raw").Register("otel:before_raw", before("raw")),
+		cb.Raw().After("gorm:raw").Register("otel:after_raw", after),
+	}
+	return errors.Join(register...)
+}
+
+func before(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+		db.InstanceSet(gormParentKey, db.Statement.Context)
+		db.Statement.Context, _ = Tracer().Start(db.Statement.Context, "gorm."+op,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("db.system", "postgresql"),
+				attribute.String("db.operation", op),
+			),
+		)
+	}
+}
+
+func after(db *gorm.DB) {
+	parent, ok := db.InstanceGet(gormParentKey)
+	if !ok {
+		return
+	}
+	span := trace.SpanFromContext(db.Statement.Context)
+	// контекст запроса возвращается как был: следующие операции того же *gorm.DB не
+	// должны стать дочерними спанами этой
+	db.Statement.Context = parent.(context.Context)
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(
+		attribute.String("db.statement", db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	if db.Statement.Table != "" {
+		span.SetAttributes(attribute.String("db.sql.table", db.Statement.Table))
+	}
+	if err := db.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
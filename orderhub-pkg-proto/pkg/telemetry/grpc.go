@@ -0,0 +1,46 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// ServerOption — серверный спан на каждый вызов; health-check'и не трассируются
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))))
+}
+
+// DialOption — клиентский спан на каждый вызов и traceparent в исходящих метаданных;
+// периодические health-check'и readiness не трассируются
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithFilter(filters.Not(filters.HealthCheck()))))
+}
+
+// InterceptorSpan выделяет работу перехватчика (например, проверку токена) в отдельный
+// спан. Спан закрывается, когда перехватчик передаёт вызов дальше, а обработчик остаётся
+// дочерним спаном вызова, а не перехватчика.
+func InterceptorSpan(name string, next grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		parent := trace.SpanFromContext(ctx)
+		ctx, span := Tracer().Start(ctx, name)
+		passed := false
+		resp, err := next(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			passed = true
+			span.End()
+			return handler(trace.ContextWithSpan(ctx, parent), req)
+		})
+		if !passed {
+			if err != nil {
+				span.SetStatus(codes.Error, status.Convert(err).Message())
+			}
+			span.End()
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,91 @@
+// Package kafkatrace трассирует сообщения kafka-go: producer-спан кладёт traceparent в
+// заголовки сообщения, consumer-спан ссылается (link) на него.
+package kafkatrace
+
+import (
+	"context"
+
+	"github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartProduce начинает producer-спан и записывает его контекст в заголовки msg.
+// Спан нужно закрыть через End после WriteMessages.
+func StartProduce(ctx context.Context, topic string, msg *kafka.Message) (context.Context, trace.Span) {
+	ctx, span := telemetry.Tracer().Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", topic),
+			attribute.String("messaging.operation.type", "publish"),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, carrier{msg: msg})
+	return ctx, span
+}
+
+// StartConsume начинает consumer-спан для прочитанного сообщения. Обработка сообщения —
+// отдельная трасса со ссылкой на producer-спан из заголовков.
+func StartConsume(ctx context.Context, msg kafka.Message) (context.Context, trace.Span) {
+	remote := otel.GetTextMapPropagator().Extract(context.Background(), carrier{msg: &msg})
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithNewRoot(),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", msg.Topic),
+			attribute.String("messaging.operation.type", "process"),
+			attribute.Int("messaging.destination.partition.id", msg.Partition),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+		),
+	}
+	if sc := trace.SpanContextFromContext(remote); sc.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sc}))
+	}
+	return telemetry.Tracer().Start(ctx, msg.Topic+" process", opts...)
+}
+
+// End закрывает спан, отмечая ошибку
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// carrier — propagation.TextMapCarrier поверх заголовков сообщения
+type carrier struct {
+	msg *kafka.Message
+}
+
+func (c carrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c carrier) Set(key, value string) {
+	for i, h := range c.msg.Headers {
+		if h.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c carrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
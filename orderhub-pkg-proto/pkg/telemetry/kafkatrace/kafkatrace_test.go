@@ -0,0 +1,45 @@
+package kafkatrace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestConsumeSpanLinksToProducer(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	msg := kafka.Message{Topic: "emails.send", Headers: []kafka.Header{{Key: "x-request-id", Value: []byte("r1")}}}
+	_, produce := StartProduce(context.Background(), msg.Topic, &msg)
+	End(produce, nil)
+	if len(msg.Headers) != 2 || msg.Headers[1].Key != "traceparent" {
+		t.Fatalf("expected traceparent header next to existing ones, got %+v", msg.Headers)
+	}
+
+	_, consume := StartConsume(context.Background(), msg)
+	End(consume, nil)
+
+	ended := rec.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+	consumer := ended[1]
+	if consumer.Parent().IsValid() {
+		t.Error("consumer span must start a new trace")
+	}
+	if links := consumer.Links(); len(links) != 1 || links[0].SpanContext.SpanID() != produce.SpanContext().SpanID() {
+		t.Errorf("consumer span must link to the producer span, got %+v", links)
+	}
+}
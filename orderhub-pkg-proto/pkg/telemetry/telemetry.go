@@ -0,0 +1,87 @@
+// Package telemetry настраивает OpenTelemetry-трассировку сервисов OrderHub: экспорт спанов,
+// propagator W3C Trace Context и инструментирование gRPC и GORM.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Значения OTEL_TRACES_EXPORTER
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+const instrumentationName = "github.com/Anabol1ks/orderhub-pkg-proto/pkg/telemetry"
+
+// Tracer — трейсер для спанов, которые OrderHub создаёт сам (GORM, Kafka, перехватчики)
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup регистрирует глобальные TracerProvider и propagator (traceparent и baggage).
+// Экспортёр выбирается переменной OTEL_TRACES_EXPORTER: otlp (по умолчанию, если задан
+// OTEL_EXPORTER_OTLP_ENDPOINT), stdout для локального запуска или none — тогда спаны не
+// пишутся, но контекст трассы по-прежнему передаётся дальше. Адрес, заголовки и sampler
+// OTLP берутся из стандартных переменных OTEL_*. Возвращаемая функция сбрасывает
+// накопленные спаны при остановке.
+func Setup(ctx context.Context, service string, log *zap.Logger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	name := exporterName()
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch name {
+	case ExporterNone:
+		log.Info("tracing disabled")
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	default:
+		return nil, fmt.Errorf("unknown OTEL_TRACES_EXPORTER %q", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create %s exporter: %w", name, err)
+	}
+
+	// OTEL_SERVICE_NAME и OTEL_RESOURCE_ATTRIBUTES переопределяют имя сервиса
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", service)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	log.Info("tracing enabled", zap.String("exporter", name))
+	return tp.Shutdown, nil
+}
+
+func exporterName() string {
+	if name := strings.ToLower(strings.TrimSpace(os.Getenv("OTEL_TRACES_EXPORTER"))); name != "" {
+		return name
+	}
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" {
+		return ExporterOTLP
+	}
+	return ExporterNone
+}
@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return rec
+}
+
+func TestInterceptorSpan_HandlerStaysChildOfCall(t *testing.T) {
+	rec := newRecorder(t)
+	ctx, call := Tracer().Start(context.Background(), "call")
+
+	auth := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(ctx, req)
+	}
+	var handlerParent trace.SpanID
+	_, err := InterceptorSpan("auth", auth)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		handlerParent = trace.SpanContextFromContext(ctx).SpanID()
+		return nil, nil
+	})
+	call.End()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if handlerParent != call.SpanContext().SpanID() {
+		t.Error("handler must run in the call span, not in the interceptor span")
+	}
+	ended := rec.Ended()
+	if len(ended) != 2 || ended[0].Name() != "auth" || ended[0].Parent().SpanID() != call.SpanContext().SpanID() {
+		t.Fatalf("expected auth span under the call span, got %d spans", len(ended))
+	}
+}
+
+func TestInterceptorSpan_RecordsRejection(t *testing.T) {
+	rec := newRecorder(t)
+	deny := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	_, err := InterceptorSpan("auth", deny)(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		t.Fatal("handler must not be called")
+		return nil, nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ended := rec.Ended()
+	if len(ended) != 1 || ended[0].Status().Description != "invalid token" {
+		t.Fatalf("expected the rejection to be recorded on the span, got %+v", ended)
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/Anabol1ks/orderhub-pkg-proto/proto
+
+go 1.25.1
+
+require (
+	github.com/envoyproxy/protoc-gen-validate v1.3.0
+	google.golang.org/grpc v1.75.0
+	google.golang.org/protobuf v1.36.7
+)
+
+require (
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.36.0 // indirect
+	golang.org/x/text v0.28.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
+)